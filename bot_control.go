@@ -0,0 +1,557 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/metrics"
+)
+
+// vars related to the bot control
+var (
+	ErrDryRunEnabled         = errors.New("orders cannot be placed or cancelled in dry run mode")
+	ErrExchangeAlreadyActive = errors.New("exchange already enabled")
+	ErrInvalidCurrencyPair   = errors.New("invalid currency pair")
+	ErrInvalidOrderSide      = errors.New("invalid order side, must be buy or sell")
+	ErrInvalidOrderType      = errors.New("invalid order type, must be limit or market")
+	ErrOrderTypeNotSupported = errors.New("order type not supported by the exchange")
+	ErrInvalidOrderAmount    = errors.New("order amount and limit price must be greater than zero")
+	ErrInvalidOrderID        = errors.New("invalid order ID")
+	ErrInvalidWithdrawal     = errors.New("withdrawal currency, address and amount must be set")
+	ErrCurrencyNotSet        = errors.New("currency not set")
+
+	updatersPaused int32
+)
+
+// BotControl implements base.IBotControl so the communication mediums and the
+// gRPC server are able to issue trading and control commands. The exchange wrappers do not expose
+// open orders, so only orders placed through the bot control are tracked and
+// they are pruned once the exchange reports them as filled or cancelled
+type BotControl struct {
+	orders []base.ControlOrder
+	sync.Mutex
+}
+
+// UpdatersPaused returns whether the ticker and orderbook updater routines
+// have been paused
+func UpdatersPaused() bool {
+	return atomic.LoadInt32(&updatersPaused) == 1
+}
+
+// getLoadedExchange returns a loaded exchange by name
+func getLoadedExchange(exchangeName string) (exchange.IBotExchange, error) {
+	exch := GetExchangeByName(exchangeName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	return exch, nil
+}
+
+// parseCurrencyPair returns an upper case currency pair, guarding against
+// strings too short to be split into a pair
+func parseCurrencyPair(currencyPair string) (pair.CurrencyPair, error) {
+	if len(currencyPair) < 6 && !strings.ContainsAny(currencyPair, "_-") {
+		return pair.CurrencyPair{}, ErrInvalidCurrencyPair
+	}
+	return pair.NewCurrencyPairFromString(common.StringToUpper(currencyPair)), nil
+}
+
+// parseOrderSide returns the order side for a case insensitive buy or sell
+func parseOrderSide(side string) (exchange.OrderSide, error) {
+	switch common.StringToLower(side) {
+	case "buy":
+		return exchange.Buy, nil
+	case "sell":
+		return exchange.Sell, nil
+	}
+	return "", ErrInvalidOrderSide
+}
+
+// parseOrderType returns the order type for a case insensitive limit or
+// market
+func parseOrderType(orderType string) (exchange.OrderType, error) {
+	switch common.StringToLower(orderType) {
+	case "limit":
+		return exchange.Limit, nil
+	case "market":
+		return exchange.Market, nil
+	}
+	return "", ErrInvalidOrderType
+}
+
+// GetOpenOrders returns the open orders placed through the bot control, all
+// exchanges are included if the exchange name is empty
+func (b *BotControl) GetOpenOrders(exchangeName string) ([]base.ControlOrder, error) {
+	b.refreshOrders(exchangeName)
+
+	b.Lock()
+	defer b.Unlock()
+
+	var orders []base.ControlOrder
+	for i := range b.orders {
+		if exchangeName != "" &&
+			common.StringToLower(b.orders[i].Exchange) != common.StringToLower(exchangeName) {
+			continue
+		}
+		orders = append(orders, b.orders[i])
+	}
+	return orders, nil
+}
+
+// CancelOrder cancels an order by ID, orders placed through the bot control
+// are cancelled using their stored currency pair and side
+func (b *BotControl) CancelOrder(exchangeName, orderID string) error {
+	if bot.dryRun {
+		return ErrDryRunEnabled
+	}
+
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return err
+	}
+
+	cancel := exchange.OrderCancellation{OrderID: orderID}
+	order := base.ControlOrder{Exchange: exch.GetName(), OrderID: orderID}
+	b.Lock()
+	idx := b.findOrder(exch.GetName(), orderID)
+	if idx != -1 {
+		order = b.orders[idx]
+		cancel.CurrencyPair = pair.NewCurrencyPairFromString(order.CurrencyPair)
+		cancel.Side = exchange.OrderSide(order.Side)
+	}
+	b.Unlock()
+
+	err = exch.CancelOrder(cancel)
+	metrics.AddOrder(exch.GetName(), metrics.OrderCancel, err)
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	if idx = b.findOrder(exch.GetName(), orderID); idx != -1 {
+		b.orders = append(b.orders[:idx], b.orders[idx+1:]...)
+	}
+	b.Unlock()
+	log.Printf("%s order %s cancelled via remote control.", exch.GetName(), orderID)
+	relayOrderUpdate(OrderStatusCancelled, order)
+	return nil
+}
+
+// CancelAllOrders cancels all orders on an exchange
+func (b *BotControl) CancelAllOrders(exchangeName string) error {
+	if bot.dryRun {
+		return ErrDryRunEnabled
+	}
+
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return err
+	}
+
+	err = exch.CancelAllOrders()
+	metrics.AddOrder(exch.GetName(), metrics.OrderCancelAll, err)
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	var orders, cancelled []base.ControlOrder
+	for i := range b.orders {
+		if b.orders[i].Exchange != exch.GetName() {
+			orders = append(orders, b.orders[i])
+			continue
+		}
+		cancelled = append(cancelled, b.orders[i])
+	}
+	b.orders = orders
+	b.Unlock()
+	log.Printf("%s all orders cancelled via remote control.", exch.GetName())
+	for i := range cancelled {
+		relayOrderUpdate(OrderStatusCancelled, cancelled[i])
+	}
+	return nil
+}
+
+// SubmitLimitOrder places a limit order and tracks it as open
+func (b *BotControl) SubmitLimitOrder(exchangeName, currencyPair, side string, amount, price float64) (string, error) {
+	return b.SubmitOrder(exchangeName, currencyPair, side, "limit", amount, price, "")
+}
+
+// SubmitOrder places a limit or market order and tracks it as open
+func (b *BotControl) SubmitOrder(exchangeName, currencyPair, side, orderType string, amount, price float64, clientID string) (string, error) {
+	if bot.dryRun {
+		return "", ErrDryRunEnabled
+	}
+
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return "", err
+	}
+
+	orderSide, err := parseOrderSide(side)
+	if err != nil {
+		return "", err
+	}
+
+	ordType, err := parseOrderType(orderType)
+	if err != nil {
+		return "", err
+	}
+
+	capabilities := exch.GetCapabilities()
+	if !capabilities.SupportsOrderType(ordType) {
+		return "", ErrOrderTypeNotSupported
+	}
+
+	if amount <= 0 || (ordType == exchange.Limit && price <= 0) {
+		return "", ErrInvalidOrderAmount
+	}
+
+	p, err := parseCurrencyPair(currencyPair)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := exch.SubmitOrder(p, orderSide, ordType,
+		decimal.NewFromFloat(amount), decimal.NewFromFloat(price), clientID)
+	if err == nil && !resp.IsOrderPlaced {
+		err = errors.New("order not placed")
+	}
+	metrics.AddOrder(exch.GetName(), metrics.OrderSubmit, err)
+	if err != nil {
+		return "", err
+	}
+
+	order := base.ControlOrder{
+		Exchange:     exch.GetName(),
+		OrderID:      resp.OrderID,
+		CurrencyPair: p.Pair().String(),
+		Side:         string(orderSide),
+		OrderType:    string(ordType),
+		Amount:       amount,
+		Price:        price,
+	}
+	b.Lock()
+	b.orders = append(b.orders, order)
+	b.Unlock()
+	log.Printf("%s %s %s order %s placed via remote control.",
+		exch.GetName(), p.Pair().String(), ordType, resp.OrderID)
+	relayOrderUpdate(OrderStatusPlaced, order)
+	return resp.OrderID, nil
+}
+
+// ModifyOrder amends an order and returns its new ID, a tracked order is
+// updated to match
+func (b *BotControl) ModifyOrder(exchangeName, orderID, side, orderType string, amount, price float64) (string, error) {
+	if bot.dryRun {
+		return "", ErrDryRunEnabled
+	}
+
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := strconv.ParseInt(orderID, 10, 64)
+	if err != nil {
+		return "", ErrInvalidOrderID
+	}
+
+	orderSide, err := parseOrderSide(side)
+	if err != nil {
+		return "", err
+	}
+
+	ordType, err := parseOrderType(orderType)
+	if err != nil {
+		return "", err
+	}
+
+	if amount <= 0 || (ordType == exchange.Limit && price <= 0) {
+		return "", ErrInvalidOrderAmount
+	}
+
+	newID, err := exch.ModifyOrder(id, exchange.ModifyOrder{
+		OrderType: ordType,
+		OrderSide: orderSide,
+		Price:     decimal.NewFromFloat(price),
+		Amount:    decimal.NewFromFloat(amount),
+	})
+	metrics.AddOrder(exch.GetName(), metrics.OrderModify, err)
+	if err != nil {
+		return "", err
+	}
+
+	newOrderID := strconv.FormatInt(newID, 10)
+	order := base.ControlOrder{
+		Exchange:  exch.GetName(),
+		OrderID:   newOrderID,
+		Side:      string(orderSide),
+		OrderType: string(ordType),
+		Amount:    amount,
+		Price:     price,
+	}
+	b.Lock()
+	if idx := b.findOrder(exch.GetName(), orderID); idx != -1 {
+		order.CurrencyPair = b.orders[idx].CurrencyPair
+		b.orders[idx] = order
+	}
+	b.Unlock()
+	log.Printf("%s order %s modified via remote control, new order ID %s.",
+		exch.GetName(), orderID, newOrderID)
+	relayOrderUpdate(OrderStatusModified, order)
+	return newOrderID, nil
+}
+
+// GetOrderInfo returns the exchange details of an order
+func (b *BotControl) GetOrderInfo(exchangeName, orderID string) (exchange.OrderDetail, error) {
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return exchange.OrderDetail{}, err
+	}
+
+	id, err := strconv.ParseInt(orderID, 10, 64)
+	if err != nil {
+		return exchange.OrderDetail{}, ErrInvalidOrderID
+	}
+	return exch.GetOrderInfo(id)
+}
+
+// GetDepositAddress returns the exchange deposit address for a cryptocurrency
+func (b *BotControl) GetDepositAddress(exchangeName, cryptocurrency string) (string, error) {
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return "", err
+	}
+
+	if cryptocurrency == "" {
+		return "", ErrCurrencyNotSet
+	}
+	return exch.GetDepositAddress(pair.CurrencyItem(common.StringToUpper(cryptocurrency)))
+}
+
+// WithdrawCryptocurrency withdraws a cryptocurrency to an address and returns
+// the exchange withdrawal ID
+func (b *BotControl) WithdrawCryptocurrency(exchangeName, cryptocurrency, address string, amount float64) (string, error) {
+	if bot.dryRun {
+		return "", ErrDryRunEnabled
+	}
+
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return "", err
+	}
+
+	if cryptocurrency == "" || address == "" || amount <= 0 {
+		return "", ErrInvalidWithdrawal
+	}
+
+	id, err := exch.WithdrawCryptocurrencyFunds(address,
+		pair.CurrencyItem(common.StringToUpper(cryptocurrency)), amount)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("%s %f %s withdrawal to %s requested via remote control, ID %s.",
+		exch.GetName(), amount, common.StringToUpper(cryptocurrency), address, id)
+	return id, nil
+}
+
+// WithdrawFiat withdraws a fiat currency to the bank account registered with
+// the exchange and returns the exchange withdrawal ID
+func (b *BotControl) WithdrawFiat(exchangeName, fiatCurrency string, amount float64) (string, error) {
+	if bot.dryRun {
+		return "", ErrDryRunEnabled
+	}
+
+	exch, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		return "", err
+	}
+
+	if fiatCurrency == "" || amount <= 0 {
+		return "", ErrInvalidWithdrawal
+	}
+
+	id, err := exch.WithdrawFiatFunds(pair.CurrencyItem(common.StringToUpper(fiatCurrency)), amount)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("%s %f %s fiat withdrawal requested via remote control, ID %s.",
+		exch.GetName(), amount, common.StringToUpper(fiatCurrency), id)
+	return id, nil
+}
+
+// SetExchangeEnabled loads or unloads an exchange and updates its config
+func (b *BotControl) SetExchangeEnabled(exchangeName string, enabled bool) error {
+	var name string
+	bot.configMtx.RLock()
+	for i := range bot.config.Exchanges {
+		if common.StringToLower(bot.config.Exchanges[i].Name) == common.StringToLower(exchangeName) {
+			name = bot.config.Exchanges[i].Name
+			break
+		}
+	}
+	bot.configMtx.RUnlock()
+	if name == "" {
+		return ErrExchangeNotFound
+	}
+
+	if !enabled {
+		err := UnloadExchange(name)
+		if err != nil {
+			return err
+		}
+		log.Printf("%s exchange disabled via remote control.", name)
+		return nil
+	}
+
+	if CheckExchangeExists(name) {
+		return ErrExchangeAlreadyActive
+	}
+
+	exchCfg, err := bot.config.GetExchangeConfig(name)
+	if err != nil {
+		return err
+	}
+
+	exchCfg.Enabled = true
+	err = bot.config.UpdateExchangeConfig(exchCfg)
+	if err != nil {
+		return err
+	}
+
+	err = LoadExchange(name, false, nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("%s exchange enabled via remote control.", name)
+	return nil
+}
+
+// SetUpdatersPaused pauses or resumes the ticker and orderbook updater
+// routines
+func (b *BotControl) SetUpdatersPaused(paused bool) {
+	if paused {
+		atomic.StoreInt32(&updatersPaused, 1)
+	} else {
+		atomic.StoreInt32(&updatersPaused, 0)
+	}
+	log.Printf("Updater routines paused via remote control: %v.", paused)
+}
+
+// refreshOrders queries the exchange for each tracked order and stops tracking
+// the orders that have been filled or cancelled. Orders on exchanges that do
+// not support GetOrderInfo are left as they are
+func (b *BotControl) refreshOrders(exchangeName string) {
+	var tracked []base.ControlOrder
+	b.Lock()
+	for i := range b.orders {
+		if exchangeName != "" &&
+			common.StringToLower(b.orders[i].Exchange) != common.StringToLower(exchangeName) {
+			continue
+		}
+		tracked = append(tracked, b.orders[i])
+	}
+	b.Unlock()
+
+	for i := range tracked {
+		exch := GetExchangeByName(tracked[i].Exchange)
+		if exch == nil {
+			continue
+		}
+
+		capabilities := exch.GetCapabilities()
+		if !capabilities.SupportsFunction("GetOrderInfo") {
+			continue
+		}
+
+		id, err := strconv.ParseInt(tracked[i].OrderID, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		detail, err := exch.GetOrderInfo(id)
+		if err != nil {
+			log.Printf("%s failed to get order %s info. Err: %s",
+				tracked[i].Exchange, tracked[i].OrderID, err)
+			continue
+		}
+
+		status := closedOrderStatus(detail)
+		if status == "" {
+			continue
+		}
+
+		b.Lock()
+		if idx := b.findOrder(tracked[i].Exchange, tracked[i].OrderID); idx != -1 {
+			b.orders = append(b.orders[:idx], b.orders[idx+1:]...)
+		}
+		b.Unlock()
+		log.Printf("%s order %s %s.", tracked[i].Exchange, tracked[i].OrderID, status)
+		relayOrderUpdate(status, tracked[i])
+		if status == OrderStatusFilled {
+			pushTradeFill(tracked[i], detail)
+		}
+	}
+}
+
+// pushTradeFill alerts the communication mediums that an order has been filled
+func pushTradeFill(order base.ControlOrder, detail exchange.OrderDetail) {
+	if bot.comms == nil {
+		return
+	}
+
+	bot.comms.PushEvent(base.Event{
+		Type: "TRADE_FILL",
+		TradeDetails: fmt.Sprintf("%s %s %s order %s filled, amount %f price %f",
+			order.Exchange, order.CurrencyPair, order.Side, order.OrderID,
+			detail.Amount, detail.Price),
+		Severity: base.SeverityInfo,
+		Category: base.CategoryTradeFill,
+	})
+}
+
+// closedOrderStatus returns OrderStatusFilled or OrderStatusCancelled if the
+// exchange order details show the order is no longer open, otherwise an empty
+// string
+func closedOrderStatus(detail exchange.OrderDetail) string {
+	status := common.StringToLower(detail.Status)
+	for _, s := range []string{"cancel", "reject", "expire", "fail"} {
+		if common.StringContains(status, s) {
+			return OrderStatusCancelled
+		}
+	}
+
+	if detail.OpenVolume > 0 || common.StringContains(status, "partial") ||
+		common.StringContains(status, "unfill") {
+		return ""
+	}
+
+	for _, s := range []string{"fill", "match", "closed", "done"} {
+		if common.StringContains(status, s) {
+			return OrderStatusFilled
+		}
+	}
+	return ""
+}
+
+// findOrder returns the index of a tracked order or -1 if not found, the
+// caller must hold the lock
+func (b *BotControl) findOrder(exchangeName, orderID string) int {
+	for i := range b.orders {
+		if b.orders[i].Exchange == exchangeName && b.orders[i].OrderID == orderID {
+			return i
+		}
+	}
+	return -1
+}
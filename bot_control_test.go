@@ -0,0 +1,190 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/communications"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/bitfinex"
+)
+
+func TestBotControlGetOpenOrders(t *testing.T) {
+	b := BotControl{
+		orders: []base.ControlOrder{
+			{Exchange: "Bitfinex", OrderID: "1"},
+			{Exchange: "Kraken", OrderID: "2"},
+		},
+	}
+
+	orders, err := b.GetOpenOrders("")
+	if err != nil || len(orders) != 2 {
+		t.Error("Test failed. BotControl GetOpenOrders() error", err, orders)
+	}
+
+	orders, err = b.GetOpenOrders("bitfinex")
+	if err != nil || len(orders) != 1 || orders[0].OrderID != "1" {
+		t.Error("Test failed. BotControl GetOpenOrders() error", err, orders)
+	}
+}
+
+func TestBotControlDryRun(t *testing.T) {
+	SetupTest(t)
+	bot.dryRun = true
+	defer func() { bot.dryRun = false }()
+
+	var b BotControl
+	_, err := b.SubmitLimitOrder("Bitfinex", "BTCUSD", "buy", 1, 1)
+	if err != ErrDryRunEnabled {
+		t.Error("Test failed. BotControl SubmitLimitOrder() error", err)
+	}
+
+	err = b.CancelAllOrders("Bitfinex")
+	if err != ErrDryRunEnabled {
+		t.Error("Test failed. BotControl CancelAllOrders() error", err)
+	}
+}
+
+func TestBotControlSetExchangeEnabled(t *testing.T) {
+	SetupTest(t)
+
+	var b BotControl
+	err := b.SetExchangeEnabled("Asdsad", true)
+	if err != ErrExchangeNotFound {
+		t.Error("Test failed. BotControl SetExchangeEnabled() error", err)
+	}
+
+	err = b.SetExchangeEnabled("bitfinex", true)
+	if err != ErrExchangeAlreadyActive {
+		t.Error("Test failed. BotControl SetExchangeEnabled() error", err)
+	}
+
+	err = b.SetExchangeEnabled("bitfinex", false)
+	if err != nil || CheckExchangeExists("Bitfinex") {
+		t.Error("Test failed. BotControl SetExchangeEnabled() error", err)
+	}
+}
+
+func TestBotControlSetUpdatersPaused(t *testing.T) {
+	var b BotControl
+	b.SetUpdatersPaused(true)
+	if !UpdatersPaused() {
+		t.Error("Test failed. BotControl SetUpdatersPaused() error")
+	}
+
+	b.SetUpdatersPaused(false)
+	if UpdatersPaused() {
+		t.Error("Test failed. BotControl SetUpdatersPaused() error")
+	}
+}
+
+func TestBotControlOrderTypeNotSupported(t *testing.T) {
+	SetupTest(t)
+	exch := GetExchangeByName("Bitfinex").(*bitfinex.Bitfinex)
+	orderTypes := exch.SupportedOrderTypes
+	exch.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	defer func() { exch.SupportedOrderTypes = orderTypes }()
+
+	var b BotControl
+	_, err := b.SubmitOrder("Bitfinex", "BTCUSD", "buy", "market", 1, 0, "")
+	if err != ErrOrderTypeNotSupported {
+		t.Error("Test failed. BotControl SubmitOrder() error", err)
+	}
+}
+
+// orderInfoExchange reports the supplied order details from GetOrderInfo
+type orderInfoExchange struct {
+	*bitfinex.Bitfinex
+	details map[int64]exchange.OrderDetail
+}
+
+func (o *orderInfoExchange) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+	return o.details[orderID], nil
+}
+
+// eventMedium records the events pushed to it
+type eventMedium struct {
+	events []base.Event
+}
+
+func (e *eventMedium) Setup(config.CommunicationsConfig) {}
+func (e *eventMedium) Connect() error                    { return nil }
+func (e *eventMedium) IsEnabled() bool                   { return true }
+func (e *eventMedium) IsConnected() bool                 { return true }
+func (e *eventMedium) GetName() string                   { return "EventMedium" }
+
+func (e *eventMedium) PushEvent(event base.Event) error {
+	e.events = append(e.events, event)
+	return nil
+}
+
+func TestBotControlGetOpenOrdersPrunesClosedOrders(t *testing.T) {
+	SetupTest(t)
+
+	medium := new(eventMedium)
+	comms := bot.comms
+	bot.comms = &communications.Communications{IComm: base.IComm{medium}}
+	defer func() { bot.comms = comms }()
+
+	exch := &orderInfoExchange{
+		Bitfinex: new(bitfinex.Bitfinex),
+		details: map[int64]exchange.OrderDetail{
+			1: {Status: "Open", Amount: 1, OpenVolume: 1},
+			2: {Status: "Fully Matched", Amount: 1},
+			3: {Status: "Cancelled", Amount: 1, OpenVolume: 1},
+			4: {Status: "Partially Matched", Amount: 1, OpenVolume: 0.5},
+		},
+	}
+	exch.SetDefaults()
+	exch.Name = "OrderInfoTest"
+	exch.UnsupportedFunctions = nil
+	bot.exchanges = append(bot.exchanges, exch)
+	defer func() { bot.exchanges = bot.exchanges[:len(bot.exchanges)-1] }()
+
+	b := BotControl{
+		orders: []base.ControlOrder{
+			{Exchange: "OrderInfoTest", OrderID: "1"},
+			{Exchange: "OrderInfoTest", OrderID: "2"},
+			{Exchange: "OrderInfoTest", OrderID: "3"},
+			{Exchange: "OrderInfoTest", OrderID: "4"},
+		},
+	}
+
+	orders, err := b.GetOpenOrders("orderinfotest")
+	if err != nil || len(orders) != 2 || orders[0].OrderID != "1" ||
+		orders[1].OrderID != "4" {
+		t.Error("Test failed. BotControl GetOpenOrders() error", err, orders)
+	}
+
+	if len(b.orders) != 2 {
+		t.Error("Test failed. BotControl GetOpenOrders() closed orders not pruned",
+			b.orders)
+	}
+
+	if len(medium.events) != 1 || medium.events[0].Category != base.CategoryTradeFill {
+		t.Error("Test failed. BotControl GetOpenOrders() trade fill not pushed",
+			medium.events)
+	}
+}
+
+func TestClosedOrderStatus(t *testing.T) {
+	tests := []struct {
+		detail exchange.OrderDetail
+		status string
+	}{
+		{exchange.OrderDetail{Status: "Open", OpenVolume: 1}, ""},
+		{exchange.OrderDetail{Status: "Partially Matched", OpenVolume: 0.5}, ""},
+		{exchange.OrderDetail{Status: "Fully Matched"}, OrderStatusFilled},
+		{exchange.OrderDetail{Status: "filled"}, OrderStatusFilled},
+		{exchange.OrderDetail{Status: "Cancelled", OpenVolume: 1}, OrderStatusCancelled},
+		{exchange.OrderDetail{Status: "Rejected"}, OrderStatusCancelled},
+	}
+
+	for i := range tests {
+		if s := closedOrderStatus(tests[i].detail); s != tests[i].status {
+			t.Errorf("Test failed. closedOrderStatus() %s returned %q, expected %q",
+				tests[i].detail.Status, s, tests[i].status)
+		}
+	}
+}
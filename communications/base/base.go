@@ -58,11 +58,21 @@ type Base struct {
 	Connected bool
 }
 
-// Event is a generalise event type
+// Event is a generalise event type, Severity and Category are used to route
+// the event to the communication mediums subscribed to it
 type Event struct {
 	Type         string
 	GainLoss     string
 	TradeDetails string
+	Severity     string
+	Category     string
+}
+
+// String returns a single line summary of the event for the communication
+// mediums
+func (e *Event) String() string {
+	return fmt.Sprintf("Type: %s Details: %s GainOrLoss: %s",
+		e.Type, e.TradeDetails, e.GainLoss)
 }
 
 // IsEnabled returns if the comms package has been enabled in the configuration
@@ -144,6 +154,44 @@ func (b *Base) GetOrderbook(exchangeName string) string {
 	return common.JoinStrings(packagedOrderbooks, "\n")
 }
 
+// GetStagedTickers returns a copy of the staged ticker data for all exchanges
+// keyed by exchange name, asset type and currency pair
+func (b *Base) GetStagedTickers() map[string]map[string]map[string]ticker.Price {
+	m.Lock()
+	defer m.Unlock()
+
+	tickers := make(map[string]map[string]map[string]ticker.Price)
+	for exchangeName, assets := range TickerStaged {
+		tickers[exchangeName] = make(map[string]map[string]ticker.Price)
+		for assetType, pairs := range assets {
+			tickers[exchangeName][assetType] = make(map[string]ticker.Price)
+			for currencyPair, price := range pairs {
+				tickers[exchangeName][assetType][currencyPair] = price
+			}
+		}
+	}
+	return tickers
+}
+
+// GetStagedOrderbooks returns a copy of the staged orderbook data for all
+// exchanges keyed by exchange name, asset type and currency pair
+func (b *Base) GetStagedOrderbooks() map[string]map[string]map[string]Orderbook {
+	m.Lock()
+	defer m.Unlock()
+
+	orderbooks := make(map[string]map[string]map[string]Orderbook)
+	for exchangeName, assets := range OrderbookStaged {
+		orderbooks[exchangeName] = make(map[string]map[string]Orderbook)
+		for assetType, pairs := range assets {
+			orderbooks[exchangeName][assetType] = make(map[string]Orderbook)
+			for currencyPair, ob := range pairs {
+				orderbooks[exchangeName][assetType][currencyPair] = ob
+			}
+		}
+	}
+	return orderbooks
+}
+
 // GetPortfolio returns staged portfolio info
 func (b *Base) GetPortfolio() string {
 	m.Lock()
@@ -0,0 +1,388 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+// Control commands shared by the chat based communication mediums, each medium
+// supplies its own command prefix
+const (
+	CmdOpenOrders      = "openorders"
+	CmdCancelOrder     = "cancelorder"
+	CmdCancelAllOrders = "cancelallorders"
+	CmdLimitOrder      = "limitorder"
+	CmdEnableExchange  = "enableexchange"
+	CmdDisableExchange = "disableexchange"
+	CmdPause           = "pause"
+	CmdResume          = "resume"
+	CmdConfirm         = "confirm"
+
+	// ConfirmTimeout is how long a command which moves money waits for the
+	// user to confirm it before it is discarded
+	ConfirmTimeout = time.Minute
+
+	confirmCodeLength = 3
+)
+
+var (
+	botControl IBotControl
+	controlMtx sync.Mutex
+
+	errBotControlNotSet = errors.New("bot control is not available")
+
+	roleLevels = map[string]int{
+		config.CommsRoleViewer: 1,
+		config.CommsRoleTrader: 2,
+		config.CommsRoleAdmin:  3,
+	}
+
+	commandRoles = map[string]string{
+		CmdOpenOrders:      config.CommsRoleViewer,
+		CmdCancelOrder:     config.CommsRoleTrader,
+		CmdCancelAllOrders: config.CommsRoleTrader,
+		CmdLimitOrder:      config.CommsRoleTrader,
+		CmdEnableExchange:  config.CommsRoleAdmin,
+		CmdDisableExchange: config.CommsRoleAdmin,
+		CmdPause:           config.CommsRoleAdmin,
+		CmdResume:          config.CommsRoleAdmin,
+		CmdConfirm:         config.CommsRoleViewer,
+	}
+
+	// confirmRequired holds the commands that move money and must be confirmed
+	// before they are executed
+	confirmRequired = map[string]bool{
+		CmdCancelOrder:     true,
+		CmdCancelAllOrders: true,
+		CmdLimitOrder:      true,
+	}
+)
+
+// IBotControl is implemented by the bot so the communication mediums are able
+// to issue trading and control commands
+type IBotControl interface {
+	GetOpenOrders(exchangeName string) ([]ControlOrder, error)
+	CancelOrder(exchangeName, orderID string) error
+	CancelAllOrders(exchangeName string) error
+	SubmitLimitOrder(exchangeName, currencyPair, side string, amount, price float64) (string, error)
+	SetExchangeEnabled(exchangeName string, enabled bool) error
+	SetUpdatersPaused(paused bool)
+}
+
+// ControlOrder holds the minimal order details sent to a communication medium
+type ControlOrder struct {
+	Exchange     string
+	OrderID      string
+	CurrencyPair string
+	Side         string
+	OrderType    string
+	Amount       float64
+	Price        float64
+}
+
+// pendingCommand is a command awaiting confirmation from the user who issued
+// it
+type pendingCommand struct {
+	userID  string
+	args    []string
+	expires time.Time
+}
+
+// CommandHandler authorises and runs control commands on behalf of a
+// communication medium
+type CommandHandler struct {
+	users   map[string]string
+	pending map[string]pendingCommand
+	mtx     sync.Mutex
+}
+
+// SetBotControl sets the bot control used to run control commands
+func SetBotControl(c IBotControl) {
+	controlMtx.Lock()
+	botControl = c
+	controlMtx.Unlock()
+}
+
+func getBotControl() (IBotControl, error) {
+	controlMtx.Lock()
+	defer controlMtx.Unlock()
+	if botControl == nil {
+		return nil, errBotControlNotSet
+	}
+	return botControl, nil
+}
+
+// NewCommandHandler returns a command handler for the supplied authorised
+// users
+func NewCommandHandler(users []config.CommsUser) *CommandHandler {
+	c := &CommandHandler{
+		users:   make(map[string]string),
+		pending: make(map[string]pendingCommand),
+	}
+	for i := range users {
+		c.users[users[i].ID] = users[i].Role
+	}
+	return c
+}
+
+// IsControlCommand returns whether the text, stripped of the mediums command
+// prefix, is a control command
+func IsControlCommand(text string) bool {
+	_, ok := commandRoles[commandName(text)]
+	return ok
+}
+
+// ControlHelp returns the control command list using the supplied prefix
+func ControlHelp(prefix string) string {
+	return fmt.Sprintf(`Control commands (require an authorised user):
+	%[1]sopenorders [exchange] 	- Lists open orders placed by the bot
+	%[1]scancelorder <exchange> <orderID> 	- Cancels an order
+	%[1]scancelallorders <exchange> 	- Cancels all orders on an exchange
+	%[1]slimitorder <exchange> <pair> <buy|sell> <amount> <price> 	- Places a limit order
+	%[1]senableexchange <exchange> 	- Enables an exchange
+	%[1]sdisableexchange <exchange> 	- Disables an exchange
+	%[1]spause / %[1]sresume 	- Pauses or resumes the ticker and orderbook updaters
+	%[1]sconfirm <code> 	- Confirms a pending order command`, prefix)
+}
+
+// Handle authorises and runs a control command for a user, the text must be
+// stripped of the mediums command prefix. The returned string is the reply to
+// send back to the user
+func (c *CommandHandler) Handle(userID, text string) string {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "command not recognized"
+	}
+	fields[0] = commandName(fields[0])
+
+	required, ok := commandRoles[fields[0]]
+	if !ok {
+		return fmt.Sprintf("command %s not recognized", fields[0])
+	}
+
+	if !c.authorised(userID, required) {
+		return fmt.Sprintf("user %s is not authorised to run %s", userID, fields[0])
+	}
+
+	if fields[0] == CmdConfirm {
+		return c.confirm(userID, fields)
+	}
+
+	if err := validateArgs(fields); err != nil {
+		return err.Error()
+	}
+
+	if confirmRequired[fields[0]] {
+		code, err := c.addPending(userID, fields)
+		if err != nil {
+			return fmt.Sprintf("unable to queue %s: %s", fields[0], err)
+		}
+		return fmt.Sprintf("%s requires confirmation, reply with confirm %s within %s",
+			common.JoinStrings(fields, " "), code, ConfirmTimeout)
+	}
+	return execute(fields)
+}
+
+// authorised returns whether the user holds the required role or higher
+func (c *CommandHandler) authorised(userID, required string) bool {
+	role, ok := c.users[userID]
+	if !ok {
+		return false
+	}
+	return roleLevels[role] >= roleLevels[required]
+}
+
+// addPending stores a command awaiting confirmation and returns the code the
+// user must reply with
+func (c *CommandHandler) addPending(userID string, args []string) (string, error) {
+	salt, err := common.GetRandomSalt(nil, confirmCodeLength)
+	if err != nil {
+		return "", err
+	}
+	code := common.HexEncodeToString(salt)
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for k, v := range c.pending {
+		if time.Now().After(v.expires) {
+			delete(c.pending, k)
+		}
+	}
+	c.pending[code] = pendingCommand{
+		userID:  userID,
+		args:    args,
+		expires: time.Now().Add(ConfirmTimeout),
+	}
+	return code, nil
+}
+
+// confirm runs a pending command if the code is valid, has not expired and was
+// issued by the same user
+func (c *CommandHandler) confirm(userID string, fields []string) string {
+	if len(fields) != 2 {
+		return "usage: confirm <code>"
+	}
+
+	c.mtx.Lock()
+	p, ok := c.pending[fields[1]]
+	if ok && p.userID == userID {
+		delete(c.pending, fields[1])
+	}
+	c.mtx.Unlock()
+
+	if !ok || p.userID != userID {
+		return fmt.Sprintf("confirmation code %s not found", fields[1])
+	}
+
+	if time.Now().After(p.expires) {
+		return fmt.Sprintf("confirmation code %s has expired", fields[1])
+	}
+
+	if !c.authorised(userID, commandRoles[p.args[0]]) {
+		return fmt.Sprintf("user %s is not authorised to run %s", userID, p.args[0])
+	}
+	return execute(p.args)
+}
+
+// validateArgs checks a commands arguments before it is queued or executed
+func validateArgs(fields []string) error {
+	var expected int
+	var usage string
+	switch fields[0] {
+	case CmdOpenOrders:
+		if len(fields) > 2 {
+			return errors.New("usage: openorders [exchange]")
+		}
+		return nil
+	case CmdCancelOrder:
+		expected, usage = 3, "cancelorder <exchange> <orderID>"
+	case CmdCancelAllOrders:
+		expected, usage = 2, "cancelallorders <exchange>"
+	case CmdLimitOrder:
+		expected, usage = 6, "limitorder <exchange> <pair> <buy|sell> <amount> <price>"
+	case CmdEnableExchange:
+		expected, usage = 2, "enableexchange <exchange>"
+	case CmdDisableExchange:
+		expected, usage = 2, "disableexchange <exchange>"
+	default:
+		return nil
+	}
+
+	if len(fields) != expected {
+		return fmt.Errorf("usage: %s", usage)
+	}
+
+	if fields[0] == CmdLimitOrder {
+		side := common.StringToLower(fields[3])
+		if side != "buy" && side != "sell" {
+			return fmt.Errorf("invalid order side %s, must be buy or sell", fields[3])
+		}
+		for _, v := range fields[4:] {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f <= 0 {
+				return fmt.Errorf("invalid amount or price %s", v)
+			}
+		}
+	}
+	return nil
+}
+
+// execute runs a validated command against the bot control
+func execute(fields []string) string {
+	control, err := getBotControl()
+	if err != nil {
+		return err.Error()
+	}
+
+	switch fields[0] {
+	case CmdOpenOrders:
+		var exchangeName string
+		if len(fields) == 2 {
+			exchangeName = fields[1]
+		}
+		orders, err := control.GetOpenOrders(exchangeName)
+		if err != nil {
+			return fmt.Sprintf("unable to get open orders: %s", err)
+		}
+		if len(orders) == 0 {
+			return "no open orders"
+		}
+		var lines []string
+		for i := range orders {
+			lines = append(lines, fmt.Sprintf(
+				"Exchange: %s OrderID: %s Currency Pair: %s Side: %s Amount: %f Price: %f",
+				orders[i].Exchange,
+				orders[i].OrderID,
+				orders[i].CurrencyPair,
+				orders[i].Side,
+				orders[i].Amount,
+				orders[i].Price))
+		}
+		return common.JoinStrings(lines, "\n")
+
+	case CmdCancelOrder:
+		err = control.CancelOrder(fields[1], fields[2])
+		if err != nil {
+			return fmt.Sprintf("unable to cancel order %s: %s", fields[2], err)
+		}
+		return fmt.Sprintf("order %s cancelled", fields[2])
+
+	case CmdCancelAllOrders:
+		err = control.CancelAllOrders(fields[1])
+		if err != nil {
+			return fmt.Sprintf("unable to cancel all %s orders: %s", fields[1], err)
+		}
+		return fmt.Sprintf("all %s orders cancelled", fields[1])
+
+	case CmdLimitOrder:
+		amount, _ := strconv.ParseFloat(fields[4], 64)
+		price, _ := strconv.ParseFloat(fields[5], 64)
+		orderID, err := control.SubmitLimitOrder(fields[1], fields[2],
+			common.StringToLower(fields[3]), amount, price)
+		if err != nil {
+			return fmt.Sprintf("unable to place limit order: %s", err)
+		}
+		return fmt.Sprintf("limit order placed, order ID %s", orderID)
+
+	case CmdEnableExchange, CmdDisableExchange:
+		enabled := fields[0] == CmdEnableExchange
+		err = control.SetExchangeEnabled(fields[1], enabled)
+		if err != nil {
+			return fmt.Sprintf("unable to update exchange %s: %s", fields[1], err)
+		}
+		if enabled {
+			return fmt.Sprintf("exchange %s enabled", fields[1])
+		}
+		return fmt.Sprintf("exchange %s disabled", fields[1])
+
+	case CmdPause:
+		control.SetUpdatersPaused(true)
+		return "updater routines paused"
+
+	case CmdResume:
+		control.SetUpdatersPaused(false)
+		return "updater routines resumed"
+	}
+	return fmt.Sprintf("command %s not recognized", fields[0])
+}
+
+// commandName returns the lower case command name from the first word of the
+// text, dropping any @botname suffix added by the chat client
+func commandName(text string) string {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return ""
+	}
+	name := common.StringToLower(fields[0])
+	if idx := strings.Index(name, "@"); idx > 0 {
+		name = name[:idx]
+	}
+	return name
+}
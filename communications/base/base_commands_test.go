@@ -0,0 +1,159 @@
+package base
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+type mockControl struct {
+	cancelled []string
+	placed    int
+	paused    bool
+	enabled   map[string]bool
+}
+
+func (c *mockControl) GetOpenOrders(exchangeName string) ([]ControlOrder, error) {
+	return []ControlOrder{{Exchange: "Bitfinex", OrderID: "1337"}}, nil
+}
+
+func (c *mockControl) CancelOrder(exchangeName, orderID string) error {
+	c.cancelled = append(c.cancelled, orderID)
+	return nil
+}
+
+func (c *mockControl) CancelAllOrders(exchangeName string) error {
+	c.cancelled = append(c.cancelled, "all")
+	return nil
+}
+
+func (c *mockControl) SubmitLimitOrder(exchangeName, currencyPair, side string, amount, price float64) (string, error) {
+	c.placed++
+	return "1", nil
+}
+
+func (c *mockControl) SetExchangeEnabled(exchangeName string, enabled bool) error {
+	c.enabled[exchangeName] = enabled
+	return nil
+}
+
+func (c *mockControl) SetUpdatersPaused(paused bool) {
+	c.paused = paused
+}
+
+func newTestHandler() (*CommandHandler, *mockControl) {
+	control := &mockControl{enabled: make(map[string]bool)}
+	SetBotControl(control)
+	return NewCommandHandler([]config.CommsUser{
+		{ID: "viewer", Role: config.CommsRoleViewer},
+		{ID: "trader", Role: config.CommsRoleTrader},
+		{ID: "admin", Role: config.CommsRoleAdmin},
+	}), control
+}
+
+func confirmCode(t *testing.T, reply string) string {
+	idx := strings.Index(reply, CmdConfirm+" ")
+	if idx == -1 {
+		t.Fatal("test failed - base Handle() no confirmation code in reply", reply)
+	}
+	return strings.Fields(reply[idx:])[1]
+}
+
+func TestIsControlCommand(t *testing.T) {
+	if !IsControlCommand("cancelorder Bitfinex 1") ||
+		!IsControlCommand("PAUSE@gctbot") ||
+		IsControlCommand("orderbooks") ||
+		IsControlCommand("") {
+		t.Error("test failed - base IsControlCommand() error")
+	}
+}
+
+func TestHandleAuthorisation(t *testing.T) {
+	h, control := newTestHandler()
+
+	reply := h.Handle("stranger", "openorders")
+	if !strings.Contains(reply, "not authorised") {
+		t.Error("test failed - base Handle() unknown user authorised", reply)
+	}
+
+	reply = h.Handle("viewer", "openorders")
+	if !strings.Contains(reply, "1337") {
+		t.Error("test failed - base Handle() openorders error", reply)
+	}
+
+	reply = h.Handle("viewer", "cancelallorders Bitfinex")
+	if !strings.Contains(reply, "not authorised") {
+		t.Error("test failed - base Handle() viewer authorised to trade", reply)
+	}
+
+	reply = h.Handle("trader", "pause")
+	if !strings.Contains(reply, "not authorised") || control.paused {
+		t.Error("test failed - base Handle() trader authorised to pause", reply)
+	}
+
+	h.Handle("admin", "pause")
+	if !control.paused {
+		t.Error("test failed - base Handle() admin unable to pause")
+	}
+
+	h.Handle("admin", "disableexchange Bitfinex")
+	if enabled, ok := control.enabled["Bitfinex"]; !ok || enabled {
+		t.Error("test failed - base Handle() admin unable to disable exchange")
+	}
+}
+
+func TestHandleConfirmation(t *testing.T) {
+	h, control := newTestHandler()
+
+	reply := h.Handle("trader", "limitorder Bitfinex BTCUSD buy 1 100")
+	if control.placed != 0 {
+		t.Fatal("test failed - base Handle() order placed without confirmation")
+	}
+	code := confirmCode(t, reply)
+
+	reply = h.Handle("admin", "confirm "+code)
+	if control.placed != 0 || !strings.Contains(reply, "not found") {
+		t.Error("test failed - base Handle() order confirmed by another user", reply)
+	}
+
+	h.Handle("trader", "confirm "+code)
+	if control.placed != 1 {
+		t.Error("test failed - base Handle() confirmed order not placed")
+	}
+
+	h.Handle("trader", "confirm "+code)
+	if control.placed != 1 {
+		t.Error("test failed - base Handle() confirmation code reused")
+	}
+
+	code = confirmCode(t, h.Handle("trader", "cancelorder Bitfinex 1337"))
+	h.mtx.Lock()
+	p := h.pending[code]
+	p.expires = time.Now().Add(-time.Second)
+	h.pending[code] = p
+	h.mtx.Unlock()
+
+	reply = h.Handle("trader", "confirm "+code)
+	if len(control.cancelled) != 0 || !strings.Contains(reply, "expired") {
+		t.Error("test failed - base Handle() expired confirmation executed", reply)
+	}
+}
+
+func TestHandleValidation(t *testing.T) {
+	h, _ := newTestHandler()
+
+	for _, cmd := range []string{
+		"cancelorder Bitfinex",
+		"limitorder Bitfinex BTCUSD hold 1 100",
+		"limitorder Bitfinex BTCUSD buy -1 100",
+		"limitorder Bitfinex BTCUSD buy 1 abc",
+		"enableexchange",
+	} {
+		reply := h.Handle("admin", cmd)
+		if strings.Contains(reply, CmdConfirm+" ") {
+			t.Errorf("test failed - base Handle() invalid command %q queued", cmd)
+		}
+	}
+}
@@ -39,14 +39,24 @@ func (c IComm) Setup() {
 	}
 }
 
-// PushEvent pushes triggered events to all enabled communication links
+// PushEvent pushes triggered events to all enabled communication links that
+// the alert rules route the event to. Events without a severity are treated as
+// informational
 func (c IComm) PushEvent(event Event) {
+	if event.Severity == "" {
+		event.Severity = SeverityInfo
+	}
+
+	router := getAlertRouter()
 	for i := range c {
 		if c[i].IsEnabled() && c[i].IsConnected() {
+			if router != nil && !router.Allow(c[i].GetName(), event) {
+				continue
+			}
 			err := c[i].PushEvent(event)
 			if err != nil {
-				log.Printf("Communications error - PushEvent() in package %s with %v",
-					c[i].GetName(), event)
+				log.Printf("Communications error - PushEvent() in package %s with %v. Err: %s",
+					c[i].GetName(), event, err)
 			}
 		}
 	}
@@ -0,0 +1,182 @@
+package base
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+// Event severities and categories
+const (
+	SeverityInfo     = config.AlertSeverityInfo
+	SeverityWarning  = config.AlertSeverityWarning
+	SeverityCritical = config.AlertSeverityCritical
+
+	CategoryTradeFill           = config.AlertCategoryTradeFill
+	CategoryEventTrigger        = config.AlertCategoryEventTrigger
+	CategoryWebsocketDisconnect = config.AlertCategoryWebsocketDisconnect
+	CategoryError               = config.AlertCategoryError
+)
+
+var (
+	alertRouter   *AlertRouter
+	alertRouterMu sync.Mutex
+)
+
+// AlertRouter decides which events are delivered to each communication medium
+// based on the configured alert rules
+type AlertRouter struct {
+	routes map[string]*alertRoute
+	now    func() time.Time
+	mtx    sync.Mutex
+}
+
+// alertRoute holds a mediums alert rule and its delivery history
+type alertRoute struct {
+	rule       config.AlertRule
+	quietStart int
+	quietEnd   int
+	sent       []time.Time
+	lastSeen   map[string]time.Time
+	suppressed int
+}
+
+// SetAlertRules sets the alert rules used when pushing events to the
+// communication mediums
+func SetAlertRules(rules []config.AlertRule) {
+	alertRouterMu.Lock()
+	alertRouter = NewAlertRouter(rules)
+	alertRouterMu.Unlock()
+}
+
+func getAlertRouter() *AlertRouter {
+	alertRouterMu.Lock()
+	defer alertRouterMu.Unlock()
+	return alertRouter
+}
+
+// NewAlertRouter returns an alert router for the supplied rules
+func NewAlertRouter(rules []config.AlertRule) *AlertRouter {
+	r := &AlertRouter{
+		routes: make(map[string]*alertRoute),
+		now:    time.Now,
+	}
+	for i := range rules {
+		route := &alertRoute{
+			rule:       rules[i],
+			lastSeen:   make(map[string]time.Time),
+			quietStart: -1,
+			quietEnd:   -1,
+		}
+		if rules[i].QuietHoursStart != "" && rules[i].QuietHoursEnd != "" {
+			route.quietStart = minutesOfDay(rules[i].QuietHoursStart)
+			route.quietEnd = minutesOfDay(rules[i].QuietHoursEnd)
+		}
+		if route.rule.RateLimit > 0 && route.rule.RateLimitWindow <= 0 {
+			route.rule.RateLimitWindow = time.Minute
+		}
+		r.routes[rules[i].Medium] = route
+	}
+	return r
+}
+
+// Allow returns whether an event should be delivered to a medium and records
+// the delivery against the mediums rate limit and de-duplication history
+func (r *AlertRouter) Allow(medium string, event Event) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	route, ok := r.routes[medium]
+	if !ok {
+		return true
+	}
+
+	if len(route.rule.Categories) > 0 &&
+		!common.StringDataCompare(route.rule.Categories, event.Category) {
+		return false
+	}
+
+	severity := config.AlertSeverityLevels[event.Severity]
+	if route.rule.MinSeverity != "" &&
+		severity < config.AlertSeverityLevels[route.rule.MinSeverity] {
+		return false
+	}
+
+	now := r.now()
+	if severity < config.AlertSeverityLevels[SeverityCritical] && route.inQuietHours(now) {
+		route.suppressed++
+		return false
+	}
+
+	key := event.Category + "|" + event.Severity + "|" + event.String()
+	if route.rule.DedupWindow > 0 {
+		if last, ok := route.lastSeen[key]; ok && now.Sub(last) < route.rule.DedupWindow {
+			route.suppressed++
+			return false
+		}
+		for k, v := range route.lastSeen {
+			if now.Sub(v) >= route.rule.DedupWindow {
+				delete(route.lastSeen, k)
+			}
+		}
+	}
+
+	if route.rule.RateLimit > 0 {
+		var sent []time.Time
+		for i := range route.sent {
+			if now.Sub(route.sent[i]) < route.rule.RateLimitWindow {
+				sent = append(sent, route.sent[i])
+			}
+		}
+		route.sent = sent
+		if len(route.sent) >= route.rule.RateLimit {
+			route.suppressed++
+			return false
+		}
+		route.sent = append(route.sent, now)
+	}
+
+	if route.rule.DedupWindow > 0 {
+		route.lastSeen[key] = now
+	}
+	return true
+}
+
+// Suppressed returns how many events have been dropped for a medium by its
+// quiet hours, de-duplication and rate limit
+func (r *AlertRouter) Suppressed(medium string) int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	route, ok := r.routes[medium]
+	if !ok {
+		return 0
+	}
+	return route.suppressed
+}
+
+// inQuietHours returns whether the time falls within the routes quiet hours,
+// the quiet hours may span midnight
+func (a *alertRoute) inQuietHours(t time.Time) bool {
+	if a.quietStart < 0 || a.quietStart == a.quietEnd {
+		return false
+	}
+	now := t.Hour()*60 + t.Minute()
+	if a.quietStart < a.quietEnd {
+		return now >= a.quietStart && now < a.quietEnd
+	}
+	return now >= a.quietStart || now < a.quietEnd
+}
+
+// minutesOfDay converts a HH:MM time to minutes past midnight, returning -1 if
+// it cannot be parsed
+func minutesOfDay(hhmm string) int {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		log.Printf("Communications: invalid quiet hours time %s", hhmm)
+		return -1
+	}
+	return t.Hour()*60 + t.Minute()
+}
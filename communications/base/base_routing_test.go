@@ -0,0 +1,108 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+func newTestRouter(rule config.AlertRule, now time.Time) *AlertRouter {
+	rule.Medium = "SMSGlobal"
+	r := NewAlertRouter([]config.AlertRule{rule})
+	r.now = func() time.Time { return now }
+	return r
+}
+
+func TestAlertRouterNoRule(t *testing.T) {
+	r := NewAlertRouter(nil)
+	if !r.Allow("Slack", Event{}) {
+		t.Error("test failed - base Allow() medium without rule filtered")
+	}
+}
+
+func TestAlertRouterFilters(t *testing.T) {
+	r := newTestRouter(config.AlertRule{
+		Categories:  []string{CategoryError, CategoryTradeFill},
+		MinSeverity: SeverityWarning,
+	}, time.Now())
+
+	if r.Allow("SMSGlobal", Event{Category: CategoryEventTrigger, Severity: SeverityCritical}) {
+		t.Error("test failed - base Allow() unsubscribed category delivered")
+	}
+
+	if r.Allow("SMSGlobal", Event{Category: CategoryError, Severity: SeverityInfo}) {
+		t.Error("test failed - base Allow() low severity delivered")
+	}
+
+	if !r.Allow("SMSGlobal", Event{Category: CategoryError, Severity: SeverityWarning}) {
+		t.Error("test failed - base Allow() subscribed event filtered")
+	}
+}
+
+func TestAlertRouterRateLimit(t *testing.T) {
+	now := time.Now()
+	r := newTestRouter(config.AlertRule{
+		RateLimit:       2,
+		RateLimitWindow: time.Minute,
+	}, now)
+
+	for i := 0; i < 2; i++ {
+		if !r.Allow("SMSGlobal", Event{Type: "test"}) {
+			t.Fatal("test failed - base Allow() event within rate limit filtered")
+		}
+	}
+
+	if r.Allow("SMSGlobal", Event{Type: "test"}) || r.Suppressed("SMSGlobal") != 1 {
+		t.Error("test failed - base Allow() rate limit exceeded")
+	}
+
+	r.now = func() time.Time { return now.Add(time.Minute) }
+	if !r.Allow("SMSGlobal", Event{Type: "test"}) {
+		t.Error("test failed - base Allow() rate limit not reset after window")
+	}
+}
+
+func TestAlertRouterDedup(t *testing.T) {
+	now := time.Now()
+	r := newTestRouter(config.AlertRule{DedupWindow: time.Minute}, now)
+
+	e := Event{Type: "disconnect", Category: CategoryWebsocketDisconnect}
+	if !r.Allow("SMSGlobal", e) {
+		t.Fatal("test failed - base Allow() first event filtered")
+	}
+
+	if r.Allow("SMSGlobal", e) {
+		t.Error("test failed - base Allow() duplicate event delivered")
+	}
+
+	if !r.Allow("SMSGlobal", Event{Type: "other"}) {
+		t.Error("test failed - base Allow() different event filtered")
+	}
+
+	r.now = func() time.Time { return now.Add(time.Minute) }
+	if !r.Allow("SMSGlobal", e) {
+		t.Error("test failed - base Allow() event filtered after dedup window")
+	}
+}
+
+func TestAlertRouterQuietHours(t *testing.T) {
+	night := time.Date(2018, 1, 1, 23, 30, 0, 0, time.Local)
+	r := newTestRouter(config.AlertRule{
+		QuietHoursStart: "22:00",
+		QuietHoursEnd:   "07:00",
+	}, night)
+
+	if r.Allow("SMSGlobal", Event{Severity: SeverityWarning}) {
+		t.Error("test failed - base Allow() event delivered during quiet hours")
+	}
+
+	if !r.Allow("SMSGlobal", Event{Severity: SeverityCritical}) {
+		t.Error("test failed - base Allow() critical event filtered during quiet hours")
+	}
+
+	r.now = func() time.Time { return night.Add(time.Hour * 8) }
+	if !r.Allow("SMSGlobal", Event{Severity: SeverityWarning}) {
+		t.Error("test failed - base Allow() event filtered outside quiet hours")
+	}
+}
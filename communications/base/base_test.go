@@ -50,6 +50,20 @@ func TestGetOrderbook(t *testing.T) {
 	}
 }
 
+func TestGetStagedTickers(t *testing.T) {
+	v := b.GetStagedTickers()
+	if len(v) != 0 {
+		t.Error("test failed - base GetStagedTickers() error")
+	}
+}
+
+func TestGetStagedOrderbooks(t *testing.T) {
+	v := b.GetStagedOrderbooks()
+	if len(v) != 0 {
+		t.Error("test failed - base GetStagedOrderbooks() error")
+	}
+}
+
 func TestGetPortfolio(t *testing.T) {
 	v := b.GetPortfolio()
 	if v != "{}" {
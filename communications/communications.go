@@ -1,11 +1,16 @@
 package communications
 
 import (
+	"log"
+
 	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/communications/discord"
+	"github.com/thrasher-/gocryptotrader/communications/matrix"
 	"github.com/thrasher-/gocryptotrader/communications/slack"
 	"github.com/thrasher-/gocryptotrader/communications/smsglobal"
 	"github.com/thrasher-/gocryptotrader/communications/smtpservice"
 	"github.com/thrasher-/gocryptotrader/communications/telegram"
+	"github.com/thrasher-/gocryptotrader/communications/webhook"
 	"github.com/thrasher-/gocryptotrader/config"
 )
 
@@ -18,30 +23,117 @@ type Communications struct {
 func NewComm(config config.CommunicationsConfig) *Communications {
 	var comm Communications
 
-	if config.TelegramConfig.Enabled {
-		Telegram := new(telegram.Telegram)
-		Telegram.Setup(config)
-		comm.IComm = append(comm.IComm, Telegram)
+	for _, name := range mediums {
+		medium := newMedium(name)
+		medium.Setup(config)
+		if medium.IsEnabled() {
+			comm.IComm = append(comm.IComm, medium)
+		}
+	}
+
+	base.SetAlertRules(config.AlertRules)
+	comm.Setup()
+	return &comm
+}
+
+// mediums holds the communications config names in the order the mediums are
+// set up
+var mediums = []string{
+	config.CommsTelegram,
+	config.CommsSMSGlobal,
+	config.CommsSMTP,
+	config.CommsSlack,
+	config.CommsWebhook,
+	config.CommsDiscord,
+	config.CommsMatrix,
+}
+
+// newMedium returns an unconfigured communications medium by config name
+func newMedium(name string) base.ICommunicate {
+	switch name {
+	case config.CommsTelegram:
+		return new(telegram.Telegram)
+	case config.CommsSMSGlobal:
+		return new(smsglobal.SMSGlobal)
+	case config.CommsSMTP:
+		return new(smtpservice.SMTPservice)
+	case config.CommsSlack:
+		return new(slack.Slack)
+	case config.CommsWebhook:
+		return new(webhook.Webhook)
+	case config.CommsDiscord:
+		return new(discord.Discord)
+	case config.CommsMatrix:
+		return new(matrix.Matrix)
 	}
+	return nil
+}
 
-	if config.SMSGlobalConfig.Enabled {
-		SMSGlobal := new(smsglobal.SMSGlobal)
-		SMSGlobal.Setup(config)
-		comm.IComm = append(comm.IComm, SMSGlobal)
+// mediumName returns the communications config name of a medium
+func mediumName(medium base.ICommunicate) string {
+	switch medium.(type) {
+	case *telegram.Telegram:
+		return config.CommsTelegram
+	case *smsglobal.SMSGlobal:
+		return config.CommsSMSGlobal
+	case *smtpservice.SMTPservice:
+		return config.CommsSMTP
+	case *slack.Slack:
+		return config.CommsSlack
+	case *webhook.Webhook:
+		return config.CommsWebhook
+	case *discord.Discord:
+		return config.CommsDiscord
+	case *matrix.Matrix:
+		return config.CommsMatrix
 	}
+	return ""
+}
 
-	if config.SMTPConfig.Enabled {
-		SMTP := new(smtpservice.SMTPservice)
-		SMTP.Setup(config)
-		comm.IComm = append(comm.IComm, SMTP)
+// Shutdown stops the background routines of every medium, mediums with
+// deliveries in flight wait a bounded time for them to finish
+func (c *Communications) Shutdown() {
+	for i := range c.IComm {
+		shutdownMedium(c.IComm[i])
 	}
+}
 
-	if config.SlackConfig.Enabled {
-		Slack := new(slack.Slack)
-		Slack.Setup(config)
-		comm.IComm = append(comm.IComm, Slack)
+// shutdownMedium stops the background routines of a medium which has them
+func shutdownMedium(medium base.ICommunicate) {
+	if s, ok := medium.(interface{ Shutdown() }); ok {
+		s.Shutdown()
 	}
+}
 
-	comm.Setup()
-	return &comm
+// Reload replaces the named mediums with new ones set up from the config,
+// shutting down the background routines of the mediums being replaced. It
+// returns the names of the mediums which are enabled after the reload
+func (c *Communications) Reload(cfg config.CommunicationsConfig, names []string) []string {
+	var enabled []string
+	for _, name := range names {
+		for i := len(c.IComm) - 1; i >= 0; i-- {
+			if mediumName(c.IComm[i]) != name {
+				continue
+			}
+			shutdownMedium(c.IComm[i])
+			c.IComm = append(c.IComm[:i], c.IComm[i+1:]...)
+		}
+
+		medium := newMedium(name)
+		if medium == nil {
+			continue
+		}
+		medium.Setup(cfg)
+		if !medium.IsEnabled() {
+			continue
+		}
+
+		err := medium.Connect()
+		if err != nil {
+			log.Printf("Communications: %s failed to connect. Err: %s", medium.GetName(), err)
+		}
+		c.IComm = append(c.IComm, medium)
+		enabled = append(enabled, name)
+	}
+	return enabled
 }
@@ -26,3 +26,25 @@ func TestNewComm(t *testing.T) {
 			len(communications.IComm))
 	}
 }
+
+func TestReload(t *testing.T) {
+	var cfg config.CommunicationsConfig
+	cfg.SMTPConfig.Enabled = true
+	cfg.SMTPConfig.Name = "SMTP"
+	communications := NewComm(cfg)
+
+	cfg.SMTPConfig.AccountName = "bot"
+	enabled := communications.Reload(cfg, []string{config.CommsSMTP})
+	if len(enabled) != 1 || len(communications.IComm) != 1 ||
+		!communications.IComm[0].IsConnected() {
+		t.Errorf("Test failed, communications Reload, expected SMTP restarted got %v",
+			enabled)
+	}
+
+	cfg.SMTPConfig.Enabled = false
+	enabled = communications.Reload(cfg, []string{config.CommsSMTP})
+	if len(enabled) != 0 || len(communications.IComm) != 0 {
+		t.Errorf("Test failed, communications Reload, expected SMTP removed got len %d",
+			len(communications.IComm))
+	}
+}
@@ -0,0 +1,299 @@
+// Package discord is used to connect to the Discord chat platform using a bot
+// account and the REST API defined in https://discord.com/developers/docs
+package discord
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+const (
+	apiURL = "https://discord.com/api/v10"
+
+	pathCurrentUser     = "/users/@me"
+	pathChannelMessages = "/channels/%s/messages"
+
+	cmdStatus    = "/status"
+	cmdHelp      = "/help"
+	cmdSettings  = "/settings"
+	cmdTicker    = "/ticker"
+	cmdPortfolio = "/portfolio"
+	cmdOrders    = "/orderbooks"
+
+	cmdHelpReply = `GoCryptoTrader DiscordBot, thank you for using this service!
+	Current commands are:
+	/status 		- Displays the status of the bot
+	/help 			- Displays current command list
+	/settings 	- Displays current bot settings
+	/ticker 		- Displays current ANX ticker data
+	/portfolio	- Displays your current portfolio
+	/orderbooks - Displays current orderbooks for ANX`
+
+	talkRoot = "GoCryptoTrader bot"
+
+	// maxMessageLength is the maximum amount of characters Discord accepts in
+	// a single message
+	maxMessageLength = 2000
+	pollInterval     = time.Second * 3
+	httpTimeout      = time.Second * 15
+)
+
+// Discord is the overarching type across this package
+type Discord struct {
+	base.Base
+	BotToken      string
+	ChannelID     string
+	APIURL        string
+	BotID         string
+	LastMessageID string
+	HTTPClient    *http.Client
+	shutdown      chan struct{}
+}
+
+// Setup takes in a Discord configuration and sets the bot token and channel
+func (d *Discord) Setup(config config.CommunicationsConfig) {
+	d.Name = config.DiscordConfig.Name
+	d.Enabled = config.DiscordConfig.Enabled
+	d.Verbose = config.DiscordConfig.Verbose
+	d.BotToken = config.DiscordConfig.BotToken
+	d.ChannelID = config.DiscordConfig.ChannelID
+	d.APIURL = apiURL
+	d.HTTPClient = common.NewHTTPClientWithTimeout(httpTimeout)
+}
+
+// Connect verifies the bot token and starts polling the channel for commands
+func (d *Discord) Connect() error {
+	if err := d.TestConnection(); err != nil {
+		return err
+	}
+
+	d.Connected = true
+	d.shutdown = make(chan struct{})
+	go d.PollerStart()
+	return nil
+}
+
+// PushEvent sends an event to the configured channel
+func (d *Discord) PushEvent(event base.Event) error {
+	return d.SendMessage(event.String())
+}
+
+// PollerStart polls the configured channel for commands
+func (d *Discord) PollerStart() {
+	shutdown := d.shutdown
+	err := d.InitialConnect()
+	if err != nil {
+		log.Printf("Discord: failed to retrieve initial messages. Err: %s", err)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shutdown:
+			return
+		case <-ticker.C:
+			msgs, err := d.GetMessages()
+			if err != nil {
+				log.Printf("Discord: failed to retrieve messages. Err: %s", err)
+				continue
+			}
+
+			// Messages are returned newest first
+			for i := len(msgs) - 1; i >= 0; i-- {
+				d.LastMessageID = msgs[i].ID
+				if msgs[i].Author.Bot || msgs[i].Author.ID == d.BotID {
+					continue
+				}
+
+				if len(msgs[i].Content) > 0 && string(msgs[i].Content[0]) == "/" {
+					err = d.HandleMessages(msgs[i].Content)
+					if err != nil {
+						log.Printf("Discord: failed to handle command %s. Err: %s",
+							msgs[i].Content, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Shutdown stops polling the channel for commands
+func (d *Discord) Shutdown() {
+	if d.shutdown != nil {
+		close(d.shutdown)
+		d.shutdown = nil
+	}
+	d.Connected = false
+}
+
+// InitialConnect sets the last message ID so commands sent while the bot was
+// offline are not replayed and greets the channel
+func (d *Discord) InitialConnect() error {
+	msgs, err := d.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	if len(msgs) > 0 {
+		d.LastMessageID = msgs[0].ID
+	}
+	return d.SendMessage(fmt.Sprintf("%s has connected.", talkRoot))
+}
+
+// HandleMessages handles incoming commands from the channel
+func (d *Discord) HandleMessages(text string) error {
+	switch {
+	case common.StringContains(text, cmdHelp):
+		return d.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply))
+
+	case common.StringContains(text, cmdOrders):
+		return d.SendMessage(fmt.Sprintf("%s: %s", talkRoot, d.GetOrderbook("ANX")))
+
+	case common.StringContains(text, cmdStatus):
+		return d.SendMessage(fmt.Sprintf("%s: %s", talkRoot, d.GetStatus()))
+
+	case common.StringContains(text, cmdTicker):
+		return d.SendMessage(fmt.Sprintf("%s: %s", talkRoot, d.GetTicker("ANX")))
+
+	case common.StringContains(text, cmdSettings):
+		return d.SendMessage(fmt.Sprintf("%s: %s", talkRoot, d.GetSettings()))
+
+	case common.StringContains(text, cmdPortfolio):
+		return d.SendMessage(fmt.Sprintf("%s: %s", talkRoot, d.GetPortfolio()))
+
+	default:
+		return d.SendMessage(fmt.Sprintf("command %s not recognized", text))
+	}
+}
+
+// TestConnection tests the bot's supplied authentication token
+func (d *Discord) TestConnection() error {
+	var user User
+	err := d.SendHTTPRequest(http.MethodGet, pathCurrentUser, nil, &user)
+	if err != nil {
+		return err
+	}
+
+	if user.ID == "" {
+		return errors.New("discord TestConnection() unable to retrieve bot user")
+	}
+	d.BotID = user.ID
+	return nil
+}
+
+// GetMessages returns the messages posted to the channel since the last
+// processed message
+func (d *Discord) GetMessages() ([]Message, error) {
+	path := fmt.Sprintf(pathChannelMessages, d.ChannelID) + "?limit=50"
+	if d.LastMessageID != "" {
+		path += "&after=" + d.LastMessageID
+	}
+
+	var msgs []Message
+	return msgs, d.SendHTTPRequest(http.MethodGet, path, nil, &msgs)
+}
+
+// SendMessage sends a message to the channel, splitting it into multiple
+// messages if it exceeds the Discord message length limit
+func (d *Discord) SendMessage(text string) error {
+	if text == "" {
+		return errors.New("discord SendMessage() cannot send an empty message")
+	}
+
+	chunks := splitMessage(text, maxMessageLength)
+	for i := range chunks {
+		data, err := common.JSONEncode(SendMessage{Content: chunks[i]})
+		if err != nil {
+			return err
+		}
+
+		var resp Message
+		err = d.SendHTTPRequest(http.MethodPost,
+			fmt.Sprintf(pathChannelMessages, d.ChannelID), data, &resp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SendHTTPRequest sends an authenticated HTTP request to the Discord API
+func (d *Discord) SendHTTPRequest(method, path string, data []byte, result interface{}) error {
+	req, err := http.NewRequest(method, d.APIURL+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bot "+d.BotToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	if d.HTTPClient == nil {
+		d.HTTPClient = common.NewHTTPClientWithTimeout(httpTimeout)
+	}
+
+	resp, err := d.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	if d.Verbose {
+		log.Printf("Discord %s %s response: %s", method, path, string(contents))
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp ErrorResponse
+		if common.JSONDecode(contents, &errResp) == nil && errResp.Message != "" {
+			return errors.New(errResp.Message)
+		}
+		return fmt.Errorf("discord unexpected status code %d", resp.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return common.JSONDecode(contents, result)
+}
+
+// splitMessage splits text into chunks no longer than limit bytes, preferring
+// to split on new lines and never splitting a UTF-8 character
+func splitMessage(text string, limit int) []string {
+	var chunks []string
+	for len(text) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		if cut == 0 {
+			_, cut = utf8.DecodeRuneInString(text)
+		}
+		for i := cut; i > 0; i-- {
+			if text[i-1] == '\n' {
+				cut = i
+				break
+			}
+		}
+		chunks = append(chunks, text[:cut])
+		text = text[cut:]
+	}
+	if text != "" {
+		chunks = append(chunks, text)
+	}
+	return chunks
+}
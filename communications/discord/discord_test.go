@@ -0,0 +1,157 @@
+package discord
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+var d Discord
+
+// sent holds the message contents posted to the test server
+var sent []string
+
+func newTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bot token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":0,"message":"401: Unauthorized"}`))
+			return
+		}
+
+		switch {
+		case r.URL.Path == pathCurrentUser:
+			w.Write([]byte(`{"id":"1","username":"gct","bot":true}`))
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`[{"id":"3","content":"/status","author":{"id":"2"}},{"id":"2","content":"hi","author":{"id":"1","bot":true}}]`))
+		case r.Method == http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			var msg SendMessage
+			common.JSONDecode(body, &msg)
+			sent = append(sent, msg.Content)
+			w.Write([]byte(`{"id":"4"}`))
+		}
+	}))
+}
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	d.Setup(cfg.GetCommunicationsConfig())
+	if d.Name != "Discord" || d.Enabled || d.APIURL != apiURL {
+		t.Error("test failed - discord Setup() error, unexpected setup values",
+			d.Name, d.Enabled, d.APIURL)
+	}
+}
+
+func TestTestConnection(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	d.APIURL = server.URL
+
+	d.BotToken = "bad"
+	err := d.TestConnection()
+	if err == nil || err.Error() != "401: Unauthorized" {
+		t.Error("test failed - discord TestConnection() error", err)
+	}
+
+	d.BotToken = "token"
+	err = d.TestConnection()
+	if err != nil || d.BotID != "1" {
+		t.Error("test failed - discord TestConnection() error", err)
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	d.APIURL = server.URL
+	sent = nil
+
+	err := d.PushEvent(base.Event{Type: "TEST"})
+	if err != nil {
+		t.Fatal("test failed - discord PushEvent() error", err)
+	}
+	if len(sent) != 1 || sent[0] != "Type: TEST Details:  GainOrLoss: " {
+		t.Error("test failed - discord PushEvent() unexpected message", sent)
+	}
+}
+
+func TestGetMessages(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	d.APIURL = server.URL
+
+	msgs, err := d.GetMessages()
+	if err != nil || len(msgs) != 2 {
+		t.Error("test failed - discord GetMessages() error", err)
+	}
+}
+
+func TestHandleMessages(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	d.APIURL = server.URL
+
+	commands := []string{cmdHelp, cmdOrders, cmdStatus, cmdTicker, cmdSettings,
+		cmdPortfolio, "/lol"}
+	for i := range commands {
+		sent = nil
+		err := d.HandleMessages(commands[i])
+		if err != nil || len(sent) == 0 {
+			t.Errorf("test failed - discord HandleMessages() %s error %s",
+				commands[i], err)
+		}
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	d.APIURL = server.URL
+
+	err := d.SendMessage("")
+	if err == nil {
+		t.Error("test failed - discord SendMessage() error")
+	}
+
+	sent = nil
+	err = d.SendMessage(strings.Repeat("a\n", maxMessageLength))
+	if err != nil || len(sent) != 2 {
+		t.Error("test failed - discord SendMessage() error", err, len(sent))
+	}
+}
+
+func TestSplitMessage(t *testing.T) {
+	chunks := splitMessage("abc\ndef\nghi", 5)
+	if len(chunks) != 3 || chunks[0] != "abc\n" || chunks[2] != "ghi" {
+		t.Error("test failed - discord splitMessage() error", chunks)
+	}
+
+	chunks = splitMessage("abcdefghij", 4)
+	if len(chunks) != 3 || chunks[0] != "abcd" {
+		t.Error("test failed - discord splitMessage() error", chunks)
+	}
+
+	chunks = splitMessage("aé€₿日本", 4)
+	if len(chunks) != 5 || chunks[0] != "aé" || chunks[4] != "本" {
+		t.Error("test failed - discord splitMessage() error", chunks)
+	}
+	for i := range chunks {
+		if !utf8.ValidString(chunks[i]) {
+			t.Error("test failed - discord splitMessage() split a character", chunks[i])
+		}
+	}
+
+	chunks = splitMessage("₿₿", 2)
+	if len(chunks) != 2 || chunks[0] != "₿" {
+		t.Error("test failed - discord splitMessage() error", chunks)
+	}
+}
@@ -0,0 +1,29 @@
+package discord
+
+// User holds Discord user information
+type User struct {
+	ID            string `json:"id"`
+	Username      string `json:"username"`
+	Discriminator string `json:"discriminator"`
+	Bot           bool   `json:"bot"`
+}
+
+// Message holds a Discord channel message
+type Message struct {
+	ID        string `json:"id"`
+	ChannelID string `json:"channel_id"`
+	Author    User   `json:"author"`
+	Content   string `json:"content"`
+	Timestamp string `json:"timestamp"`
+}
+
+// SendMessage holds the details for a message to be sent to a channel
+type SendMessage struct {
+	Content string `json:"content"`
+}
+
+// ErrorResponse holds a Discord API error
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
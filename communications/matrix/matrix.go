@@ -0,0 +1,276 @@
+// Package matrix is used to connect to a Matrix homeserver using the client
+// server API defined in https://spec.matrix.org/latest/client-server-api/
+package matrix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+const (
+	pathWhoAmI      = "/_matrix/client/v3/account/whoami"
+	pathSync        = "/_matrix/client/v3/sync"
+	pathSendMessage = "/_matrix/client/v3/rooms/%s/send/m.room.message/%s"
+
+	eventRoomMessage = "m.room.message"
+	msgTypeText      = "m.text"
+
+	cmdStatus    = "/status"
+	cmdHelp      = "/help"
+	cmdSettings  = "/settings"
+	cmdTicker    = "/ticker"
+	cmdPortfolio = "/portfolio"
+	cmdOrders    = "/orderbooks"
+
+	cmdHelpReply = `GoCryptoTrader MatrixBot, thank you for using this service!
+	Current commands are:
+	/status 		- Displays the status of the bot
+	/help 			- Displays current command list
+	/settings 	- Displays current bot settings
+	/ticker 		- Displays current ANX ticker data
+	/portfolio	- Displays your current portfolio
+	/orderbooks - Displays current orderbooks for ANX`
+
+	talkRoot = "GoCryptoTrader bot"
+
+	// syncTimeout is how long the homeserver holds a sync request open when
+	// there are no new events, the HTTP timeout must exceed it
+	syncTimeout  = time.Second * 30
+	httpTimeout  = time.Second * 45
+	syncErrDelay = time.Second * 5
+)
+
+// Matrix is the overarching type across this package
+type Matrix struct {
+	base.Base
+	HomeserverURL string
+	AccessToken   string
+	RoomID        string
+	UserID        string
+	NextBatch     string
+	HTTPClient    *http.Client
+	txnCounter    int64
+	shutdown      chan struct{}
+}
+
+// Setup takes in a Matrix configuration and sets the homeserver, access token
+// and room
+func (m *Matrix) Setup(config config.CommunicationsConfig) {
+	m.Name = config.MatrixConfig.Name
+	m.Enabled = config.MatrixConfig.Enabled
+	m.Verbose = config.MatrixConfig.Verbose
+	m.HomeserverURL = common.TrimString(config.MatrixConfig.HomeserverURL, "/")
+	m.AccessToken = config.MatrixConfig.AccessToken
+	m.RoomID = config.MatrixConfig.RoomID
+	m.HTTPClient = common.NewHTTPClientWithTimeout(httpTimeout)
+}
+
+// Connect verifies the access token and starts syncing the room for commands
+func (m *Matrix) Connect() error {
+	if err := m.TestConnection(); err != nil {
+		return err
+	}
+
+	m.Connected = true
+	m.shutdown = make(chan struct{})
+	go m.PollerStart()
+	return nil
+}
+
+// PushEvent sends an event to the configured room
+func (m *Matrix) PushEvent(event base.Event) error {
+	return m.SendMessage(event.String())
+}
+
+// PollerStart starts the long polling sync sequence
+func (m *Matrix) PollerStart() {
+	shutdown := m.shutdown
+	err := m.InitialConnect()
+	if err != nil {
+		log.Printf("Matrix: failed to perform initial sync. Err: %s", err)
+	}
+
+	for {
+		select {
+		case <-shutdown:
+			return
+		default:
+		}
+
+		resp, err := m.Sync(syncTimeout)
+		if err != nil {
+			log.Printf("Matrix: failed to sync. Err: %s", err)
+			time.Sleep(syncErrDelay)
+			continue
+		}
+		m.NextBatch = resp.NextBatch
+
+		room, ok := resp.Rooms.Join[m.RoomID]
+		if !ok {
+			continue
+		}
+
+		for i := range room.Timeline.Events {
+			evt := room.Timeline.Events[i]
+			if evt.Type != eventRoomMessage || evt.Sender == m.UserID {
+				continue
+			}
+
+			if len(evt.Content.Body) > 0 && string(evt.Content.Body[0]) == "/" {
+				err = m.HandleMessages(evt.Content.Body)
+				if err != nil {
+					log.Printf("Matrix: failed to handle command %s. Err: %s",
+						evt.Content.Body, err)
+				}
+			}
+		}
+	}
+}
+
+// Shutdown stops syncing the room for commands
+func (m *Matrix) Shutdown() {
+	if m.shutdown != nil {
+		close(m.shutdown)
+		m.shutdown = nil
+	}
+	m.Connected = false
+}
+
+// InitialConnect performs an initial sync so commands sent while the bot was
+// offline are not replayed and greets the room
+func (m *Matrix) InitialConnect() error {
+	resp, err := m.Sync(0)
+	if err != nil {
+		return err
+	}
+	m.NextBatch = resp.NextBatch
+	return m.SendMessage(fmt.Sprintf("%s has connected.", talkRoot))
+}
+
+// HandleMessages handles incoming commands from the room
+func (m *Matrix) HandleMessages(text string) error {
+	switch {
+	case common.StringContains(text, cmdHelp):
+		return m.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply))
+
+	case common.StringContains(text, cmdOrders):
+		return m.SendMessage(fmt.Sprintf("%s: %s", talkRoot, m.GetOrderbook("ANX")))
+
+	case common.StringContains(text, cmdStatus):
+		return m.SendMessage(fmt.Sprintf("%s: %s", talkRoot, m.GetStatus()))
+
+	case common.StringContains(text, cmdTicker):
+		return m.SendMessage(fmt.Sprintf("%s: %s", talkRoot, m.GetTicker("ANX")))
+
+	case common.StringContains(text, cmdSettings):
+		return m.SendMessage(fmt.Sprintf("%s: %s", talkRoot, m.GetSettings()))
+
+	case common.StringContains(text, cmdPortfolio):
+		return m.SendMessage(fmt.Sprintf("%s: %s", talkRoot, m.GetPortfolio()))
+
+	default:
+		return m.SendMessage(fmt.Sprintf("command %s not recognized", text))
+	}
+}
+
+// TestConnection tests the supplied access token and stores the bot user ID
+func (m *Matrix) TestConnection() error {
+	var resp WhoAmI
+	err := m.SendHTTPRequest(http.MethodGet, pathWhoAmI, nil, &resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.UserID == "" {
+		return errors.New("matrix TestConnection() unable to retrieve user ID")
+	}
+	m.UserID = resp.UserID
+	return nil
+}
+
+// Sync returns the events that have occurred since the last sync, waiting up
+// to timeout for new events to arrive
+func (m *Matrix) Sync(timeout time.Duration) (SyncResponse, error) {
+	values := url.Values{}
+	values.Set("timeout", strconv.FormatInt(int64(timeout/time.Millisecond), 10))
+	if m.NextBatch != "" {
+		values.Set("since", m.NextBatch)
+	}
+
+	var resp SyncResponse
+	return resp, m.SendHTTPRequest(http.MethodGet,
+		common.EncodeURLValues(pathSync, values), nil, &resp)
+}
+
+// SendMessage sends a text message to the room
+func (m *Matrix) SendMessage(text string) error {
+	if text == "" {
+		return errors.New("matrix SendMessage() cannot send an empty message")
+	}
+
+	data, err := common.JSONEncode(SendMessage{MsgType: msgTypeText, Body: text})
+	if err != nil {
+		return err
+	}
+
+	txnID := fmt.Sprintf("gct%d.%d", time.Now().UnixNano(),
+		atomic.AddInt64(&m.txnCounter, 1))
+	path := fmt.Sprintf(pathSendMessage, url.PathEscape(m.RoomID), txnID)
+
+	var resp SendMessageResponse
+	return m.SendHTTPRequest(http.MethodPut, path, data, &resp)
+}
+
+// SendHTTPRequest sends an authenticated HTTP request to the homeserver
+func (m *Matrix) SendHTTPRequest(method, path string, data []byte, result interface{}) error {
+	req, err := http.NewRequest(method, m.HomeserverURL+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+m.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	if m.HTTPClient == nil {
+		m.HTTPClient = common.NewHTTPClientWithTimeout(httpTimeout)
+	}
+
+	resp, err := m.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	if m.Verbose {
+		log.Printf("Matrix %s %s response: %s", method, path, string(contents))
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp ErrorResponse
+		if common.JSONDecode(contents, &errResp) == nil && errResp.Error != "" {
+			return fmt.Errorf("%s: %s", errResp.ErrCode, errResp.Error)
+		}
+		return fmt.Errorf("matrix unexpected status code %d", resp.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return common.JSONDecode(contents, result)
+}
@@ -0,0 +1,127 @@
+package matrix
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+var m Matrix
+
+// sent holds the message bodies sent to the test server
+var sent []string
+
+func newTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errcode":"M_UNKNOWN_TOKEN","error":"Invalid macaroon passed."}`))
+			return
+		}
+
+		switch {
+		case r.URL.Path == pathWhoAmI:
+			w.Write([]byte(`{"user_id":"@gct:localhost"}`))
+		case r.URL.Path == pathSync:
+			w.Write([]byte(`{"next_batch":"s1","rooms":{"join":{"!room:localhost":{"timeline":{"events":[{"type":"m.room.message","sender":"@bob:localhost","content":{"msgtype":"m.text","body":"/status"}}]}}}}}`))
+		case r.Method == http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			var msg SendMessage
+			common.JSONDecode(body, &msg)
+			sent = append(sent, msg.Body)
+			w.Write([]byte(`{"event_id":"$1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	m.Setup(cfg.GetCommunicationsConfig())
+	if m.Name != "Matrix" || m.Enabled || m.HTTPClient == nil {
+		t.Error("test failed - matrix Setup() error, unexpected setup values",
+			m.Name, m.Enabled)
+	}
+	m.RoomID = "!room:localhost"
+}
+
+func TestTestConnection(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	m.HomeserverURL = server.URL
+
+	m.AccessToken = "bad"
+	err := m.TestConnection()
+	if err == nil || err.Error() != "M_UNKNOWN_TOKEN: Invalid macaroon passed." {
+		t.Error("test failed - matrix TestConnection() error", err)
+	}
+
+	m.AccessToken = "token"
+	err = m.TestConnection()
+	if err != nil || m.UserID != "@gct:localhost" {
+		t.Error("test failed - matrix TestConnection() error", err)
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	m.HomeserverURL = server.URL
+	sent = nil
+
+	err := m.PushEvent(base.Event{Type: "TEST"})
+	if err != nil {
+		t.Fatal("test failed - matrix PushEvent() error", err)
+	}
+	if len(sent) != 1 || sent[0] != "Type: TEST Details:  GainOrLoss: " {
+		t.Error("test failed - matrix PushEvent() unexpected message", sent)
+	}
+}
+
+func TestSync(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	m.HomeserverURL = server.URL
+
+	resp, err := m.Sync(0)
+	if err != nil || resp.NextBatch != "s1" {
+		t.Error("test failed - matrix Sync() error", err)
+	}
+
+	room, ok := resp.Rooms.Join[m.RoomID]
+	if !ok || len(room.Timeline.Events) != 1 ||
+		room.Timeline.Events[0].Content.Body != "/status" {
+		t.Error("test failed - matrix Sync() unexpected events", resp)
+	}
+}
+
+func TestHandleMessages(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	m.HomeserverURL = server.URL
+
+	commands := []string{cmdHelp, cmdOrders, cmdStatus, cmdTicker, cmdSettings,
+		cmdPortfolio, "/lol"}
+	for i := range commands {
+		sent = nil
+		err := m.HandleMessages(commands[i])
+		if err != nil || len(sent) != 1 {
+			t.Errorf("test failed - matrix HandleMessages() %s error %s",
+				commands[i], err)
+		}
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	err := m.SendMessage("")
+	if err == nil {
+		t.Error("test failed - matrix SendMessage() error")
+	}
+}
@@ -0,0 +1,50 @@
+package matrix
+
+// WhoAmI holds the user ID associated with an access token
+type WhoAmI struct {
+	UserID string `json:"user_id"`
+}
+
+// SyncResponse holds the data returned by the sync endpoint, only the joined
+// room timelines are decoded
+type SyncResponse struct {
+	NextBatch string `json:"next_batch"`
+	Rooms     struct {
+		Join map[string]JoinedRoom `json:"join"`
+	} `json:"rooms"`
+}
+
+// JoinedRoom holds the timeline of a joined room
+type JoinedRoom struct {
+	Timeline struct {
+		Events []Event `json:"events"`
+	} `json:"timeline"`
+}
+
+// Event holds a Matrix room event
+type Event struct {
+	Type    string `json:"type"`
+	EventID string `json:"event_id"`
+	Sender  string `json:"sender"`
+	Content struct {
+		MsgType string `json:"msgtype"`
+		Body    string `json:"body"`
+	} `json:"content"`
+}
+
+// SendMessage holds the details for a message to be sent to a room
+type SendMessage struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// SendMessageResponse holds the response after sending a message
+type SendMessageResponse struct {
+	EventID string `json:"event_id"`
+}
+
+// ErrorResponse holds a Matrix API error
+type ErrorResponse struct {
+	ErrCode string `json:"errcode"`
+	Error   string `json:"error"`
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,8 +53,8 @@ type Slack struct {
 	Details         Response
 	ReconnectURL    string
 	WebsocketConn   *websocket.Conn
-	Connected       bool
-	Shutdown        bool
+	Commands        *base.CommandHandler
+	shutdown        bool
 	sync.Mutex
 }
 
@@ -65,6 +66,7 @@ func (s *Slack) Setup(config config.CommunicationsConfig) {
 	s.Verbose = config.SlackConfig.Verbose
 	s.TargetChannel = config.SlackConfig.TargetChannel
 	s.VerificationToken = config.SlackConfig.VerificationToken
+	s.Commands = base.NewCommandHandler(config.SlackConfig.AuthorisedUsers)
 }
 
 // Connect connects to the service
@@ -75,9 +77,22 @@ func (s *Slack) Connect() error {
 	return nil
 }
 
-// PushEvent pushes an event to either a slack channel or specific client
-func (s *Slack) PushEvent(base.Event) error {
-	return common.ErrNotYetImplemented
+// PushEvent pushes an event to the target slack channel
+func (s *Slack) PushEvent(event base.Event) error {
+	if s.TargetChannelID == "" {
+		return fmt.Errorf("slack PushEvent() error - target channel %s not resolved",
+			s.TargetChannel)
+	}
+	return s.WebsocketSend("message", event.String())
+}
+
+// Shutdown closes the websocket connection and stops reading events
+func (s *Slack) Shutdown() {
+	s.shutdown = true
+	if s.WebsocketConn != nil {
+		s.WebsocketConn.Close()
+	}
+	s.Connected = false
 }
 
 // BuildURL returns an appended token string with the SlackURL
@@ -200,6 +215,9 @@ func (s *Slack) WebsocketReader() {
 	for {
 		_, resp, err := s.WebsocketConn.ReadMessage()
 		if err != nil {
+			if s.shutdown {
+				return
+			}
 			log.Fatal(err)
 		}
 
@@ -337,6 +355,10 @@ func (s *Slack) WebsocketKeepAlive() {
 
 	for {
 		<-ticker.C
+		if s.shutdown {
+			ticker.Stop()
+			return
+		}
 		if err := s.WebsocketSend("ping", ""); err != nil {
 			log.Println("slack WebsocketKeepAlive() error", err)
 		}
@@ -363,15 +385,24 @@ func (s *Slack) WebsocketSend(eventType, text string) error {
 	return s.WebsocketConn.WriteMessage(websocket.TextMessage, data)
 }
 
-// HandleMessage handles incoming messages and/or commands from slack
+// HandleMessage handles incoming messages and/or commands from slack, control
+// commands are authorised against the senders user ID
 func (s *Slack) HandleMessage(msg Message) error {
+	command := strings.TrimPrefix(msg.Text, "!")
+	if base.IsControlCommand(command) {
+		if s.Commands == nil {
+			s.Commands = base.NewCommandHandler(nil)
+		}
+		return s.WebsocketSend("message", s.Commands.Handle(msg.User, command))
+	}
+
 	msg.Text = common.StringToLower(msg.Text)
 	switch {
 	case common.StringContains(msg.Text, cmdStatus):
 		return s.WebsocketSend("message", s.GetStatus())
 
 	case common.StringContains(msg.Text, cmdHelp):
-		return s.WebsocketSend("message", getHelp)
+		return s.WebsocketSend("message", getHelp+"\n"+base.ControlHelp("!"))
 
 	case common.StringContains(msg.Text, cmdTicker):
 		return s.WebsocketSend("message", s.GetTicker("ANX"))
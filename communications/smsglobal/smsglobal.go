@@ -4,6 +4,7 @@ package smsglobal
 import (
 	"errors"
 	"flag"
+	"fmt"
 	"net/url"
 	"strings"
 
@@ -17,8 +18,9 @@ const (
 )
 
 var (
-	errSMSNotSent      = errors.New("SMSGlobal message not sent")
-	errContactNotFound = errors.New("SMSGlobal error contact not found")
+	errSMSNotSent       = errors.New("SMSGlobal message not sent")
+	errContactNotFound  = errors.New("SMSGlobal error contact not found")
+	errNoEnabledContact = errors.New("SMSGlobal error no enabled contacts")
 )
 
 // SMSGlobal is the overarching type across this package
@@ -59,8 +61,8 @@ func (s *SMSGlobal) Connect() error {
 }
 
 // PushEvent pushes an event to a contact list via SMS
-func (s *SMSGlobal) PushEvent(base.Event) error {
-	return common.ErrNotYetImplemented
+func (s *SMSGlobal) PushEvent(event base.Event) error {
+	return s.SendMessageToAll(event.String())
 }
 
 // GetEnabledContacts returns how many SMS contacts are enabled in the
@@ -134,16 +136,29 @@ func (s *SMSGlobal) RemoveContact(contact Contact) error {
 	return errors.New("SMSGlobal RemoveContact() error - contact already removed")
 }
 
-// SendMessageToAll sends a message to all enabled contacts in cfg
+// SendMessageToAll sends a message to all enabled contacts in cfg, a failed
+// delivery to one contact does not stop the message being sent to the rest and
+// all failures are reported in the returned error
 func (s *SMSGlobal) SendMessageToAll(message string) error {
+	if s.GetEnabledContacts() == 0 {
+		return errNoEnabledContact
+	}
+
+	var failed []string
 	for x := range s.Contacts {
 		if s.Contacts[x].Enabled {
 			err := s.SendMessage(s.Contacts[x].Number, message)
 			if err != nil {
-				return err
+				failed = append(failed, fmt.Sprintf("%s (%s): %s",
+					s.Contacts[x].Name, s.Contacts[x].Number, err))
 			}
 		}
 	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("SMSGlobal SendMessageToAll() failed to deliver to %d of %d contacts: %s",
+			len(failed), s.GetEnabledContacts(), common.JoinStrings(failed, ", "))
+	}
 	return nil
 }
 
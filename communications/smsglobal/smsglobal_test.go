@@ -25,8 +25,8 @@ func TestConnect(t *testing.T) {
 
 func TestPushEvent(t *testing.T) {
 	err := s.PushEvent(base.Event{})
-	if err == nil {
-		t.Error("test failed - SMSGlobal PushEvent() error")
+	if err != nil {
+		t.Error("test failed - SMSGlobal PushEvent() error", err)
 	}
 }
 
@@ -1,9 +1,14 @@
 package smtpservice
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	htmltemplate "html/template"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
+	"text/template"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/communications/base"
@@ -11,8 +16,33 @@ import (
 )
 
 const (
-	mime    = "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
+	mime    = "MIME-version: 1.0;\nContent-Type: multipart/alternative; boundary=\"%s\";\n\n"
 	msgSMTP = "To: %s\r\nSubject: %s\r\n%s\r\n%s"
+
+	eventSubject = "GoCryptoTrader event"
+
+	eventPlainText = `GoCryptoTrader event triggered
+Type: {{.Type}}
+Details: {{.TradeDetails}}
+Gain or loss: {{.GainLoss}}
+`
+
+	eventHTML = `<html>
+<body>
+<h3>GoCryptoTrader event triggered</h3>
+<table>
+<tr><td><b>Type</b></td><td>{{.Type}}</td></tr>
+<tr><td><b>Details</b></td><td>{{.TradeDetails}}</td></tr>
+<tr><td><b>Gain or loss</b></td><td>{{.GainLoss}}</td></tr>
+</table>
+</body>
+</html>
+`
+)
+
+var (
+	eventPlainTextTemplate = template.Must(template.New("eventPlainText").Parse(eventPlainText))
+	eventHTMLTemplate      = htmltemplate.Must(htmltemplate.New("eventHTML").Parse(eventHTML))
 )
 
 // SMTPservice uses the net/smtp package to send emails to a recipient list
@@ -45,36 +75,112 @@ func (s *SMTPservice) Connect() error {
 }
 
 // PushEvent sends an event to supplied recipient list via SMTP
-func (s *SMTPservice) PushEvent(base.Event) error {
-	return common.ErrNotYetImplemented
+func (s *SMTPservice) PushEvent(event base.Event) error {
+	var plainText, html bytes.Buffer
+	err := eventPlainTextTemplate.Execute(&plainText, event)
+	if err != nil {
+		return err
+	}
+
+	err = eventHTMLTemplate.Execute(&html, event)
+	if err != nil {
+		return err
+	}
+
+	subject := eventSubject
+	if event.Type != "" {
+		subject = fmt.Sprintf("%s: %s", eventSubject, event.Type)
+	}
+	return s.Send(subject, plainText.String(), html.String())
 }
 
 // Send sends an email template to the recipient list via your SMTP host when
-// an internal event is triggered by GoCryptoTrader
-func (s *SMTPservice) Send(subject, alert string) error {
-	if subject == "" || alert == "" {
+// an internal event is triggered by GoCryptoTrader. The alert is sent as a
+// multipart message containing both the plain-text and HTML versions so the
+// recipient mail client can pick the one it supports
+func (s *SMTPservice) Send(subject, plainText, html string) error {
+	if subject == "" || (plainText == "" && html == "") {
 		return errors.New("STMPservice Send() please add subject and alert")
 	}
 
+	if s.RecipientList == "" {
+		return errors.New("STMPservice Send() recipient list is empty")
+	}
+
+	boundary, body, err := buildMultipartBody(plainText, html)
+	if err != nil {
+		return err
+	}
+
 	list := common.SplitStrings(s.RecipientList, ",")
 
+	var failed []string
 	for i := range list {
+		recipient := common.TrimString(list[i], " ")
+		if recipient == "" {
+			continue
+		}
+
 		messageToSend := fmt.Sprintf(
 			msgSMTP,
-			list[i],
+			recipient,
 			subject,
-			mime,
-			alert)
+			fmt.Sprintf(mime, boundary),
+			body)
 
-		err := smtp.SendMail(
+		err = smtp.SendMail(
 			s.Host+":"+s.Port,
 			smtp.PlainAuth("", s.AccountName, s.AccountPassword, s.Host),
 			s.AccountName,
-			[]string{list[i]},
+			[]string{recipient},
 			[]byte(messageToSend))
 		if err != nil {
-			return err
+			failed = append(failed, fmt.Sprintf("%s: %s", recipient, err))
 		}
 	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("STMPservice Send() failed to deliver to %d of %d recipients: %s",
+			len(failed), len(list), common.JoinStrings(failed, ", "))
+	}
 	return nil
 }
+
+// buildMultipartBody returns the boundary and the multipart/alternative body
+// for the supplied plain-text and HTML parts
+func buildMultipartBody(plainText, html string) (string, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=\"UTF-8\"", plainText},
+		{"text/html; charset=\"UTF-8\"", html},
+	}
+
+	for i := range parts {
+		if parts[i].content == "" {
+			continue
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", parts[i].contentType)
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return "", "", err
+		}
+
+		_, err = part.Write([]byte(parts[i].content))
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return "", "", err
+	}
+	return writer.Boundary(), body.String(), nil
+}
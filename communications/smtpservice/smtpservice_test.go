@@ -3,6 +3,7 @@ package smtpservice
 import (
 	"testing"
 
+	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/communications/base"
 	"github.com/thrasher-/gocryptotrader/config"
 )
@@ -30,12 +31,27 @@ func TestPushEvent(t *testing.T) {
 }
 
 func TestSend(t *testing.T) {
-	err := s.Send("", "")
+	err := s.Send("", "", "")
 	if err == nil {
 		t.Error("test failed - smtpservice Send() error", err)
 	}
-	err = s.Send("subject", "alertmessage")
+	err = s.Send("subject", "alertmessage", "<b>alertmessage</b>")
 	if err == nil {
 		t.Error("test failed - smtpservice Send() error", err)
 	}
 }
+
+func TestBuildMultipartBody(t *testing.T) {
+	boundary, body, err := buildMultipartBody("plain", "<b>html</b>")
+	if err != nil {
+		t.Fatal("test failed - smtpservice buildMultipartBody() error", err)
+	}
+	if boundary == "" {
+		t.Error("test failed - smtpservice buildMultipartBody() boundary not set")
+	}
+	if !common.StringContains(body, "text/plain") ||
+		!common.StringContains(body, "text/html") ||
+		!common.StringContains(body, "<b>html</b>") {
+		t.Error("test failed - smtpservice buildMultipartBody() missing parts")
+	}
+}
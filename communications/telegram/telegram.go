@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/communications/base"
@@ -48,6 +50,8 @@ type Telegram struct {
 	Token             string
 	Offset            int64
 	AuthorisedClients []int64
+	Commands          *base.CommandHandler
+	shutdown          chan struct{}
 }
 
 // Setup takes in a Telegram configuration and sets verification token
@@ -56,6 +60,18 @@ func (t *Telegram) Setup(config config.CommunicationsConfig) {
 	t.Enabled = config.TelegramConfig.Enabled
 	t.Token = config.TelegramConfig.VerificationToken
 	t.Verbose = config.TelegramConfig.Verbose
+	t.Commands = base.NewCommandHandler(config.TelegramConfig.AuthorisedUsers)
+
+	t.AuthorisedClients = nil
+	for i := range config.TelegramConfig.AuthorisedUsers {
+		id, err := strconv.ParseInt(config.TelegramConfig.AuthorisedUsers[i].ID, 10, 64)
+		if err != nil {
+			log.Printf("Telegram: invalid authorised user ID %s",
+				config.TelegramConfig.AuthorisedUsers[i].ID)
+			continue
+		}
+		t.AuthorisedClients = append(t.AuthorisedClients, id)
+	}
 }
 
 // Connect starts an initial connection
@@ -64,6 +80,7 @@ func (t *Telegram) Connect() error {
 		return err
 	}
 	t.Connected = true
+	t.shutdown = make(chan struct{})
 	go t.PollerStart()
 	return nil
 }
@@ -71,8 +88,7 @@ func (t *Telegram) Connect() error {
 // PushEvent sends an event to a supplied recipient list via telegram
 func (t *Telegram) PushEvent(event base.Event) error {
 	for i := range t.AuthorisedClients {
-		err := t.SendMessage(fmt.Sprintf("Type: %s Details: %s GainOrLoss: %s",
-			event.Type, event.TradeDetails, event.GainLoss), t.AuthorisedClients[i])
+		err := t.SendMessage(event.String(), t.AuthorisedClients[i])
 		if err != nil {
 			return err
 		}
@@ -82,9 +98,16 @@ func (t *Telegram) PushEvent(event base.Event) error {
 
 // PollerStart starts the long polling sequence
 func (t *Telegram) PollerStart() {
+	shutdown := t.shutdown
 	t.InitialConnect()
 
 	for {
+		select {
+		case <-shutdown:
+			return
+		default:
+		}
+
 		resp, err := t.GetUpdates()
 		if err != nil {
 			log.Fatal(err)
@@ -104,6 +127,15 @@ func (t *Telegram) PollerStart() {
 	}
 }
 
+// Shutdown stops polling for commands
+func (t *Telegram) Shutdown() {
+	if t.shutdown != nil {
+		close(t.shutdown)
+		t.shutdown = nil
+	}
+	t.Connected = false
+}
+
 // InitialConnect sets offset, and sends a welcome greeting to any associated
 // IDs
 func (t *Telegram) InitialConnect() {
@@ -135,11 +167,22 @@ func (t *Telegram) InitialConnect() {
 	t.Offset = resp.Result[len(resp.Result)-1].UpdateID
 }
 
-// HandleMessages handles incoming message from the long polling routine
+// HandleMessages handles incoming message from the long polling routine,
+// control commands are authorised against the senders ID
 func (t *Telegram) HandleMessages(text string, chatID int64) error {
+	command := strings.TrimPrefix(text, "/")
+	if base.IsControlCommand(command) {
+		if t.Commands == nil {
+			t.Commands = base.NewCommandHandler(nil)
+		}
+		reply := t.Commands.Handle(strconv.FormatInt(chatID, 10), command)
+		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, reply), chatID)
+	}
+
 	switch {
 	case common.StringContains(text, cmdHelp):
-		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply), chatID)
+		return t.SendMessage(fmt.Sprintf("%s: %s\n%s", talkRoot, cmdHelpReply,
+			base.ControlHelp("/")), chatID)
 
 	case common.StringContains(text, cmdStart):
 		return t.SendMessage(fmt.Sprintf("%s: START COMMANDS HERE", talkRoot), chatID)
@@ -0,0 +1,309 @@
+// Package webhook posts GoCryptoTrader events as JSON or templated payloads to
+// user defined HTTP endpoints so they can be routed into external tooling
+package webhook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+const (
+	defaultHMACHeader  = "X-GCT-Signature"
+	defaultRetryDelay  = time.Second * 2
+	defaultHTTPTimeout = time.Second * 15
+	shutdownTimeout    = time.Second * 5
+	hmacPrefix         = "sha256="
+)
+
+var (
+	errNoEnabledEndpoints = errors.New("webhook error no enabled endpoints")
+	errShutdown           = errors.New("webhook shut down before delivery succeeded")
+)
+
+// Webhook is the overarching type across this package
+type Webhook struct {
+	base.Base
+	Endpoints  []Endpoint
+	HTTPClient *http.Client
+	pending    sync.WaitGroup
+	shutdown   chan struct{}
+}
+
+// Setup takes in a Webhook configuration and sets the endpoints events will
+// be delivered to
+func (w *Webhook) Setup(config config.CommunicationsConfig) {
+	w.Name = config.WebhookConfig.Name
+	w.Enabled = config.WebhookConfig.Enabled
+	w.Verbose = config.WebhookConfig.Verbose
+
+	var endpoints []Endpoint
+	for x := range config.WebhookConfig.Endpoints {
+		endpoints = append(endpoints,
+			Endpoint{
+				Name:              config.WebhookConfig.Endpoints[x].Name,
+				Enabled:           config.WebhookConfig.Endpoints[x].Enabled,
+				URL:               config.WebhookConfig.Endpoints[x].URL,
+				Headers:           config.WebhookConfig.Endpoints[x].Headers,
+				HMACSecret:        config.WebhookConfig.Endpoints[x].HMACSecret,
+				HMACHeader:        config.WebhookConfig.Endpoints[x].HMACHeader,
+				IncludeTickers:    config.WebhookConfig.Endpoints[x].IncludeTickers,
+				IncludeOrderbooks: config.WebhookConfig.Endpoints[x].IncludeOrderbooks,
+				MaxRetries:        config.WebhookConfig.Endpoints[x].MaxRetries,
+				RetryDelay:        config.WebhookConfig.Endpoints[x].RetryDelay,
+				PayloadTemplate:   config.WebhookConfig.Endpoints[x].PayloadTemplate,
+			},
+		)
+	}
+	w.Endpoints = endpoints
+	w.HTTPClient = common.NewHTTPClientWithTimeout(defaultHTTPTimeout)
+}
+
+// Connect validates the enabled endpoints and parses their payload templates
+func (w *Webhook) Connect() error {
+	enabled := 0
+	for x := range w.Endpoints {
+		if !w.Endpoints[x].Enabled {
+			continue
+		}
+
+		_, err := url.ParseRequestURI(w.Endpoints[x].URL)
+		if err != nil {
+			return fmt.Errorf("webhook endpoint %s invalid URL: %s",
+				w.Endpoints[x].Name, err)
+		}
+
+		if w.Endpoints[x].PayloadTemplate != "" {
+			w.Endpoints[x].payloadTemplate, err = template.New(w.Endpoints[x].Name).
+				Funcs(template.FuncMap{"json": templateJSON}).
+				Parse(w.Endpoints[x].PayloadTemplate)
+			if err != nil {
+				return fmt.Errorf("webhook endpoint %s invalid payload template: %s",
+					w.Endpoints[x].Name, err)
+			}
+		}
+		enabled++
+	}
+
+	if enabled == 0 {
+		return errNoEnabledEndpoints
+	}
+
+	if w.HTTPClient == nil {
+		w.HTTPClient = common.NewHTTPClientWithTimeout(defaultHTTPTimeout)
+	}
+
+	if w.shutdown == nil {
+		w.shutdown = make(chan struct{})
+	}
+	w.Connected = true
+	return nil
+}
+
+// Shutdown stops the retries of deliveries in progress and waits up to
+// shutdownTimeout for requests in flight to finish
+func (w *Webhook) Shutdown() {
+	w.Connected = false
+	if w.shutdown != nil {
+		close(w.shutdown)
+		w.shutdown = nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		w.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		log.Printf("Webhook: deliveries still in flight %v after shutdown, abandoning them",
+			shutdownTimeout)
+	}
+}
+
+// PushEvent renders an event for all enabled endpoints and delivers it in the
+// background, so a slow or failing endpoint and its retries do not hold up the
+// other communication mediums. Delivery failures are logged and payloads that
+// fail to render are reported in the returned error
+func (w *Webhook) PushEvent(event base.Event) error {
+	var failed []string
+	shutdown := w.shutdown
+	for x := range w.Endpoints {
+		if !w.Endpoints[x].Enabled {
+			continue
+		}
+
+		e := w.Endpoints[x]
+		body, err := e.RenderPayload(w.BuildPayload(&e, event))
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", e.Name, err))
+			continue
+		}
+
+		w.pending.Add(1)
+		go func() {
+			defer w.pending.Done()
+			err := w.send(&e, body, shutdown)
+			if err != nil {
+				log.Printf("Webhook endpoint %s delivery failed. Err: %s", e.Name, err)
+			}
+		}()
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("webhook PushEvent() failed to render for %d endpoint(s): %s",
+			len(failed), common.JoinStrings(failed, ", "))
+	}
+	return nil
+}
+
+// BuildPayload returns the payload for an event including the staged ticker
+// and orderbook data if requested by the endpoint
+func (w *Webhook) BuildPayload(e *Endpoint, event base.Event) Payload {
+	payload := Payload{
+		Timestamp:    time.Now().Unix(),
+		Type:         event.Type,
+		TradeDetails: event.TradeDetails,
+		GainLoss:     event.GainLoss,
+		Severity:     event.Severity,
+		Category:     event.Category,
+		Message:      event.String(),
+	}
+
+	if e.IncludeTickers {
+		payload.Tickers = w.GetStagedTickers()
+	}
+
+	if e.IncludeOrderbooks {
+		payload.Orderbooks = w.GetStagedOrderbooks()
+	}
+	return payload
+}
+
+// RenderPayload returns the request body for an endpoint, using its payload
+// template if one is set or JSON otherwise
+func (e *Endpoint) RenderPayload(payload Payload) ([]byte, error) {
+	if e.payloadTemplate == nil {
+		return common.JSONEncode(payload)
+	}
+
+	var body bytes.Buffer
+	err := e.payloadTemplate.Execute(&body, payload)
+	if err != nil {
+		return nil, err
+	}
+	return body.Bytes(), nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the body using the
+// endpoints secret
+func (e *Endpoint) Sign(body []byte) string {
+	return hmacPrefix + common.HexEncodeToString(
+		common.GetHMAC(common.HashSHA256, body, []byte(e.HMACSecret)))
+}
+
+// Send posts a rendered payload to an endpoint, retrying on network errors,
+// rate limits and server side failures. It blocks until the delivery succeeds,
+// the retries are exhausted or the webhook is shut down
+func (w *Webhook) Send(e *Endpoint, body []byte) error {
+	return w.send(e, body, w.shutdown)
+}
+
+// send delivers a payload, retries stop once the shutdown channel is closed
+func (w *Webhook) send(e *Endpoint, body []byte, shutdown chan struct{}) error {
+	retryDelay := e.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = defaultRetryDelay
+	}
+
+	for attempt := 0; ; attempt++ {
+		retry, err := w.sendRequest(e, body)
+		if err == nil {
+			return nil
+		}
+
+		if !retry || attempt >= e.MaxRetries {
+			return err
+		}
+
+		if w.Verbose {
+			log.Printf("Webhook endpoint %s delivery failed, retrying (%d/%d). Err: %s",
+				e.Name, attempt+1, e.MaxRetries, err)
+		}
+		select {
+		case <-time.After(retryDelay * time.Duration(attempt+1)):
+		case <-shutdown:
+			return errShutdown
+		}
+	}
+}
+
+// sendRequest performs a single delivery attempt and reports whether a failure
+// is worth retrying
+func (w *Webhook) sendRequest(e *Endpoint, body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.Headers {
+		req.Header.Set(k, v)
+	}
+
+	if e.HMACSecret != "" {
+		header := e.HMACHeader
+		if header == "" {
+			header = defaultHMACHeader
+		}
+		req.Header.Set(header, e.Sign(body))
+	}
+
+	resp, err := w.HTTPClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return true, err
+	}
+
+	if w.Verbose {
+		log.Printf("Webhook endpoint %s response %d: %s", e.Name,
+			resp.StatusCode, string(contents))
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+}
+
+// templateJSON is exposed to payload templates as "json" so values can be
+// embedded safely inside a JSON document
+func templateJSON(v interface{}) (string, error) {
+	data, err := common.JSONEncode(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
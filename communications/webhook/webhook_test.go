@@ -0,0 +1,234 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+var w Webhook
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	w.Setup(cfg.GetCommunicationsConfig())
+	if w.Name != "Webhook" || w.HTTPClient == nil {
+		t.Error("test failed - webhook Setup() error")
+	}
+}
+
+func TestConnect(t *testing.T) {
+	w.Endpoints = nil
+	err := w.Connect()
+	if err == nil {
+		t.Error("test failed - webhook Connect() error")
+	}
+
+	w.Endpoints = []Endpoint{{Name: "bad", Enabled: true, URL: "lol"}}
+	err = w.Connect()
+	if err == nil {
+		t.Error("test failed - webhook Connect() error")
+	}
+
+	w.Endpoints = []Endpoint{{Name: "badtemplate", Enabled: true,
+		URL: "http://localhost", PayloadTemplate: "{{.Type"}}
+	err = w.Connect()
+	if err == nil {
+		t.Error("test failed - webhook Connect() error")
+	}
+
+	w.Endpoints = []Endpoint{{Name: "good", Enabled: true, URL: "http://localhost"}}
+	err = w.Connect()
+	if err != nil {
+		t.Error("test failed - webhook Connect() error", err)
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	var received []byte
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		received, _ = ioutil.ReadAll(r.Body)
+		signature = r.Header.Get(defaultHMACHeader)
+		if r.Header.Get("X-Test") != "test" {
+			rw.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	w.Endpoints = []Endpoint{{
+		Name:       "test",
+		Enabled:    true,
+		URL:        server.URL,
+		Headers:    map[string]string{"X-Test": "test"},
+		HMACSecret: "secret",
+	}}
+	err := w.Connect()
+	if err != nil {
+		t.Fatal("test failed - webhook Connect() error", err)
+	}
+
+	err = w.PushEvent(base.Event{Type: "TEST", TradeDetails: "details"})
+	if err != nil {
+		t.Fatal("test failed - webhook PushEvent() error", err)
+	}
+	w.pending.Wait()
+
+	var p Payload
+	err = common.JSONDecode(received, &p)
+	if err != nil || p.Type != "TEST" || p.TradeDetails != "details" {
+		t.Error("test failed - webhook PushEvent() unexpected payload", string(received))
+	}
+
+	if signature != w.Endpoints[0].Sign(received) {
+		t.Error("test failed - webhook PushEvent() invalid signature", signature)
+	}
+}
+
+func TestPushEventTemplate(t *testing.T) {
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		received, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	w.Endpoints = []Endpoint{{
+		Name:            "template",
+		Enabled:         true,
+		URL:             server.URL,
+		PayloadTemplate: `{"text":{{json .Message}}}`,
+	}}
+	err := w.Connect()
+	if err != nil {
+		t.Fatal("test failed - webhook Connect() error", err)
+	}
+
+	err = w.PushEvent(base.Event{Type: "TEST"})
+	if err != nil {
+		t.Fatal("test failed - webhook PushEvent() error", err)
+	}
+	w.pending.Wait()
+
+	if string(received) != `{"text":"Type: TEST Details:  GainOrLoss: "}` {
+		t.Error("test failed - webhook PushEvent() unexpected payload", string(received))
+	}
+}
+
+func TestSendRetry(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	w.Endpoints = []Endpoint{{
+		Name:       "retry",
+		Enabled:    true,
+		URL:        server.URL,
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond,
+	}}
+	err := w.Connect()
+	if err != nil {
+		t.Fatal("test failed - webhook Connect() error", err)
+	}
+
+	err = w.Send(&w.Endpoints[0], []byte("{}"))
+	if err != nil || attempts != 3 {
+		t.Error("test failed - webhook Send() retry error", err, attempts)
+	}
+
+	attempts = 0
+	w.Endpoints[0].MaxRetries = 1
+	err = w.Send(&w.Endpoints[0], []byte("{}"))
+	if err == nil || attempts != 2 {
+		t.Error("test failed - webhook Send() retry error", err, attempts)
+	}
+}
+
+func TestPushEventDoesNotBlockOnRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	w.Endpoints = []Endpoint{{
+		Name:       "failing",
+		Enabled:    true,
+		URL:        server.URL,
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond * 200,
+	}}
+	err := w.Connect()
+	if err != nil {
+		t.Fatal("test failed - webhook Connect() error", err)
+	}
+
+	start := time.Now()
+	err = w.PushEvent(base.Event{})
+	if err != nil || time.Since(start) > time.Millisecond*100 {
+		t.Error("test failed - webhook PushEvent() blocked on retries", err,
+			time.Since(start))
+	}
+
+	w.pending.Wait()
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Error("test failed - webhook PushEvent() retry error", attempts)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	w.Endpoints = []Endpoint{{
+		Name:       "shutdown",
+		Enabled:    true,
+		URL:        server.URL,
+		MaxRetries: 5,
+		RetryDelay: time.Second,
+	}}
+	err := w.Connect()
+	if err != nil {
+		t.Fatal("test failed - webhook Connect() error", err)
+	}
+
+	err = w.PushEvent(base.Event{})
+	if err != nil {
+		t.Fatal("test failed - webhook PushEvent() error", err)
+	}
+	for i := 0; i < 100 && atomic.LoadInt32(&attempts) == 0; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	start := time.Now()
+	w.Shutdown()
+	if time.Since(start) > time.Millisecond*500 || w.IsConnected() {
+		t.Error("test failed - webhook Shutdown() didn't stop the retries",
+			time.Since(start))
+	}
+	if atomic.LoadInt32(&attempts) != 1 {
+		t.Error("test failed - webhook Shutdown() retried after shutdown", attempts)
+	}
+
+	err = w.Connect()
+	if err != nil || w.shutdown == nil {
+		t.Error("test failed - webhook Connect() after Shutdown() error", err)
+	}
+}
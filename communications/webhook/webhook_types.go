@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"text/template"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// Endpoint holds the details for an individual URL that events are posted to
+type Endpoint struct {
+	Name              string
+	Enabled           bool
+	URL               string
+	Headers           map[string]string
+	HMACSecret        string
+	HMACHeader        string
+	IncludeTickers    bool
+	IncludeOrderbooks bool
+	MaxRetries        int
+	RetryDelay        time.Duration
+	PayloadTemplate   string
+	payloadTemplate   *template.Template
+}
+
+// Payload is the data sent to an endpoint. When an endpoint has no payload
+// template it is JSON encoded as the request body, otherwise it is passed to
+// the template for rendering
+type Payload struct {
+	Timestamp    int64                                           `json:"timestamp"`
+	Type         string                                          `json:"type"`
+	TradeDetails string                                          `json:"tradeDetails"`
+	GainLoss     string                                          `json:"gainLoss"`
+	Severity     string                                          `json:"severity"`
+	Category     string                                          `json:"category"`
+	Message      string                                          `json:"message"`
+	Tickers      map[string]map[string]map[string]ticker.Price   `json:"tickers,omitempty"`
+	Orderbooks   map[string]map[string]map[string]base.Orderbook `json:"orderbooks,omitempty"`
+}
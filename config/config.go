@@ -1,7 +1,6 @@
 package config
 
 import (
-	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -19,6 +18,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/forexprovider/base"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/portfolio"
+	"gopkg.in/yaml.v3"
 )
 
 // Constants declared here are filename strings and test strings
@@ -26,6 +26,7 @@ const (
 	FXProviderFixer                        = "fixer"
 	EncryptedConfigFile                    = "config.dat"
 	ConfigFile                             = "config.json"
+	ConfigFileYAML                         = "config.yaml"
 	ConfigTestFile                         = "../testdata/configtest.json"
 	configFileEncryptionPrompt             = 0
 	configFileEncryptionEnabled            = 1
@@ -53,13 +54,57 @@ const (
 	WarningWebserverCredentialValuesEmpty           = "WARNING -- Webserver support disabled due to empty Username/Password values."
 	WarningWebserverListenAddressInvalid            = "WARNING -- Webserver support disabled due to invalid listen address."
 	WarningWebserverRootWebFolderNotFound           = "WARNING -- Webserver support disabled due to missing web folder."
+	WarningWebserverAPITokenInvalid                 = "WARNING -- Webserver support disabled due to API token #%d having an empty token or invalid scope."
+	WarningWebserverTLSCertKeyMismatch              = "WARNING -- Webserver support disabled due to only one of the TLS cert and key files being set."
+	WarningWebserverAPITokenDuplicate               = "WARNING -- Webserver support disabled due to duplicate API token %s."
+	WarningGRPCCredentialValuesEmpty                = "WARNING -- gRPC support disabled due to empty webserver Username/Password values."
+	WarningGRPCListenAddressInvalid                 = "WARNING -- gRPC support disabled due to invalid listen address."
 	WarningExchangeAuthAPIDefaultOrEmptyValues      = "WARNING -- Exchange %s: Authenticated API support disabled due to default/empty APIKey/Secret/ClientID values."
 	WarningCurrencyExchangeProvider                 = "WARNING -- Currency exchange provider invalid valid. Reset to Fixer."
 	WarningPairsLastUpdatedThresholdExceeded        = "WARNING -- Exchange %s: Last manual update of available currency pairs has exceeded %d days. Manual update required!"
+	WarningForexProviderAPIKeyNotSet                = "WARNING -- %s forex provider API key not set, the provider will be disabled. Please set this in your config.json file"
+	WarningForexProviderAPIKeyLevelNotSet           = "WARNING -- %s APIKey Level not set, functions limited. Please set this in your config.json file"
+	WarningNoForexProvidersEnabled                  = "WARNING -- No forex providers enabled, defaulting to free provider CurrencyConverterAPI."
 	APIURLNonDefaultMessage                         = "NON_DEFAULT_HTTP_LINK_TO_EXCHANGE_API"
 	WebsocketURLNonDefaultMessage                   = "NON_DEFAULT_HTTP_LINK_TO_WEBSOCKET_EXCHANGE_API"
 )
 
+// Roles which can be assigned to communications users, each role includes the
+// commands available to the roles before it
+const (
+	CommsRoleViewer = "viewer"
+	CommsRoleTrader = "trader"
+	CommsRoleAdmin  = "admin"
+)
+
+// Scopes which can be granted to RESTful API tokens, admin tokens may also
+// use read routes
+const (
+	APIScopeRead  = "read"
+	APIScopeAdmin = "admin"
+)
+
+// Severities and categories used to route events to communications mediums
+const (
+	AlertSeverityInfo     = "info"
+	AlertSeverityWarning  = "warning"
+	AlertSeverityCritical = "critical"
+
+	AlertCategoryTradeFill           = "trade_fill"
+	AlertCategoryEventTrigger        = "event_trigger"
+	AlertCategoryWebsocketDisconnect = "websocket_disconnect"
+	AlertCategoryError               = "error"
+
+	alertQuietHoursFormat = "15:04"
+)
+
+// AlertSeverityLevels orders the alert severities from lowest to highest
+var AlertSeverityLevels = map[string]int{
+	AlertSeverityInfo:     1,
+	AlertSeverityWarning:  2,
+	AlertSeverityCritical: 3,
+}
+
 // Variables here are used for configuration
 var (
 	Cfg            Config
@@ -70,13 +115,55 @@ var (
 
 // WebserverConfig struct holds the prestart variables for the webserver.
 type WebserverConfig struct {
-	Enabled                      bool   `json:"enabled"`
-	AdminUsername                string `json:"adminUsername"`
-	AdminPassword                string `json:"adminPassword"`
-	ListenAddress                string `json:"listenAddress"`
-	WebsocketConnectionLimit     int    `json:"websocketConnectionLimit"`
-	WebsocketMaxAuthFailures     int    `json:"websocketMaxAuthFailures"`
-	WebsocketAllowInsecureOrigin bool   `json:"websocketAllowInsecureOrigin"`
+	Enabled                      bool               `json:"enabled"`
+	AdminUsername                string             `json:"adminUsername"`
+	AdminPassword                string             `json:"adminPassword"`
+	ListenAddress                string             `json:"listenAddress"`
+	WebsocketConnectionLimit     int                `json:"websocketConnectionLimit"`
+	WebsocketMaxAuthFailures     int                `json:"websocketMaxAuthFailures"`
+	WebsocketAllowInsecureOrigin bool               `json:"websocketAllowInsecureOrigin"`
+	AllowWithdrawals             bool               `json:"allowWithdrawals"`
+	APITokens                    []APIToken         `json:"apiTokens,omitempty"`
+	TLS                          WebserverTLSConfig `json:"tls"`
+	GRPC                         GRPCConfig         `json:"grpc"`
+	Health                       HealthConfig       `json:"health"`
+}
+
+// HealthConfig holds the staleness thresholds used by the health and readiness
+// endpoints. A zero threshold uses the default and a negative one disables the
+// check. Forex rates are only fetched at startup and on reload, so a zero
+// ForexStaleness leaves the forex check disabled
+type HealthConfig struct {
+	TickerStaleness    time.Duration `json:"tickerStaleness"`
+	PortfolioStaleness time.Duration `json:"portfolioStaleness"`
+	ForexStaleness     time.Duration `json:"forexStaleness"`
+}
+
+// GRPCConfig holds the settings for the gRPC remote control API. Clients
+// authenticate with the webserver admin credentials and the webserver TLS
+// settings are used when TLS is enabled
+type GRPCConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+}
+
+// WebserverTLSConfig holds the TLS settings for the webserver and websocket
+// endpoint. If CertFile and KeyFile are left blank a self-signed certificate
+// is generated in the data directory on first start. If ClientCAFile is set
+// clients must present a certificate signed by it
+type WebserverTLSConfig struct {
+	Enabled      bool   `json:"enabled"`
+	CertFile     string `json:"certFile,omitempty"`
+	KeyFile      string `json:"keyFile,omitempty"`
+	ClientCAFile string `json:"clientCAFile,omitempty"`
+}
+
+// APIToken is a bearer token which grants access to the RESTful API, Scope is
+// either read or admin
+type APIToken struct {
+	Name  string `json:"name"`
+	Token string `json:"token"`
+	Scope string `json:"scope"`
 }
 
 // Post holds the bot configuration data
@@ -97,6 +184,7 @@ type CurrencyPairFormatConfig struct {
 // Exchanges
 type Config struct {
 	Name              string               `json:"name"`
+	Version           int                  `json:"version"`
 	EncryptConfig     int                  `json:"encryptConfig"`
 	GlobalHTTPTimeout time.Duration        `json:"globalHTTPTimeout"`
 	Currency          CurrencyConfig       `json:"currencyConfig"`
@@ -105,12 +193,32 @@ type Config struct {
 	Webserver         WebserverConfig      `json:"webserver"`
 	Exchanges         []ExchangeConfig     `json:"exchanges"`
 	BankAccounts      []BankAccount        `json:"bankAccounts"`
+	Includes          []string             `json:"includes,omitempty"`
+	Profile           string               `json:"profile,omitempty"`
+	Profiles          []ProfileConfig      `json:"profiles,omitempty"`
 
 	// Deprecated config settings, will be removed at a future date
 	CurrencyPairFormat  *CurrencyPairFormatConfig `json:"currencyPairFormat,omitempty"`
 	FiatDisplayCurrency string                    `json:"fiatDispayCurrency,omitempty"`
 	Cryptocurrencies    string                    `json:"cryptocurrencies,omitempty"`
 	SMS                 *SMSGlobalConfig          `json:"smsGlobal,omitempty"`
+
+	// secrets holds the secret references resolved when the config was loaded
+	secrets map[string]secretRef
+	// format holds the format the config file was read in, used when saving
+	// to a file whose extension doesn't name a format
+	format string
+	// yamlComments holds the parsed YAML config file so its comments are
+	// written back when the config is saved as YAML
+	yamlComments *yaml.Node
+	// includedFiles holds the paths of the files merged into the config
+	includedFiles []string
+	// profileOverlay is set when a profile other than the one named in the
+	// config file was applied
+	profileOverlay bool
+	// profileBase holds the settings the applied profile overrode, they are
+	// saved in place of the overrides
+	profileBase *profileBase
 }
 
 // ExchangeConfig holds all the information needed for each enabled Exchange.
@@ -181,15 +289,44 @@ type CommunicationsConfig struct {
 	SMSGlobalConfig SMSGlobalConfig `json:"smsGlobal"`
 	SMTPConfig      SMTPConfig      `json:"smtp"`
 	TelegramConfig  TelegramConfig  `json:"telegram"`
+	WebhookConfig   WebhookConfig   `json:"webhook"`
+	DiscordConfig   DiscordConfig   `json:"discord"`
+	MatrixConfig    MatrixConfig    `json:"matrix"`
+	AlertRules      []AlertRule     `json:"alertRules,omitempty"`
+}
+
+// AlertRule controls which events are delivered to a communications medium.
+// Mediums without a rule receive every event. Categories and MinSeverity
+// filter events, RateLimit caps deliveries per RateLimitWindow, repeated
+// events within DedupWindow are dropped and only critical events are
+// delivered between QuietHoursStart and QuietHoursEnd (local time, HH:MM)
+type AlertRule struct {
+	Medium          string        `json:"medium"`
+	Categories      []string      `json:"categories,omitempty"`
+	MinSeverity     string        `json:"minSeverity,omitempty"`
+	RateLimit       int           `json:"rateLimit"`
+	RateLimitWindow time.Duration `json:"rateLimitWindow"`
+	DedupWindow     time.Duration `json:"dedupWindow"`
+	QuietHoursStart string        `json:"quietHoursStart,omitempty"`
+	QuietHoursEnd   string        `json:"quietHoursEnd,omitempty"`
 }
 
 // SlackConfig holds all variables to start and run the Slack package
 type SlackConfig struct {
-	Name              string `json:"name"`
-	Enabled           bool   `json:"enabled"`
-	Verbose           bool   `json:"verbose"`
-	TargetChannel     string `json:"targetChannel"`
-	VerificationToken string `json:"verificationToken"`
+	Name              string      `json:"name"`
+	Enabled           bool        `json:"enabled"`
+	Verbose           bool        `json:"verbose"`
+	TargetChannel     string      `json:"targetChannel"`
+	VerificationToken string      `json:"verificationToken"`
+	AuthorisedUsers   []CommsUser `json:"authorisedUsers,omitempty"`
+}
+
+// CommsUser is a chat user that is allowed to issue control commands to the
+// bot, Role is one of viewer, trader or admin and determines which commands
+// the user may run
+type CommsUser struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
 }
 
 // SMSContact stores the SMS contact info
@@ -224,10 +361,55 @@ type SMTPConfig struct {
 
 // TelegramConfig holds all variables to start and run the Telegram package
 type TelegramConfig struct {
-	Name              string `json:"name"`
-	Enabled           bool   `json:"enabled"`
-	Verbose           bool   `json:"verbose"`
-	VerificationToken string `json:"verificationToken"`
+	Name              string      `json:"name"`
+	Enabled           bool        `json:"enabled"`
+	Verbose           bool        `json:"verbose"`
+	VerificationToken string      `json:"verificationToken"`
+	AuthorisedUsers   []CommsUser `json:"authorisedUsers,omitempty"`
+}
+
+// WebhookConfig holds all variables to start and run the Webhook package
+type WebhookConfig struct {
+	Name      string            `json:"name"`
+	Enabled   bool              `json:"enabled"`
+	Verbose   bool              `json:"verbose"`
+	Endpoints []WebhookEndpoint `json:"endpoints"`
+}
+
+// WebhookEndpoint holds the details for an individual URL that events are
+// posted to. PayloadTemplate is an optional text/template used to render the
+// request body, if left blank the event is sent as JSON
+type WebhookEndpoint struct {
+	Name              string            `json:"name"`
+	Enabled           bool              `json:"enabled"`
+	URL               string            `json:"url"`
+	Headers           map[string]string `json:"headers,omitempty"`
+	HMACSecret        string            `json:"hmacSecret,omitempty"`
+	HMACHeader        string            `json:"hmacHeader,omitempty"`
+	PayloadTemplate   string            `json:"payloadTemplate,omitempty"`
+	IncludeTickers    bool              `json:"includeTickers"`
+	IncludeOrderbooks bool              `json:"includeOrderbooks"`
+	MaxRetries        int               `json:"maxRetries"`
+	RetryDelay        time.Duration     `json:"retryDelay"`
+}
+
+// DiscordConfig holds all variables to start and run the Discord package
+type DiscordConfig struct {
+	Name      string `json:"name"`
+	Enabled   bool   `json:"enabled"`
+	Verbose   bool   `json:"verbose"`
+	BotToken  string `json:"botToken"`
+	ChannelID string `json:"channelID"`
+}
+
+// MatrixConfig holds all variables to start and run the Matrix package
+type MatrixConfig struct {
+	Name          string `json:"name"`
+	Enabled       bool   `json:"enabled"`
+	Verbose       bool   `json:"verbose"`
+	HomeserverURL string `json:"homeserverURL"`
+	AccessToken   string `json:"accessToken"`
+	RoomID        string `json:"roomID"`
 }
 
 // GetCurrencyConfig returns currency configurations
@@ -324,29 +506,45 @@ func (c *Config) CheckClientBankAccounts() error {
 		return nil
 	}
 
+	errs := clientBankAccountErrors(c.BankAccounts)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
 	for i := range c.BankAccounts {
-		if c.BankAccounts[i].Enabled == true {
-			if c.BankAccounts[i].BankName == "" || c.BankAccounts[i].BankAddress == "" {
-				return fmt.Errorf("banking details for %s is enabled but variables not set correctly",
-					c.BankAccounts[i].BankName)
-			}
+		if c.BankAccounts[i].Enabled && c.BankAccounts[i].SupportedExchanges == "" {
+			c.BankAccounts[i].SupportedExchanges = "ALL"
+		}
+	}
+	return nil
+}
 
-			if c.BankAccounts[i].AccountName == "" || c.BankAccounts[i].AccountNumber == "" {
-				return fmt.Errorf("banking account details for %s variables not set correctly",
-					c.BankAccounts[i].BankName)
-			}
-			if c.BankAccounts[i].IBAN == "" && c.BankAccounts[i].SWIFTCode == "" && c.BankAccounts[i].BSBNumber == "" {
-				return fmt.Errorf("critical banking numbers not set for %s in %s account",
-					c.BankAccounts[i].BankName,
-					c.BankAccounts[i].AccountName)
-			}
+// clientBankAccountErrors returns every problem with the enabled client bank
+// accounts
+func clientBankAccountErrors(accounts []BankAccount) []error {
+	var errs []error
+	for i := range accounts {
+		if !accounts[i].Enabled {
+			continue
+		}
 
-			if c.BankAccounts[i].SupportedExchanges == "" {
-				c.BankAccounts[i].SupportedExchanges = "ALL"
-			}
+		if accounts[i].BankName == "" || accounts[i].BankAddress == "" {
+			errs = append(errs, fmt.Errorf("banking details for %s is enabled but variables not set correctly",
+				accounts[i].BankName))
+		}
+
+		if accounts[i].AccountName == "" || accounts[i].AccountNumber == "" {
+			errs = append(errs, fmt.Errorf("banking account details for %s variables not set correctly",
+				accounts[i].BankName))
+		}
+
+		if accounts[i].IBAN == "" && accounts[i].SWIFTCode == "" && accounts[i].BSBNumber == "" {
+			errs = append(errs, fmt.Errorf("critical banking numbers not set for %s in %s account",
+				accounts[i].BankName,
+				accounts[i].AccountName))
 		}
 	}
-	return nil
+	return errs
 }
 
 // GetCommunicationsConfig returns the communications configuration
@@ -370,9 +568,24 @@ func (c *Config) CheckCommunicationsConfig() error {
 	m.Lock()
 	defer m.Unlock()
 
-	// If the communications config hasn't been populated, populate
-	// with example settings
+	c.setCommunicationsDefaults()
+	errs := c.communicationsErrors()
+	if len(errs) > 0 {
+		return errs[0]
+	}
 
+	for i := range c.Communications.AlertRules {
+		rule := &c.Communications.AlertRules[i]
+		if rule.RateLimit > 0 && rule.RateLimitWindow == 0 {
+			rule.RateLimitWindow = time.Minute
+		}
+	}
+	return nil
+}
+
+// setCommunicationsDefaults populates any communications medium which hasn't
+// been configured with example settings
+func (c *Config) setCommunicationsDefaults() {
 	if c.Communications.SlackConfig.Name == "" {
 		c.Communications.SlackConfig = SlackConfig{
 			Name:              "Slack",
@@ -382,53 +595,18 @@ func (c *Config) CheckCommunicationsConfig() error {
 	}
 
 	if c.Communications.SMSGlobalConfig.Name == "" {
-		if c.SMS != nil {
-			if c.SMS.Contacts != nil {
-				c.Communications.SMSGlobalConfig = SMSGlobalConfig{
-					Name:     "SMSGlobal",
-					Enabled:  c.SMS.Enabled,
-					Verbose:  c.SMS.Verbose,
-					Username: c.SMS.Username,
-					Password: c.SMS.Password,
-					Contacts: c.SMS.Contacts,
-				}
-				// flush old SMS config
-				c.SMS = nil
-			} else {
-				c.Communications.SMSGlobalConfig = SMSGlobalConfig{
-					Name:     "SMSGlobal",
-					Username: "main",
-					Password: "test",
-
-					Contacts: []SMSContact{
-						{
-							Name:    "bob",
-							Number:  "1234",
-							Enabled: false,
-						},
-					},
-				}
-			}
-		} else {
-			c.Communications.SMSGlobalConfig = SMSGlobalConfig{
-				Name:     "SMSGlobal",
-				Username: "main",
-				Password: "test",
-
-				Contacts: []SMSContact{
-					{
-						Name:    "bob",
-						Number:  "1234",
-						Enabled: false,
-					},
+		c.Communications.SMSGlobalConfig = SMSGlobalConfig{
+			Name:     "SMSGlobal",
+			Username: "main",
+			Password: "test",
+
+			Contacts: []SMSContact{
+				{
+					Name:    "bob",
+					Number:  "1234",
+					Enabled: false,
 				},
-			}
-		}
-
-	} else {
-		if c.SMS != nil {
-			// flush old SMS config
-			c.SMS = nil
+			},
 		}
 	}
 
@@ -450,24 +628,65 @@ func (c *Config) CheckCommunicationsConfig() error {
 		}
 	}
 
+	if c.Communications.WebhookConfig.Name == "" {
+		c.Communications.WebhookConfig = WebhookConfig{
+			Name: "Webhook",
+			Endpoints: []WebhookEndpoint{
+				{
+					Name:       "example",
+					URL:        "https://example.com/webhook",
+					MaxRetries: 3,
+					RetryDelay: time.Second * 2,
+				},
+			},
+		}
+	}
+
+	if c.Communications.DiscordConfig.Name == "" {
+		c.Communications.DiscordConfig = DiscordConfig{
+			Name:      "Discord",
+			BotToken:  "testtest",
+			ChannelID: "0",
+		}
+	}
+
+	if c.Communications.MatrixConfig.Name == "" {
+		c.Communications.MatrixConfig = MatrixConfig{
+			Name:          "Matrix",
+			HomeserverURL: "https://matrix.org",
+			AccessToken:   "testtest",
+			RoomID:        "!room:matrix.org",
+		}
+	}
+}
+
+// communicationsErrors returns every problem with the communications config,
+// the caller must hold the config lock
+func (c *Config) communicationsErrors() []error {
+	var errs []error
 	if c.Communications.SlackConfig.Name != "Slack" ||
 		c.Communications.SMSGlobalConfig.Name != "SMSGlobal" ||
 		c.Communications.SMTPConfig.Name != "SMTP" ||
-		c.Communications.TelegramConfig.Name != "Telegram" {
-		return errors.New("Communications config name/s not set correctly")
+		c.Communications.TelegramConfig.Name != "Telegram" ||
+		c.Communications.WebhookConfig.Name != "Webhook" ||
+		c.Communications.DiscordConfig.Name != "Discord" ||
+		c.Communications.MatrixConfig.Name != "Matrix" {
+		errs = append(errs, errors.New("Communications config name/s not set correctly"))
 	}
 	if c.Communications.SlackConfig.Enabled {
 		if c.Communications.SlackConfig.TargetChannel == "" ||
 			c.Communications.SlackConfig.VerificationToken == "" ||
 			c.Communications.SlackConfig.VerificationToken == "testtest" {
-			return errors.New("Slack enabled in config but variable data not set")
+			errs = append(errs, errors.New("Slack enabled in config but variable data not set"))
 		}
+		errs = append(errs, commsUsersErrors("Slack",
+			c.Communications.SlackConfig.AuthorisedUsers)...)
 	}
 	if c.Communications.SMSGlobalConfig.Enabled {
 		if c.Communications.SMSGlobalConfig.Username == "" ||
 			c.Communications.SMSGlobalConfig.Password == "" ||
 			len(c.Communications.SMSGlobalConfig.Contacts) == 0 {
-			return errors.New("SMSGlobal enabled in config but variable data not set")
+			errs = append(errs, errors.New("SMSGlobal enabled in config but variable data not set"))
 		}
 	}
 	if c.Communications.SMTPConfig.Enabled {
@@ -475,15 +694,134 @@ func (c *Config) CheckCommunicationsConfig() error {
 			c.Communications.SMTPConfig.Port == "" ||
 			c.Communications.SMTPConfig.AccountName == "" ||
 			c.Communications.SMTPConfig.AccountPassword == "" {
-			return errors.New("SMTP enabled in config but variable data not set")
+			errs = append(errs, errors.New("SMTP enabled in config but variable data not set"))
 		}
 	}
 	if c.Communications.TelegramConfig.Enabled {
 		if c.Communications.TelegramConfig.VerificationToken == "" {
-			return errors.New("Telegram enabled in config but variable data not set")
+			errs = append(errs, errors.New("Telegram enabled in config but variable data not set"))
 		}
+		errs = append(errs, commsUsersErrors("Telegram",
+			c.Communications.TelegramConfig.AuthorisedUsers)...)
 	}
-	return nil
+	if c.Communications.WebhookConfig.Enabled {
+		enabledEndpoints := 0
+		for i := range c.Communications.WebhookConfig.Endpoints {
+			if !c.Communications.WebhookConfig.Endpoints[i].Enabled {
+				continue
+			}
+			if c.Communications.WebhookConfig.Endpoints[i].URL == "" {
+				errs = append(errs, fmt.Errorf("Webhook endpoint %s enabled in config but URL not set",
+					c.Communications.WebhookConfig.Endpoints[i].Name))
+			}
+			enabledEndpoints++
+		}
+		if enabledEndpoints == 0 {
+			errs = append(errs, errors.New("Webhook enabled in config but no endpoints enabled"))
+		}
+	}
+	if c.Communications.DiscordConfig.Enabled {
+		if c.Communications.DiscordConfig.BotToken == "" ||
+			c.Communications.DiscordConfig.BotToken == "testtest" ||
+			c.Communications.DiscordConfig.ChannelID == "" {
+			errs = append(errs, errors.New("Discord enabled in config but variable data not set"))
+		}
+	}
+	if c.Communications.MatrixConfig.Enabled {
+		if c.Communications.MatrixConfig.HomeserverURL == "" ||
+			c.Communications.MatrixConfig.AccessToken == "" ||
+			c.Communications.MatrixConfig.AccessToken == "testtest" ||
+			c.Communications.MatrixConfig.RoomID == "" {
+			errs = append(errs, errors.New("Matrix enabled in config but variable data not set"))
+		}
+	}
+	return append(errs, c.alertRuleErrors()...)
+}
+
+// alertRuleErrors returns every problem with the communications alert routing
+// rules, the caller must hold the config lock
+func (c *Config) alertRuleErrors() []error {
+	var errs []error
+	mediums := map[string]bool{
+		c.Communications.SlackConfig.Name:     true,
+		c.Communications.SMSGlobalConfig.Name: true,
+		c.Communications.SMTPConfig.Name:      true,
+		c.Communications.TelegramConfig.Name:  true,
+		c.Communications.WebhookConfig.Name:   true,
+		c.Communications.DiscordConfig.Name:   true,
+		c.Communications.MatrixConfig.Name:    true,
+	}
+	categories := map[string]bool{
+		AlertCategoryTradeFill:           true,
+		AlertCategoryEventTrigger:        true,
+		AlertCategoryWebsocketDisconnect: true,
+		AlertCategoryError:               true,
+	}
+
+	seen := make(map[string]bool)
+	for i := range c.Communications.AlertRules {
+		rule := c.Communications.AlertRules[i]
+		if !mediums[rule.Medium] {
+			errs = append(errs, fmt.Errorf("Alert rule %d has unknown medium %q", i, rule.Medium))
+			continue
+		}
+		if seen[rule.Medium] {
+			errs = append(errs, fmt.Errorf("Alert rule for %s defined more than once", rule.Medium))
+			continue
+		}
+		seen[rule.Medium] = true
+
+		for x := range rule.Categories {
+			if !categories[rule.Categories[x]] {
+				errs = append(errs, fmt.Errorf("Alert rule for %s has unknown category %q",
+					rule.Medium, rule.Categories[x]))
+			}
+		}
+
+		if _, ok := AlertSeverityLevels[rule.MinSeverity]; rule.MinSeverity != "" && !ok {
+			errs = append(errs, fmt.Errorf("Alert rule for %s has unknown severity %q",
+				rule.Medium, rule.MinSeverity))
+		}
+
+		if rule.RateLimit < 0 || rule.RateLimitWindow < 0 || rule.DedupWindow < 0 {
+			errs = append(errs, fmt.Errorf("Alert rule for %s has negative rate limit or window",
+				rule.Medium))
+		}
+
+		if (rule.QuietHoursStart == "") != (rule.QuietHoursEnd == "") {
+			errs = append(errs, fmt.Errorf("Alert rule for %s requires both quiet hours start and end",
+				rule.Medium))
+		}
+
+		if rule.QuietHoursStart != "" {
+			_, errStart := time.Parse(alertQuietHoursFormat, rule.QuietHoursStart)
+			_, errEnd := time.Parse(alertQuietHoursFormat, rule.QuietHoursEnd)
+			if errStart != nil || errEnd != nil {
+				errs = append(errs, fmt.Errorf("Alert rule for %s quiet hours must be in HH:MM format",
+					rule.Medium))
+			}
+		}
+	}
+	return errs
+}
+
+// commsUsersErrors returns every authorised user of a communications medium
+// without an ID or with an unknown role
+func commsUsersErrors(medium string, users []CommsUser) []error {
+	var errs []error
+	for i := range users {
+		if users[i].ID == "" {
+			errs = append(errs, fmt.Errorf("%s authorised user %d has no ID set", medium, i))
+			continue
+		}
+		switch users[i].Role {
+		case CommsRoleViewer, CommsRoleTrader, CommsRoleAdmin:
+		default:
+			errs = append(errs, fmt.Errorf("%s authorised user %s has invalid role %q",
+				medium, users[i].ID, users[i].Role))
+		}
+	}
+	return errs
 }
 
 // CheckPairConsistency checks to see if the enabled pair exists in the
@@ -499,18 +837,8 @@ func (c *Config) CheckPairConsistency(exchName string) error {
 		return err
 	}
 
-	var pairs, pairsRemoved []pair.CurrencyPair
-	update := false
-	for x := range enabledPairs {
-		if !pair.Contains(availPairs, enabledPairs[x], true) {
-			update = true
-			pairsRemoved = append(pairsRemoved, enabledPairs[x])
-			continue
-		}
-		pairs = append(pairs, enabledPairs[x])
-	}
-
-	if !update {
+	pairs, pairsRemoved := splitUnavailablePairs(availPairs, enabledPairs)
+	if len(pairsRemoved) == 0 {
 		return nil
 	}
 
@@ -535,6 +863,19 @@ func (c *Config) CheckPairConsistency(exchName string) error {
 	return nil
 }
 
+// splitUnavailablePairs splits the enabled pairs into those which are in the
+// available pairs and those which aren't
+func splitUnavailablePairs(available, enabled []pair.CurrencyPair) (pairs, unavailable []pair.CurrencyPair) {
+	for x := range enabled {
+		if !pair.Contains(available, enabled[x], true) {
+			unavailable = append(unavailable, enabled[x])
+			continue
+		}
+		pairs = append(pairs, enabled[x])
+	}
+	return pairs, unavailable
+}
+
 // SupportsPair returns true or not whether the exchange supports the supplied
 // pair
 func (c *Config) SupportsPair(exchName string, p pair.CurrencyPair) (bool, error) {
@@ -690,10 +1031,6 @@ func (c *Config) UpdateExchangeConfig(e ExchangeConfig) error {
 func (c *Config) CheckExchangeConfigValues() error {
 	exchanges := 0
 	for i, exch := range c.Exchanges {
-		if exch.Name == "GDAX" {
-			c.Exchanges[i].Name = "CoinbasePro"
-		}
-
 		if exch.WebsocketURL != WebsocketURLNonDefaultMessage {
 			if exch.WebsocketURL == "" {
 				c.Exchanges[i].WebsocketURL = WebsocketURLNonDefaultMessage
@@ -715,35 +1052,16 @@ func (c *Config) CheckExchangeConfigValues() error {
 		}
 
 		if exch.Enabled {
-			if exch.Name == "" {
-				return fmt.Errorf(ErrExchangeNameEmpty, i)
-			}
-			if exch.AvailablePairs == "" {
-				return fmt.Errorf(ErrExchangeAvailablePairsEmpty, exch.Name)
+			errs := exchangeErrors(i, &c.Exchanges[i])
+			if len(errs) > 0 {
+				return errs[0]
 			}
-			if exch.EnabledPairs == "" {
-				return fmt.Errorf(ErrExchangeEnabledPairsEmpty, exch.Name)
+			if exch.AuthenticatedAPISupport && !hasAuthAPIValues(&exch) { // non-fatal error
+				c.Exchanges[i].AuthenticatedAPISupport = false
+				log.Printf(WarningExchangeAuthAPIDefaultOrEmptyValues, exch.Name)
 			}
-			if exch.BaseCurrencies == "" {
-				return fmt.Errorf(ErrExchangeBaseCurrenciesEmpty, exch.Name)
-			}
-			if exch.AuthenticatedAPISupport { // non-fatal error
-				if exch.APIKey == "" || exch.APISecret == "" || exch.APIKey == "Key" || exch.APISecret == "Secret" {
-					c.Exchanges[i].AuthenticatedAPISupport = false
-					log.Printf(WarningExchangeAuthAPIDefaultOrEmptyValues, exch.Name)
-				} else if exch.Name == "ITBIT" || exch.Name == "Bitstamp" || exch.Name == "COINUT" || exch.Name == "CoinbasePro" {
-					if exch.ClientID == "" || exch.ClientID == "ClientID" {
-						c.Exchanges[i].AuthenticatedAPISupport = false
-						log.Printf(WarningExchangeAuthAPIDefaultOrEmptyValues, exch.Name)
-					}
-				}
-			}
-			if !exch.SupportsAutoPairUpdates {
-				lastUpdated := common.UnixTimestampToTime(exch.PairsLastUpdated)
-				lastUpdated = lastUpdated.AddDate(0, 0, configPairsLastUpdatedWarningThreshold)
-				if lastUpdated.Unix() <= time.Now().Unix() {
-					log.Printf(WarningPairsLastUpdatedThresholdExceeded, exch.Name, configPairsLastUpdatedWarningThreshold)
-				}
+			if pairsUpdateOverdue(&exch) {
+				log.Printf(WarningPairsLastUpdatedThresholdExceeded, exch.Name, configPairsLastUpdatedWarningThreshold)
 			}
 
 			if exch.HTTPTimeout <= 0 {
@@ -758,31 +1076,6 @@ func (c *Config) CheckExchangeConfigValues() error {
 
 			if len(exch.BankAccounts) == 0 {
 				c.Exchanges[i].BankAccounts = append(c.Exchanges[i].BankAccounts, BankAccount{})
-			} else {
-				for _, bankAccount := range exch.BankAccounts {
-					if bankAccount.Enabled == true {
-						if bankAccount.BankName == "" || bankAccount.BankAddress == "" {
-							return fmt.Errorf("banking details for %s is enabled but variables not set",
-								exch.Name)
-						}
-
-						if bankAccount.AccountName == "" || bankAccount.AccountNumber == "" {
-							return fmt.Errorf("banking account details for %s variables not set",
-								exch.Name)
-						}
-
-						if bankAccount.SupportedCurrencies == "" {
-							return fmt.Errorf("banking account details for %s acceptable funding currencies not set",
-								exch.Name)
-						}
-
-						if bankAccount.BSBNumber == "" && bankAccount.IBAN == "" &&
-							bankAccount.SWIFTCode == "" {
-							return fmt.Errorf("banking account details for %s critical banking numbers not set",
-								exch.Name)
-						}
-					}
-				}
 			}
 			exchanges++
 		}
@@ -793,25 +1086,81 @@ func (c *Config) CheckExchangeConfigValues() error {
 	return nil
 }
 
-// CheckWebserverConfigValues checks information before webserver starts and
-// returns an error if values are incorrect.
-func (c *Config) CheckWebserverConfigValues() error {
-	if c.Webserver.AdminUsername == "" || c.Webserver.AdminPassword == "" {
-		return errors.New(WarningWebserverCredentialValuesEmpty)
+// exchangeErrors returns every problem which prevents an enabled exchange
+// from being loaded
+func exchangeErrors(index int, exch *ExchangeConfig) []error {
+	var errs []error
+	if exch.Name == "" {
+		errs = append(errs, fmt.Errorf(ErrExchangeNameEmpty, index))
+	}
+	if exch.AvailablePairs == "" {
+		errs = append(errs, fmt.Errorf(ErrExchangeAvailablePairsEmpty, exch.Name))
+	}
+	if exch.EnabledPairs == "" {
+		errs = append(errs, fmt.Errorf(ErrExchangeEnabledPairsEmpty, exch.Name))
+	}
+	if exch.BaseCurrencies == "" {
+		errs = append(errs, fmt.Errorf(ErrExchangeBaseCurrenciesEmpty, exch.Name))
 	}
 
-	if !common.StringContains(c.Webserver.ListenAddress, ":") {
-		return errors.New(WarningWebserverListenAddressInvalid)
+	for _, bankAccount := range exch.BankAccounts {
+		if !bankAccount.Enabled {
+			continue
+		}
+
+		if bankAccount.BankName == "" || bankAccount.BankAddress == "" {
+			errs = append(errs, fmt.Errorf("banking details for %s is enabled but variables not set",
+				exch.Name))
+		}
+
+		if bankAccount.AccountName == "" || bankAccount.AccountNumber == "" {
+			errs = append(errs, fmt.Errorf("banking account details for %s variables not set",
+				exch.Name))
+		}
+
+		if bankAccount.SupportedCurrencies == "" {
+			errs = append(errs, fmt.Errorf("banking account details for %s acceptable funding currencies not set",
+				exch.Name))
+		}
+
+		if bankAccount.BSBNumber == "" && bankAccount.IBAN == "" &&
+			bankAccount.SWIFTCode == "" {
+			errs = append(errs, fmt.Errorf("banking account details for %s critical banking numbers not set",
+				exch.Name))
+		}
+	}
+	return errs
+}
+
+// hasAuthAPIValues returns whether the API credentials the exchange requires
+// for authenticated API support are set to non default values
+func hasAuthAPIValues(exch *ExchangeConfig) bool {
+	for _, credential := range ExchangeCredentials(exch.Name) {
+		value := credentialValue(exch, credential)
+		if value == "" || value == credentialDefaults[credential] {
+			return false
+		}
 	}
+	return true
+}
 
-	portStr := common.SplitStrings(c.Webserver.ListenAddress, ":")[1]
-	port, err := strconv.Atoi(portStr)
-	if err != nil {
-		return errors.New(WarningWebserverListenAddressInvalid)
+// pairsUpdateOverdue returns whether the available pairs of an exchange
+// without automatic pair updates haven't been updated within the threshold
+func pairsUpdateOverdue(exch *ExchangeConfig) bool {
+	if exch.SupportsAutoPairUpdates {
+		return false
 	}
+	lastUpdated := common.UnixTimestampToTime(exch.PairsLastUpdated)
+	lastUpdated = lastUpdated.AddDate(0, 0, configPairsLastUpdatedWarningThreshold)
+	return lastUpdated.Unix() <= time.Now().Unix()
+}
 
-	if port < 1 || port > 65355 {
-		return errors.New(WarningWebserverListenAddressInvalid)
+// CheckWebserverConfigValues checks information before webserver starts and
+// returns an error if values are incorrect.
+func (c *Config) CheckWebserverConfigValues() error {
+	errs := c.webserverErrors()
+	if len(errs) > 0 {
+		return errs[0]
 	}
 
 	if c.Webserver.WebsocketConnectionLimit <= 0 {
@@ -821,10 +1170,88 @@ func (c *Config) CheckWebserverConfigValues() error {
 	if c.Webserver.WebsocketMaxAuthFailures <= 0 {
 		c.Webserver.WebsocketMaxAuthFailures = 3
 	}
+	return nil
+}
+
+// webserverErrors returns every problem which prevents the webserver from
+// starting
+func (c *Config) webserverErrors() []error {
+	var errs []error
+	if c.Webserver.AdminUsername == "" || c.Webserver.AdminPassword == "" {
+		errs = append(errs, errors.New(WarningWebserverCredentialValuesEmpty))
+	}
+
+	if !isValidListenAddress(c.Webserver.ListenAddress) {
+		errs = append(errs, errors.New(WarningWebserverListenAddressInvalid))
+	}
+
+	if c.Webserver.TLS.Enabled &&
+		(c.Webserver.TLS.CertFile == "") != (c.Webserver.TLS.KeyFile == "") {
+		errs = append(errs, errors.New(WarningWebserverTLSCertKeyMismatch))
+	}
 
+	tokens := make(map[string]bool)
+	for i := range c.Webserver.APITokens {
+		if c.Webserver.APITokens[i].Token == "" ||
+			(c.Webserver.APITokens[i].Scope != APIScopeRead &&
+				c.Webserver.APITokens[i].Scope != APIScopeAdmin) {
+			errs = append(errs, fmt.Errorf(WarningWebserverAPITokenInvalid, i))
+			continue
+		}
+
+		if tokens[c.Webserver.APITokens[i].Token] {
+			errs = append(errs, fmt.Errorf(WarningWebserverAPITokenDuplicate,
+				c.Webserver.APITokens[i].Name))
+		}
+		tokens[c.Webserver.APITokens[i].Token] = true
+	}
+	return errs
+}
+
+// CheckGRPCConfigValues checks information before the gRPC server starts and
+// returns an error if values are incorrect.
+func (c *Config) CheckGRPCConfigValues() error {
+	errs := c.grpcErrors()
+	if len(errs) > 0 {
+		return errs[0]
+	}
 	return nil
 }
 
+// grpcErrors returns every problem which prevents the gRPC server from
+// starting
+func (c *Config) grpcErrors() []error {
+	var errs []error
+	if c.Webserver.AdminUsername == "" || c.Webserver.AdminPassword == "" {
+		errs = append(errs, errors.New(WarningGRPCCredentialValuesEmpty))
+	}
+
+	if !isValidListenAddress(c.Webserver.GRPC.ListenAddress) {
+		errs = append(errs, errors.New(WarningGRPCListenAddressInvalid))
+	}
+
+	if c.Webserver.TLS.Enabled &&
+		(c.Webserver.TLS.CertFile == "") != (c.Webserver.TLS.KeyFile == "") {
+		errs = append(errs, errors.New(WarningWebserverTLSCertKeyMismatch))
+	}
+	return errs
+}
+
+// isValidListenAddress returns whether a host:port listen address has a valid
+// port
+func isValidListenAddress(address string) bool {
+	if !common.StringContains(address, ":") {
+		return false
+	}
+
+	portStr := common.SplitStrings(address, ":")[1]
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return false
+	}
+	return port >= 1 && port <= 65355
+}
+
 // CheckCurrencyConfigValues checks to see if the currency config values are correct or not
 func (c *Config) CheckCurrencyConfigValues() error {
 	if len(c.Currency.ForexProviders) == 0 {
@@ -852,16 +1279,15 @@ func (c *Config) CheckCurrencyConfigValues() error {
 	count := 0
 	for i := range c.Currency.ForexProviders {
 		if c.Currency.ForexProviders[i].Enabled == true {
-			if c.Currency.ForexProviders[i].APIKey == "Key" {
-				log.Printf("WARNING -- %s forex provider API key not set. Please set this in your config.json file", c.Currency.ForexProviders[i].Name)
+			usable, errs := forexProviderErrors(&c.Currency.ForexProviders[i])
+			for x := range errs {
+				log.Println(errs[x])
+			}
+			if !usable {
 				c.Currency.ForexProviders[i].Enabled = false
 				c.Currency.ForexProviders[i].PrimaryProvider = false
 				continue
 			}
-			if c.Currency.ForexProviders[i].APIKeyLvl == -1 && c.Currency.ForexProviders[i].Name != "CurrencyConverter" {
-				log.Printf("WARNING -- %s APIKey Level not set, functions limited. Please set this in your config.json file",
-					c.Currency.ForexProviders[i].Name)
-			}
 			count++
 		}
 	}
@@ -872,43 +1298,40 @@ func (c *Config) CheckCurrencyConfigValues() error {
 				c.Currency.ForexProviders[x].Enabled = true
 				c.Currency.ForexProviders[x].APIKey = ""
 				c.Currency.ForexProviders[x].PrimaryProvider = true
-				log.Printf("WARNING -- No forex providers set, defaulting to free provider CurrencyConverterAPI.")
+				log.Println(WarningNoForexProvidersEnabled)
 			}
 		}
 	}
 
 	if len(c.Currency.Cryptocurrencies) == 0 {
-		if len(c.Cryptocurrencies) != 0 {
-			c.Currency.Cryptocurrencies = c.Cryptocurrencies
-			c.Cryptocurrencies = ""
-		} else {
-			c.Currency.Cryptocurrencies = currency.DefaultCryptoCurrencies
-		}
+		c.Currency.Cryptocurrencies = currency.DefaultCryptoCurrencies
 	}
 
 	if c.Currency.CurrencyPairFormat == nil {
-		if c.CurrencyPairFormat != nil {
-			c.Currency.CurrencyPairFormat = c.CurrencyPairFormat
-			c.CurrencyPairFormat = nil
-		} else {
-			c.Currency.CurrencyPairFormat = &CurrencyPairFormatConfig{
-				Delimiter: "-",
-				Uppercase: true,
-			}
+		c.Currency.CurrencyPairFormat = &CurrencyPairFormatConfig{
+			Delimiter: "-",
+			Uppercase: true,
 		}
 	}
 
 	if c.Currency.FiatDisplayCurrency == "" {
-		if c.FiatDisplayCurrency != "" {
-			c.Currency.FiatDisplayCurrency = c.FiatDisplayCurrency
-			c.FiatDisplayCurrency = ""
-		} else {
-			c.Currency.FiatDisplayCurrency = "USD"
-		}
+		c.Currency.FiatDisplayCurrency = "USD"
 	}
 	return nil
 }
 
+// forexProviderErrors returns the problems with an enabled forex provider and
+// whether the provider can still be used
+func forexProviderErrors(provider *base.Settings) (bool, []error) {
+	if provider.APIKey == "Key" {
+		return false, []error{fmt.Errorf(WarningForexProviderAPIKeyNotSet, provider.Name)}
+	}
+	if provider.APIKeyLvl == -1 && provider.Name != "CurrencyConverter" {
+		return true, []error{fmt.Errorf(WarningForexProviderAPIKeyLevelNotSet, provider.Name)}
+	}
+	return true, nil
+}
+
 // RetrieveConfigCurrencyPairs splits, assigns and verifies enabled currency
 // pairs either cryptoCurrencies or fiatCurrencies
 func (c *Config) RetrieveConfigCurrencyPairs(enabledOnly bool) error {
@@ -1036,11 +1459,16 @@ func GetFilePath(file string) (string, error) {
 		return newDirs[0], nil
 	}
 
+	// A YAML config is used when there isn't a JSON or encrypted config
+	if _, err = os.Stat(newDir + ConfigFileYAML); err == nil {
+		return newDir + ConfigFileYAML, nil
+	}
+
 	return "", errors.New("config default file path error")
 }
 
 // ReadConfig verifies and checks for encryption and verifies the unencrypted
-// file contains JSON.
+// file contains JSON or YAML, chosen by the file extension.
 func (c *Config) ReadConfig(configPath string) error {
 	defaultPath, err := GetFilePath(configPath)
 	if err != nil {
@@ -1052,13 +1480,15 @@ func (c *Config) ReadConfig(configPath string) error {
 		return err
 	}
 
+	// files without a version field are version 0
+	c.Version = 0
 	if !ConfirmECS(file) {
-		err = ConfirmConfigJSON(file, &c)
+		err = c.decodeConfigFile(defaultPath, file)
 		if err != nil {
 			return err
 		}
 
-		if c.EncryptConfig == configFileEncryptionDisabled {
+		if c.EncryptConfig == configFileEncryptionDisabled || c.IsComposed() {
 			return nil
 		}
 
@@ -1072,6 +1502,23 @@ func (c *Config) ReadConfig(configPath string) error {
 			}
 		}
 	} else {
+		key, err := EncryptionKey()
+		if err != nil {
+			return err
+		}
+
+		if key != nil {
+			data, err := DecryptConfigFile(file, key)
+			if err != nil {
+				return err
+			}
+			err = c.decodeConfigFile(defaultPath, data)
+			if err != nil {
+				return errors.New("unable to decrypt config with the supplied encryption key")
+			}
+			return nil
+		}
+
 		errCounter := 0
 		for {
 			if errCounter >= configMaxAuthFailres {
@@ -1093,7 +1540,7 @@ func (c *Config) ReadConfig(configPath string) error {
 				continue
 			}
 
-			err = ConfirmConfigJSON(data, &c)
+			err = c.decodeConfigFile(defaultPath, data)
 			if err != nil {
 				if errCounter < configMaxAuthFailres {
 					log.Printf("Invalid password.")
@@ -1107,22 +1554,89 @@ func (c *Config) ReadConfig(configPath string) error {
 	return nil
 }
 
+// decodeConfigFile decodes the unencrypted config file data in the format
+// detected for the file and remembers the format for saving
+func (c *Config) decodeConfigFile(configPath string, data []byte) error {
+	format := DetectConfigFormat(configPath, data)
+	var includes struct {
+		Includes []string `json:"includes"`
+	}
+	err := UnmarshalConfig(data, format, &includes)
+	if err != nil {
+		return err
+	}
+
+	if len(includes.Includes) > 0 {
+		err = c.decodeIncludes(configPath, data, format)
+	} else {
+		err = UnmarshalConfig(data, format, c)
+	}
+	if err != nil {
+		return err
+	}
+	c.format = format
+
+	c.yamlComments = nil
+	if format == ConfigFormatYAML {
+		var doc yaml.Node
+		if yaml.Unmarshal(data, &doc) == nil {
+			c.yamlComments = &doc
+		}
+	}
+	return nil
+}
+
+// saveFormat returns the format to save the config file in, chosen by the
+// file extension, then the format the config was read in, then JSON
+func (c *Config) saveFormat(configPath string) string {
+	if format := FileFormat(configPath); format != "" {
+		return format
+	}
+	if c.format != "" {
+		return c.format
+	}
+	return ConfigFormatJSON
+}
+
 // SaveConfig saves your configuration to your desired path
 func (c *Config) SaveConfig(configPath string) error {
+	if c.IsComposed() {
+		return ErrComposedConfig
+	}
+
 	defaultPath, err := GetFilePath(configPath)
 	if err != nil {
 		return err
 	}
 
-	payload, err := json.MarshalIndent(c, "", " ")
+	cfg, err := c.withSecretReferences()
+	if err != nil {
+		return err
+	}
+
+	cfg, err = c.withoutProfileOverrides(cfg)
+	if err != nil {
+		return err
+	}
+
+	format := c.saveFormat(defaultPath)
+	var payload []byte
+	if format == ConfigFormatYAML {
+		payload, err = marshalYAML(cfg, c.yamlComments)
+	} else {
+		payload, err = MarshalConfig(cfg, format)
+	}
 	if err != nil {
 		return err
 	}
 
 	if c.EncryptConfig == configFileEncryptionEnabled {
-		var key []byte
+		key, err := EncryptionKey()
+		if err != nil {
+			return err
+		}
 
-		if IsInitialSetup {
+		if key == nil && IsInitialSetup {
 			key, err = PromptForConfigKey(true)
 			if err != nil {
 				return err
@@ -1162,6 +1676,14 @@ func (c *Config) CheckConfig() error {
 		}
 	}
 
+	if c.Webserver.GRPC.Enabled {
+		err = c.CheckGRPCConfigValues()
+		if err != nil {
+			log.Print(fmt.Errorf(ErrCheckingConfigValues, err))
+			c.Webserver.GRPC.Enabled = false
+		}
+	}
+
 	err = c.CheckCurrencyConfigValues()
 	if err != nil {
 		return err
@@ -1187,11 +1709,35 @@ func (c *Config) LoadConfig(configPath string) error {
 		return fmt.Errorf(ErrFailureOpeningConfig, configPath, err)
 	}
 
+	defaultPath, err := GetFilePath(configPath)
+	if err != nil {
+		return err
+	}
+
+	err = c.migrateConfigFile(defaultPath)
+	if err != nil {
+		return err
+	}
+
+	err = c.ResolveSecrets()
+	if err != nil {
+		return err
+	}
+
+	err = c.ApplyProfile(ProfileName)
+	if err != nil {
+		return err
+	}
+
 	return c.CheckConfig()
 }
 
 // UpdateConfig updates the config with a supplied config file
 func (c *Config) UpdateConfig(configPath string, newCfg Config) error {
+	if c.IsComposed() {
+		return ErrComposedConfig
+	}
+
 	err := newCfg.CheckConfig()
 	if err != nil {
 		return err
@@ -1219,6 +1765,14 @@ func (c *Config) UpdateConfig(configPath string, newCfg Config) error {
 	return nil
 }
 
+// Replace replaces the config with a newly loaded config while holding the
+// config mutex, so the config can be swapped while exchanges read it
+func (c *Config) Replace(newCfg *Config) {
+	m.Lock()
+	*c = *newCfg
+	m.Unlock()
+}
+
 // GetConfig returns a pointer to a configuration object
 func GetConfig() *Config {
 	return &Cfg
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"log"
+	"sync"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+// Credentials an exchange may require for authenticated API support, named
+// after the exchange config fields they are read from
+const (
+	CredentialAPIKey        = "apiKey"
+	CredentialAPISecret     = "apiSecret"
+	CredentialClientID      = "clientId"
+	CredentialAPIAuthPEMKey = "apiAuthPemKey"
+)
+
+// credentialDefaults holds the placeholder value of each credential in the
+// example config, a credential set to its placeholder is treated as unset
+var credentialDefaults = map[string]string{
+	CredentialAPIKey:    "Key",
+	CredentialAPISecret: "Secret",
+	CredentialClientID:  "ClientID",
+}
+
+// WarningExchangeCredentialsNotRegistered is logged when the credentials of an
+// exchange are looked up before they have been registered
+const WarningExchangeCredentialsNotRegistered = "WARNING -- Exchange %s: Required credentials not registered, the exchanges/supported package must be imported. Assuming an API key and secret are required."
+
+var (
+	exchangeCredentials    = make(map[string][]string)
+	unregisteredWarned     = make(map[string]bool)
+	exchangeCredentialsMtx sync.RWMutex
+)
+
+// RegisterExchangeCredentials sets the credentials an exchange requires for
+// authenticated API support, registered from the exchange capabilities so the
+// config doesn't need to know about individual exchanges
+func RegisterExchangeCredentials(name string, credentials []string) {
+	exchangeCredentialsMtx.Lock()
+	exchangeCredentials[common.StringToLower(name)] = credentials
+	exchangeCredentialsMtx.Unlock()
+}
+
+// ExchangeCredentialsRegistered returns whether the credentials an exchange
+// requires have been registered
+func ExchangeCredentialsRegistered(name string) bool {
+	exchangeCredentialsMtx.RLock()
+	defer exchangeCredentialsMtx.RUnlock()
+	_, ok := exchangeCredentials[common.StringToLower(name)]
+	return ok
+}
+
+// ExchangeCredentials returns the credentials an exchange requires for
+// authenticated API support. An exchange which hasn't been registered, usually
+// because the binary doesn't import exchanges/supported, logs a warning the
+// first time it is looked up and requires an API key and secret
+func ExchangeCredentials(name string) []string {
+	exchangeCredentialsMtx.Lock()
+	defer exchangeCredentialsMtx.Unlock()
+	credentials, ok := exchangeCredentials[common.StringToLower(name)]
+	if !ok {
+		if !unregisteredWarned[common.StringToLower(name)] {
+			unregisteredWarned[common.StringToLower(name)] = true
+			log.Printf(WarningExchangeCredentialsNotRegistered, name)
+		}
+		return []string{CredentialAPIKey, CredentialAPISecret}
+	}
+	return credentials
+}
+
+// credentialValue returns the value of a credential in the exchange config
+func credentialValue(exch *ExchangeConfig, credential string) string {
+	switch credential {
+	case CredentialAPIKey:
+		return exch.APIKey
+	case CredentialAPISecret:
+		return exch.APISecret
+	case CredentialClientID:
+		return exch.ClientID
+	case CredentialAPIAuthPEMKey:
+		return exch.APIAuthPEMKey
+	}
+	return ""
+}
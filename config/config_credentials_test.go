@@ -0,0 +1,54 @@
+package config
+
+import "testing"
+
+func TestExchangeCredentials(t *testing.T) {
+	if ExchangeCredentialsRegistered("Unregistered") {
+		t.Error("Test failed. ExchangeCredentialsRegistered unexpected result")
+	}
+
+	credentials := ExchangeCredentials("Unregistered")
+	if len(credentials) != 2 || credentials[0] != CredentialAPIKey ||
+		credentials[1] != CredentialAPISecret {
+		t.Error("Test failed. ExchangeCredentials unexpected default", credentials)
+	}
+
+	RegisterExchangeCredentials("TestCredentials",
+		[]string{CredentialAPIKey, CredentialAPISecret, CredentialClientID})
+	defer RegisterExchangeCredentials("TestCredentials", nil)
+
+	if !ExchangeCredentialsRegistered("testcredentials") {
+		t.Error("Test failed. ExchangeCredentialsRegistered unexpected result")
+	}
+
+	credentials = ExchangeCredentials("testcredentials")
+	if len(credentials) != 3 || credentials[2] != CredentialClientID {
+		t.Error("Test failed. ExchangeCredentials unexpected credentials", credentials)
+	}
+
+	exch := ExchangeConfig{
+		Name:      "TestCredentials",
+		APIKey:    "apikey",
+		APISecret: "apisecret",
+		ClientID:  "ClientID",
+	}
+	if hasAuthAPIValues(&exch) {
+		t.Error("Test failed. hasAuthAPIValues accepted a default client ID")
+	}
+
+	exch.ClientID = "clientid"
+	if !hasAuthAPIValues(&exch) {
+		t.Error("Test failed. hasAuthAPIValues rejected the required credentials")
+	}
+
+	exch.Name = "Unregistered"
+	exch.ClientID = ""
+	if !hasAuthAPIValues(&exch) {
+		t.Error("Test failed. hasAuthAPIValues required an unregistered credential")
+	}
+
+	exch.APISecret = "Secret"
+	if hasAuthAPIValues(&exch) {
+		t.Error("Test failed. hasAuthAPIValues accepted a default API secret")
+	}
+}
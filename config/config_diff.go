@@ -0,0 +1,175 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+// Communications mediums compared by DiffConfig
+const (
+	CommsSlack     = "slack"
+	CommsSMSGlobal = "smsGlobal"
+	CommsSMTP      = "smtp"
+	CommsTelegram  = "telegram"
+	CommsWebhook   = "webhook"
+	CommsDiscord   = "discord"
+	CommsMatrix    = "matrix"
+)
+
+// Changes holds the differences between two configs grouped by the subsystem
+// that needs to apply them
+type Changes struct {
+	// ExchangesEnabled and ExchangesDisabled hold exchanges whose enabled
+	// state changed or which were added to or removed from the config
+	ExchangesEnabled  []string
+	ExchangesDisabled []string
+	// ExchangesUpdated holds enabled exchanges with changed settings other
+	// than their enabled pairs
+	ExchangesUpdated []string
+	// PairsEnabled and PairsDisabled hold the enabled pairs added or removed
+	// by exchange for exchanges which were not otherwise updated
+	PairsEnabled  map[string][]string
+	PairsDisabled map[string][]string
+	// CommsMediums holds the communications mediums whose settings changed
+	CommsMediums        []string
+	AlertRules          bool
+	ForexProviders      bool
+	FiatDisplayCurrency bool
+	GlobalHTTPTimeout   bool
+	Portfolio           bool
+	// RestartRequired holds changed settings which only take effect after
+	// the bot is restarted
+	RestartRequired []string
+}
+
+// IsEmpty returns whether there are no changes to apply
+func (c *Changes) IsEmpty() bool {
+	return len(c.ExchangesEnabled) == 0 && len(c.ExchangesDisabled) == 0 &&
+		len(c.ExchangesUpdated) == 0 && len(c.PairsEnabled) == 0 &&
+		len(c.PairsDisabled) == 0 && len(c.CommsMediums) == 0 && !c.AlertRules &&
+		!c.ForexProviders && !c.FiatDisplayCurrency && !c.GlobalHTTPTimeout &&
+		!c.Portfolio && len(c.RestartRequired) == 0
+}
+
+// Copy returns a deep copy of the config which is not modified when the
+// config is reloaded
+func (c *Config) Copy() (Config, error) {
+	var cfg Config
+	data, err := json.Marshal(c)
+	if err != nil {
+		return cfg, err
+	}
+	err = json.Unmarshal(data, &cfg)
+	return cfg, err
+}
+
+// DiffConfig compares the old and new config and returns the changes needed to
+// bring a bot running with the old config in line with the new one
+func DiffConfig(oldCfg, newCfg *Config) Changes {
+	changes := Changes{
+		PairsEnabled:  make(map[string][]string),
+		PairsDisabled: make(map[string][]string),
+	}
+
+	oldExchanges := make(map[string]ExchangeConfig)
+	for i := range oldCfg.Exchanges {
+		oldExchanges[common.StringToLower(oldCfg.Exchanges[i].Name)] = oldCfg.Exchanges[i]
+	}
+
+	for i := range newCfg.Exchanges {
+		exch := newCfg.Exchanges[i]
+		name := common.StringToLower(exch.Name)
+		old, ok := oldExchanges[name]
+		delete(oldExchanges, name)
+
+		switch {
+		case exch.Enabled && (!ok || !old.Enabled):
+			changes.ExchangesEnabled = append(changes.ExchangesEnabled, exch.Name)
+		case !exch.Enabled && ok && old.Enabled:
+			changes.ExchangesDisabled = append(changes.ExchangesDisabled, exch.Name)
+		case exch.Enabled:
+			diffExchange(&changes, old, exch)
+		}
+	}
+
+	for _, exch := range oldExchanges {
+		if exch.Enabled {
+			changes.ExchangesDisabled = append(changes.ExchangesDisabled, exch.Name)
+		}
+	}
+
+	oldComms := commsMediums(oldCfg.Communications)
+	newComms := commsMediums(newCfg.Communications)
+	for _, medium := range []string{CommsSlack, CommsSMSGlobal, CommsSMTP,
+		CommsTelegram, CommsWebhook, CommsDiscord, CommsMatrix} {
+		if !reflect.DeepEqual(oldComms[medium], newComms[medium]) {
+			changes.CommsMediums = append(changes.CommsMediums, medium)
+		}
+	}
+	changes.AlertRules = !reflect.DeepEqual(oldCfg.Communications.AlertRules,
+		newCfg.Communications.AlertRules)
+
+	changes.ForexProviders = !reflect.DeepEqual(oldCfg.Currency.ForexProviders,
+		newCfg.Currency.ForexProviders)
+	changes.FiatDisplayCurrency = oldCfg.Currency.FiatDisplayCurrency !=
+		newCfg.Currency.FiatDisplayCurrency
+	changes.GlobalHTTPTimeout = oldCfg.GlobalHTTPTimeout != newCfg.GlobalHTTPTimeout
+	changes.Portfolio = !reflect.DeepEqual(oldCfg.Portfolio.Addresses,
+		newCfg.Portfolio.Addresses)
+
+	if oldCfg.Webserver.Enabled != newCfg.Webserver.Enabled ||
+		oldCfg.Webserver.ListenAddress != newCfg.Webserver.ListenAddress ||
+		oldCfg.Webserver.WebsocketConnectionLimit != newCfg.Webserver.WebsocketConnectionLimit ||
+		!reflect.DeepEqual(oldCfg.Webserver.TLS, newCfg.Webserver.TLS) {
+		changes.RestartRequired = append(changes.RestartRequired, "webserver")
+	}
+	if !reflect.DeepEqual(oldCfg.Webserver.GRPC, newCfg.Webserver.GRPC) {
+		changes.RestartRequired = append(changes.RestartRequired, "gRPC server")
+	}
+
+	if len(changes.PairsEnabled) == 0 {
+		changes.PairsEnabled = nil
+	}
+	if len(changes.PairsDisabled) == 0 {
+		changes.PairsDisabled = nil
+	}
+	return changes
+}
+
+// diffExchange records the changes to an exchange which is enabled in both
+// configs, a pair only change is applied without reloading the exchange
+func diffExchange(changes *Changes, oldExch, newExch ExchangeConfig) {
+	oldPairs := common.SplitStrings(oldExch.EnabledPairs, ",")
+	newPairs := common.SplitStrings(newExch.EnabledPairs, ",")
+	oldExch.EnabledPairs, newExch.EnabledPairs = "", ""
+	oldExch.PairsLastUpdated, newExch.PairsLastUpdated = 0, 0
+
+	if !reflect.DeepEqual(oldExch, newExch) {
+		changes.ExchangesUpdated = append(changes.ExchangesUpdated, newExch.Name)
+		return
+	}
+
+	enabled, disabled := pair.FindPairDifferences(oldPairs, newPairs)
+	if len(enabled) > 0 {
+		changes.PairsEnabled[newExch.Name] = enabled
+	}
+	if len(disabled) > 0 {
+		changes.PairsDisabled[newExch.Name] = disabled
+	}
+}
+
+// commsMediums returns the settings of each communications medium by name
+func commsMediums(c CommunicationsConfig) map[string]interface{} {
+	return map[string]interface{}{
+		CommsSlack:     c.SlackConfig,
+		CommsSMSGlobal: c.SMSGlobalConfig,
+		CommsSMTP:      c.SMTPConfig,
+		CommsTelegram:  c.TelegramConfig,
+		CommsWebhook:   c.WebhookConfig,
+		CommsDiscord:   c.DiscordConfig,
+		CommsMatrix:    c.MatrixConfig,
+	}
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffConfig(t *testing.T) {
+	var oldCfg Config
+	err := oldCfg.LoadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. DiffConfig LoadConfig error", err)
+	}
+
+	newCfg, err := oldCfg.Copy()
+	if err != nil {
+		t.Fatal("Test failed. Copy error", err)
+	}
+
+	changes := DiffConfig(&oldCfg, &newCfg)
+	if !changes.IsEmpty() {
+		t.Error("Test failed. DiffConfig found changes in an identical config", changes)
+	}
+
+	for i := range newCfg.Exchanges {
+		switch newCfg.Exchanges[i].Name {
+		case "Bitfinex":
+			newCfg.Exchanges[i].EnabledPairs = "BTCUSD,LTCUSD,LTCBTC,ETHUSD,ETCUSD"
+		case "Kraken":
+			newCfg.Exchanges[i].Verbose = !newCfg.Exchanges[i].Verbose
+		case "ANX":
+			newCfg.Exchanges[i].Enabled = false
+		}
+	}
+	newCfg.Exchanges = append(newCfg.Exchanges, ExchangeConfig{Name: "NewExchange", Enabled: true})
+	newCfg.Communications.SlackConfig.TargetChannel = "alerts"
+	newCfg.GlobalHTTPTimeout = oldCfg.GlobalHTTPTimeout + time.Second
+	newCfg.Webserver.ListenAddress = "localhost:9051"
+
+	changes = DiffConfig(&oldCfg, &newCfg)
+	if len(changes.PairsEnabled["Bitfinex"]) != 1 || changes.PairsEnabled["Bitfinex"][0] != "ETCUSD" ||
+		len(changes.PairsDisabled["Bitfinex"]) != 1 || changes.PairsDisabled["Bitfinex"][0] != "ETHBTC" {
+		t.Error("Test failed. DiffConfig unexpected pair changes", changes.PairsEnabled,
+			changes.PairsDisabled)
+	}
+	if len(changes.ExchangesUpdated) != 1 || changes.ExchangesUpdated[0] != "Kraken" {
+		t.Error("Test failed. DiffConfig unexpected updated exchanges", changes.ExchangesUpdated)
+	}
+	if len(changes.ExchangesDisabled) != 1 || changes.ExchangesDisabled[0] != "ANX" {
+		t.Error("Test failed. DiffConfig unexpected disabled exchanges", changes.ExchangesDisabled)
+	}
+	if len(changes.ExchangesEnabled) != 1 || changes.ExchangesEnabled[0] != "NewExchange" {
+		t.Error("Test failed. DiffConfig unexpected enabled exchanges", changes.ExchangesEnabled)
+	}
+	if len(changes.CommsMediums) != 1 || changes.CommsMediums[0] != CommsSlack {
+		t.Error("Test failed. DiffConfig unexpected comms changes", changes.CommsMediums)
+	}
+	if !changes.GlobalHTTPTimeout || changes.ForexProviders || changes.AlertRules {
+		t.Error("Test failed. DiffConfig unexpected global changes", changes)
+	}
+	if len(changes.RestartRequired) != 1 || changes.RestartRequired[0] != "webserver" {
+		t.Error("Test failed. DiffConfig unexpected restart required", changes.RestartRequired)
+	}
+
+	newCfg.Exchanges = newCfg.Exchanges[:len(newCfg.Exchanges)-2]
+	changes = DiffConfig(&oldCfg, &newCfg)
+	if len(changes.ExchangesDisabled) != 2 {
+		t.Error("Test failed. DiffConfig removed exchange not disabled", changes.ExchangesDisabled)
+	}
+}
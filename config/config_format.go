@@ -0,0 +1,255 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"gopkg.in/yaml.v3"
+)
+
+// Config file formats, chosen by the config file extension
+const (
+	ConfigFormatJSON = "json"
+	ConfigFormatYAML = "yaml"
+)
+
+// FileFormat returns the config format for the file extension, an empty
+// string is returned for extensions which don't name a format such as the
+// encrypted config .dat extension
+func FileFormat(configPath string) string {
+	switch common.StringToLower(filepath.Ext(configPath)) {
+	case ".json":
+		return ConfigFormatJSON
+	case ".yaml", ".yml":
+		return ConfigFormatYAML
+	}
+	return ""
+}
+
+// Format returns the format the config file was read in, empty if the config
+// wasn't read from a file
+func (c *Config) Format() string {
+	return c.format
+}
+
+// DetectConfigFormat returns the format of unencrypted config data, using the
+// file extension when it names a format. Encrypted configs don't, so the
+// decrypted data is checked instead, JSON configs are always an object
+func DetectConfigFormat(configPath string, data []byte) string {
+	if format := FileFormat(configPath); format != "" {
+		return format
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return ConfigFormatJSON
+	}
+	return ConfigFormatYAML
+}
+
+// UnmarshalConfig decodes config data in the given format into result. YAML is
+// converted to JSON first so the config struct json tags and decoders apply
+// to both formats
+func UnmarshalConfig(data []byte, format string, result interface{}) error {
+	switch format {
+	case ConfigFormatJSON:
+		return common.JSONDecode(data, result)
+	case ConfigFormatYAML:
+		var v interface{}
+		err := yaml.Unmarshal(data, &v)
+		if err != nil {
+			return err
+		}
+
+		v, err = yamlToJSONValue(v)
+		if err != nil {
+			return err
+		}
+
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		return common.JSONDecode(encoded, result)
+	}
+	return fmt.Errorf("unsupported config format %s", format)
+}
+
+// MarshalConfig encodes the config in the given format. YAML is converted from
+// the JSON encoding so field names and field order match the JSON config
+func MarshalConfig(cfg interface{}, format string) ([]byte, error) {
+	switch format {
+	case ConfigFormatJSON:
+		return json.MarshalIndent(cfg, "", " ")
+	case ConfigFormatYAML:
+		return marshalYAML(cfg, nil)
+	}
+	return nil, fmt.Errorf("unsupported config format %s", format)
+}
+
+// marshalYAML encodes the config as YAML, the comments of a previously parsed
+// YAML document are copied onto the settings which are still present
+func marshalYAML(cfg interface{}, comments *yaml.Node) ([]byte, error) {
+	encoded, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(encoded))
+	dec.UseNumber()
+	node, err := jsonToYAMLNode(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{node}}
+	copyYAMLComments(comments, doc)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	err = enc.Encode(doc)
+	if err != nil {
+		return nil, err
+	}
+	err = enc.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// copyYAMLComments copies the comments of a parsed YAML node onto the matching
+// node of a newly encoded document, mapping entries are matched by key and
+// sequence items by index
+func copyYAMLComments(from, to *yaml.Node) {
+	if from == nil || to == nil {
+		return
+	}
+
+	to.HeadComment = from.HeadComment
+	to.LineComment = from.LineComment
+	to.FootComment = from.FootComment
+	if from.Kind != to.Kind {
+		return
+	}
+
+	switch to.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for i := range to.Content {
+			if i < len(from.Content) {
+				copyYAMLComments(from.Content[i], to.Content[i])
+			}
+		}
+	case yaml.MappingNode:
+		keys := make(map[string]int)
+		for i := 0; i+1 < len(from.Content); i += 2 {
+			keys[from.Content[i].Value] = i
+		}
+		for i := 0; i+1 < len(to.Content); i += 2 {
+			j, ok := keys[to.Content[i].Value]
+			if !ok {
+				continue
+			}
+			copyYAMLComments(from.Content[j], to.Content[i])
+			copyYAMLComments(from.Content[j+1], to.Content[i+1])
+		}
+	}
+}
+
+// yamlToJSONValue converts decoded YAML into values encoding/json can marshal,
+// mappings with non string keys have their keys converted to strings
+func yamlToJSONValue(v interface{}) (interface{}, error) {
+	var err error
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k := range t {
+			t[k], err = yamlToJSONValue(t[k])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return t, nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k := range t {
+			m[fmt.Sprint(k)], err = yamlToJSONValue(t[k])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case []interface{}:
+		for i := range t {
+			t[i], err = yamlToJSONValue(t[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return t, nil
+	}
+	return v, nil
+}
+
+// jsonToYAMLNode reads the next JSON value from the decoder as a YAML node,
+// keeping the order of object keys
+func jsonToYAMLNode(dec *json.Decoder) (*yaml.Node, error) {
+	token, err := dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("unexpected end of config data")
+		}
+		return nil, err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if t == '[' {
+			node.Kind = yaml.SequenceNode
+			node.Tag = "!!seq"
+		}
+
+		for dec.More() {
+			if node.Kind == yaml.MappingNode {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, &yaml.Node{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: fmt.Sprint(key),
+				})
+			}
+
+			value, err := jsonToYAMLNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, value)
+		}
+
+		// consume the closing delimiter
+		_, err = dec.Token()
+		return node, err
+	case string:
+		node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: t}
+		if common.StringContains(t, "\n") {
+			node.Style = yaml.LiteralStyle
+		}
+		return node, nil
+	case json.Number:
+		tag := "!!int"
+		if _, err := t.Int64(); err != nil {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: t.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(t)}, nil
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+}
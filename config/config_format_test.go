@@ -0,0 +1,171 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+func TestDetectConfigFormat(t *testing.T) {
+	tests := []struct {
+		path   string
+		data   string
+		format string
+	}{
+		{"config.json", "name: test", ConfigFormatJSON},
+		{"config.yaml", `{"name": "test"}`, ConfigFormatYAML},
+		{"config.YML", "", ConfigFormatYAML},
+		{"config.dat", ` {"name": "test"}`, ConfigFormatJSON},
+		{"config.dat", "name: test", ConfigFormatYAML},
+	}
+
+	for _, test := range tests {
+		if r := DetectConfigFormat(test.path, []byte(test.data)); r != test.format {
+			t.Errorf("Test failed. DetectConfigFormat %s expected %s got %s",
+				test.path, test.format, r)
+		}
+	}
+
+	if FileFormat("config.dat") != "" {
+		t.Error("Test failed. FileFormat returned a format for an encrypted config")
+	}
+}
+
+func TestMarshalConfigYAML(t *testing.T) {
+	var cfg Config
+	err := cfg.LoadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. LoadConfig error", err)
+	}
+	cfg.Exchanges[0].APIAuthPEMKey = "-----BEGIN KEY-----\nabc\n-----END KEY-----"
+
+	data, err := MarshalConfig(&cfg, ConfigFormatYAML)
+	if err != nil {
+		t.Fatal("Test failed. MarshalConfig error", err)
+	}
+
+	var result Config
+	err = UnmarshalConfig(data, ConfigFormatYAML, &result)
+	if err != nil {
+		t.Fatal("Test failed. UnmarshalConfig error", err)
+	}
+
+	if !reflect.DeepEqual(cfg.Exchanges, result.Exchanges) ||
+		!reflect.DeepEqual(cfg.Currency, result.Currency) ||
+		cfg.GlobalHTTPTimeout != result.GlobalHTTPTimeout {
+		t.Error("Test failed. YAML config differs from the original config")
+	}
+
+	_, err = MarshalConfig(&cfg, "toml")
+	if err == nil {
+		t.Error("Test failed. MarshalConfig accepted an unsupported format")
+	}
+
+	err = UnmarshalConfig([]byte("name: [test"), ConfigFormatYAML, &result)
+	if err == nil {
+		t.Error("Test failed. UnmarshalConfig accepted invalid YAML")
+	}
+}
+
+func TestSaveConfigYAML(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-config-format")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var cfg Config
+	err = cfg.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+
+	path := filepath.Join(dir, "config.yaml")
+	err = cfg.SaveConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig error", err)
+	}
+
+	data, err := common.ReadFile(path)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+	if DetectConfigFormat("", data) != ConfigFormatYAML {
+		t.Error("Test failed. SaveConfig didn't write YAML")
+	}
+
+	var result Config
+	err = result.ReadConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig YAML error", err)
+	}
+	if result.Name != cfg.Name || len(result.Exchanges) != len(cfg.Exchanges) {
+		t.Error("Test failed. ReadConfig YAML config differs from the saved config")
+	}
+	if cfg.Format() != ConfigFormatJSON || result.Format() != ConfigFormatYAML {
+		t.Error("Test failed. Format didn't return the format the config was read in")
+	}
+
+	// comments in a YAML config are kept when it is saved
+	data = append([]byte("# bot settings\n"), data...)
+	data = []byte(strings.Replace(string(data), "\nexchanges:", "\n# exchange settings\nexchanges:", 1))
+	err = common.WriteFile(path, data)
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+
+	result = Config{}
+	err = result.ReadConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig YAML error", err)
+	}
+	result.Name = "commented"
+	err = result.SaveConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig YAML error", err)
+	}
+
+	data, err = common.ReadFile(path)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+	if !strings.HasPrefix(string(data), "# bot settings\n") ||
+		!strings.Contains(string(data), "# exchange settings\nexchanges:") ||
+		!strings.Contains(string(data), "name: commented") {
+		t.Error("Test failed. SaveConfig YAML didn't keep the comments", string(data))
+	}
+
+	// an encrypted YAML config keeps its format when saved to a .dat file
+	os.Setenv(EncryptionKeyEnvVar, "yamlkey")
+	defer os.Unsetenv(EncryptionKeyEnvVar)
+	sessionDK = nil
+	result.EncryptConfig = configFileEncryptionEnabled
+	encryptedPath := filepath.Join(dir, "config.dat")
+	err = result.SaveConfig(encryptedPath)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig encrypted error", err)
+	}
+
+	data, err = common.ReadFile(encryptedPath)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+	data, err = DecryptConfigFile(data, []byte("yamlkey"))
+	if err != nil {
+		t.Fatal("Test failed. DecryptConfigFile error", err)
+	}
+	if DetectConfigFormat(encryptedPath, data) != ConfigFormatYAML {
+		t.Error("Test failed. SaveConfig didn't encrypt the config as YAML")
+	}
+
+	var encrypted Config
+	err = encrypted.ReadConfig(encryptedPath)
+	if err != nil || encrypted.Name != result.Name {
+		t.Error("Test failed. ReadConfig encrypted YAML error", err)
+	}
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+// maxIncludeDepth limits how deeply config includes can be nested
+const maxIncludeDepth = 8
+
+// ErrComposedConfig is returned when saving a config built from includes or a
+// profile selected outside of the config file, saving it would write the
+// merged config over the config file
+var ErrComposedConfig = errors.New("config is built from includes or a profile and can't be saved, edit the config files instead")
+
+// IncludedFiles returns the paths of the files included by the config file
+// in the order they were merged
+func (c *Config) IncludedFiles() []string {
+	return c.includedFiles
+}
+
+// IsComposed returns whether the config was built from includes or with a
+// profile other than the one named in the config file. A profile named in the
+// config file is applied on every load, so the config can still be saved
+func (c *Config) IsComposed() bool {
+	return len(c.includedFiles) > 0 || c.profileOverlay
+}
+
+// decodeIncludes decodes config data which includes other config files. Each
+// included file is merged in order, then the including config is merged over
+// them so its settings take precedence
+func (c *Config) decodeIncludes(configPath string, data []byte, format string) error {
+	var included []string
+	merged, err := readIncludes(configPath, data, format, nil, &included)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+
+	err = common.JSONDecode(encoded, c)
+	if err != nil {
+		return err
+	}
+	c.includedFiles = included
+	return nil
+}
+
+// readIncludes decodes the config data and the files it includes, relative
+// include paths are resolved from the directory of the including file
+func readIncludes(configPath string, data []byte, format string, parents []string, included *[]string) (map[string]interface{}, error) {
+	if len(parents) > maxIncludeDepth {
+		return nil, fmt.Errorf("config includes are nested more than %d deep", maxIncludeDepth)
+	}
+
+	var doc map[string]interface{}
+	err := UnmarshalConfig(data, format, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config %s: %s", configPath, err)
+	}
+
+	var paths []string
+	if includes, ok := doc["includes"]; ok {
+		list, ok := includes.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("config %s includes must be a list of files", configPath)
+		}
+		for i := range list {
+			path, ok := list[i].(string)
+			if !ok || path == "" {
+				return nil, fmt.Errorf("config %s includes must be a list of files", configPath)
+			}
+			paths = append(paths, path)
+		}
+	}
+
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		return nil, err
+	}
+	parents = append(parents, absPath)
+
+	base := make(map[string]interface{})
+	for i := range paths {
+		path := paths[i]
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(absPath), path)
+		}
+
+		if common.StringDataCompare(parents, path) {
+			return nil, fmt.Errorf("config %s includes itself through %s", configPath, paths[i])
+		}
+
+		file, err := common.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read config include %s: %s", paths[i], err)
+		}
+
+		if ConfirmECS(file) {
+			return nil, fmt.Errorf("config include %s is encrypted, included files can't be encrypted", paths[i])
+		}
+
+		doc, err := readIncludes(path, file, DetectConfigFormat(path, file), parents, included)
+		if err != nil {
+			return nil, err
+		}
+		*included = append(*included, path)
+		base = mergeConfigValues(base, doc).(map[string]interface{})
+	}
+	return mergeConfigValues(base, doc).(map[string]interface{}), nil
+}
+
+// mergeConfigValues merges the override config values over the base values.
+// Objects are merged field by field and lists of named objects, such as the
+// exchanges, are merged by name. Any other value is replaced
+func mergeConfigValues(base, override interface{}) interface{} {
+	switch o := override.(type) {
+	case map[string]interface{}:
+		b, ok := base.(map[string]interface{})
+		if !ok {
+			return o
+		}
+		for k := range o {
+			b[k] = mergeConfigValues(b[k], o[k])
+		}
+		return b
+	case []interface{}:
+		b, ok := base.([]interface{})
+		if !ok || !namedConfigObjects(b) || !namedConfigObjects(o) {
+			return o
+		}
+		for i := range o {
+			index := namedConfigObjectIndex(b, configObjectName(o[i]))
+			if index == -1 {
+				b = append(b, o[i])
+				continue
+			}
+			b[index] = mergeConfigValues(b[index], o[i])
+		}
+		return b
+	}
+	return override
+}
+
+// namedConfigObjects returns whether every value in the list is an object
+// with a name
+func namedConfigObjects(list []interface{}) bool {
+	for i := range list {
+		if configObjectName(list[i]) == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func namedConfigObjectIndex(list []interface{}, name string) int {
+	for i := range list {
+		if common.StringToLower(configObjectName(list[i])) == common.StringToLower(name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func configObjectName(v interface{}) string {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	name, _ := m["name"].(string)
+	return name
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+func TestReadConfigIncludes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-config-include")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	data, err := common.ReadFile(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+
+	err = common.WriteFile(filepath.Join(dir, "shared.json"), data)
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+
+	err = common.WriteFile(filepath.Join(dir, "trading.yaml"), []byte(`
+includes:
+  - shared.json
+name: Trading
+exchanges:
+  - name: Bitfinex
+    enabledPairs: BTCUSD
+  - name: Bitstamp
+    enabled: false
+`))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+
+	var shared Config
+	err = shared.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+
+	var cfg Config
+	path := filepath.Join(dir, "trading.yaml")
+	err = cfg.ReadConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig includes error", err)
+	}
+
+	if cfg.Name != "Trading" || len(cfg.Exchanges) != len(shared.Exchanges) {
+		t.Error("Test failed. ReadConfig includes didn't merge the included config")
+	}
+
+	bitfinex, err := cfg.GetExchangeConfig("Bitfinex")
+	if err != nil || bitfinex.EnabledPairs != "BTCUSD" || bitfinex.AvailablePairs == "" {
+		t.Error("Test failed. ReadConfig includes didn't merge the exchange by name", err)
+	}
+
+	bitstamp, err := cfg.GetExchangeConfig("Bitstamp")
+	if err != nil || bitstamp.Enabled {
+		t.Error("Test failed. ReadConfig includes didn't override the exchange", err)
+	}
+
+	included := cfg.IncludedFiles()
+	if len(included) != 1 || filepath.Base(included[0]) != "shared.json" {
+		t.Error("Test failed. IncludedFiles unexpected result", included)
+	}
+
+	if !cfg.IsComposed() || cfg.SaveConfig(path) != ErrComposedConfig {
+		t.Error("Test failed. SaveConfig saved a config built from includes")
+	}
+
+	err = common.WriteFile(filepath.Join(dir, "loop.json"),
+		[]byte(`{"includes": ["loop.json"]}`))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+	err = cfg.ReadConfig(filepath.Join(dir, "loop.json"))
+	if err == nil {
+		t.Error("Test failed. ReadConfig accepted a config which includes itself")
+	}
+
+	err = common.WriteFile(filepath.Join(dir, "missing.json"),
+		[]byte(`{"includes": ["asdf.json"]}`))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+	err = cfg.ReadConfig(filepath.Join(dir, "missing.json"))
+	if err == nil {
+		t.Error("Test failed. ReadConfig accepted a missing include")
+	}
+}
+
+func TestMergeConfigValues(t *testing.T) {
+	base := map[string]interface{}{
+		"name": "base",
+		"list": []interface{}{"a", "b"},
+		"exchanges": []interface{}{
+			map[string]interface{}{"name": "Bitfinex", "enabled": true, "verbose": true},
+		},
+	}
+	override := map[string]interface{}{
+		"list": []interface{}{"c"},
+		"exchanges": []interface{}{
+			map[string]interface{}{"name": "BITFINEX", "enabled": false},
+			map[string]interface{}{"name": "Kraken"},
+		},
+	}
+
+	result := mergeConfigValues(base, override).(map[string]interface{})
+	if result["name"] != "base" || len(result["list"].([]interface{})) != 1 {
+		t.Error("Test failed. mergeConfigValues unexpected result", result)
+	}
+
+	exchanges := result["exchanges"].([]interface{})
+	bitfinex := exchanges[0].(map[string]interface{})
+	if len(exchanges) != 2 || bitfinex["enabled"] != false || bitfinex["verbose"] != true {
+		t.Error("Test failed. mergeConfigValues didn't merge named objects", exchanges)
+	}
+}
@@ -0,0 +1,296 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+// CurrentConfigVersion is the config schema version written by this build,
+// configs without a version field are version 0
+const CurrentConfigVersion = 3
+
+// configBackupDir is the data dir sub directory config backups are written to
+const configBackupDir = "config_backups"
+
+// MigrationBackupDir is the directory a copy of the config file is written to
+// before it is migrated, if empty the config_backups directory in the default
+// data dir is used
+var MigrationBackupDir string
+
+// Migration upgrades a config from Version to Version+1
+type Migration struct {
+	Version     int
+	Description string
+	Migrate     func(c *Config) error
+}
+
+// migrations holds every config migration ordered by version, a new migration
+// must be appended with the next version and CurrentConfigVersion increased
+var migrations = []Migration{
+	{
+		Version:     0,
+		Description: "rename the GDAX exchange to CoinbasePro",
+		Migrate:     migrateGDAXToCoinbasePro,
+	},
+	{
+		Version:     1,
+		Description: "move the deprecated root currency settings into currencyConfig",
+		Migrate:     migrateCurrencySettings,
+	},
+	{
+		Version:     2,
+		Description: "move the deprecated root smsGlobal settings into communications",
+		Migrate:     migrateSMSGlobalSettings,
+	},
+}
+
+func migrateGDAXToCoinbasePro(c *Config) error {
+	for i := range c.Exchanges {
+		if c.Exchanges[i].Name == "GDAX" {
+			c.Exchanges[i].Name = "CoinbasePro"
+		}
+	}
+	return nil
+}
+
+func migrateCurrencySettings(c *Config) error {
+	if c.Cryptocurrencies != "" {
+		if c.Currency.Cryptocurrencies == "" {
+			c.Currency.Cryptocurrencies = c.Cryptocurrencies
+		}
+		c.Cryptocurrencies = ""
+	}
+
+	if c.CurrencyPairFormat != nil {
+		if c.Currency.CurrencyPairFormat == nil {
+			c.Currency.CurrencyPairFormat = c.CurrencyPairFormat
+		}
+		c.CurrencyPairFormat = nil
+	}
+
+	if c.FiatDisplayCurrency != "" {
+		if c.Currency.FiatDisplayCurrency == "" {
+			c.Currency.FiatDisplayCurrency = c.FiatDisplayCurrency
+		}
+		c.FiatDisplayCurrency = ""
+	}
+	return nil
+}
+
+func migrateSMSGlobalSettings(c *Config) error {
+	if c.SMS == nil {
+		return nil
+	}
+
+	if c.Communications.SMSGlobalConfig.Name == "" && c.SMS.Contacts != nil {
+		c.Communications.SMSGlobalConfig = SMSGlobalConfig{
+			Name:     "SMSGlobal",
+			Enabled:  c.SMS.Enabled,
+			Verbose:  c.SMS.Verbose,
+			Username: c.SMS.Username,
+			Password: c.SMS.Password,
+			Contacts: c.SMS.Contacts,
+		}
+	}
+	c.SMS = nil
+	return nil
+}
+
+// PendingMigrations returns the migrations needed to upgrade the config to the
+// current version
+func (c *Config) PendingMigrations() ([]Migration, error) {
+	if c.Version > CurrentConfigVersion {
+		return nil, fmt.Errorf("config version %d is newer than the supported version %d",
+			c.Version, CurrentConfigVersion)
+	}
+	if c.Version < 0 {
+		return nil, fmt.Errorf("config version %d is invalid", c.Version)
+	}
+	return migrations[c.Version:], nil
+}
+
+// Migrate applies each pending migration in order and returns the migrations
+// applied, the config version is only increased for successful migrations
+func (c *Config) Migrate() ([]Migration, error) {
+	pending, err := c.PendingMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pending {
+		err = pending[i].Migrate(c)
+		if err != nil {
+			return pending[:i], fmt.Errorf("config migration from version %d failed: %s",
+				pending[i].Version, err)
+		}
+		c.Version = pending[i].Version + 1
+	}
+	return pending, nil
+}
+
+// DiffMigration returns the changes the pending migrations would make to the
+// config without modifying it
+func (c *Config) DiffMigration() ([]Migration, []string, error) {
+	before, err := json.Marshal(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrated, err := c.Copy()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	applied, err := migrated.Migrate()
+	if err != nil {
+		return applied, nil, err
+	}
+
+	after, err := json.Marshal(&migrated)
+	if err != nil {
+		return applied, nil, err
+	}
+
+	diff, err := DiffJSON(before, after)
+	return applied, diff, err
+}
+
+// BackupConfigFile copies the config file to the backup directory before it
+// is migrated from its version. An existing backup of the same version is
+// kept so the original file is preserved across repeated migrations
+func BackupConfigFile(configPath string, version int) (string, error) {
+	dir := MigrationBackupDir
+	if dir == "" {
+		dir = filepath.Join(common.GetDefaultDataDir(runtime.GOOS), configBackupDir)
+	}
+
+	err := common.CheckDir(dir, true)
+	if err != nil {
+		return "", err
+	}
+
+	backup := filepath.Join(dir, fmt.Sprintf("%s.v%d.bak", filepath.Base(configPath), version))
+	if _, err = os.Stat(backup); err == nil {
+		return backup, nil
+	}
+
+	data, err := common.ReadFile(configPath)
+	if err != nil {
+		return "", err
+	}
+	return backup, common.WriteFile(backup, data)
+}
+
+// migrateConfigFile backs up the config file then migrates the config read
+// from it to the current version
+func (c *Config) migrateConfigFile(configPath string) error {
+	if c.Version == CurrentConfigVersion {
+		return nil
+	}
+
+	pending, err := c.PendingMigrations()
+	if err != nil {
+		return err
+	}
+
+	backup, err := BackupConfigFile(configPath, c.Version)
+	if err != nil {
+		return fmt.Errorf("unable to back up config before migrating: %s", err)
+	}
+	log.Printf("Config version %d is out of date, backed up to %s before migrating.",
+		c.Version, backup)
+
+	applied, err := c.Migrate()
+	for i := range applied {
+		log.Printf("Config migrated to version %d: %s.", applied[i].Version+1,
+			applied[i].Description)
+	}
+	if err == nil && len(applied) != len(pending) {
+		err = fmt.Errorf("config migration stopped at version %d", c.Version)
+	}
+	return err
+}
+
+// DiffJSON compares two JSON documents and returns each changed value as a
+// line prefixed with - for removed or old values and + for added or new values
+func DiffJSON(before, after []byte) ([]string, error) {
+	var b, a interface{}
+	err := json.Unmarshal(before, &b)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(after, &a)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeValues := make(map[string]string)
+	afterValues := make(map[string]string)
+	flattenJSON("", b, beforeValues)
+	flattenJSON("", a, afterValues)
+
+	keys := make(map[string]bool)
+	for k := range beforeValues {
+		keys[k] = true
+	}
+	for k := range afterValues {
+		keys[k] = true
+	}
+
+	var sorted []string
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var diff []string
+	for _, k := range sorted {
+		old, hadOld := beforeValues[k]
+		updated, hasNew := afterValues[k]
+		if hadOld && hasNew && old == updated {
+			continue
+		}
+		if hadOld {
+			diff = append(diff, fmt.Sprintf("- %s: %s", k, old))
+		}
+		if hasNew {
+			diff = append(diff, fmt.Sprintf("+ %s: %s", k, updated))
+		}
+	}
+	return diff, nil
+}
+
+// flattenJSON stores each leaf value of a decoded JSON document by its path
+func flattenJSON(path string, v interface{}, values map[string]string) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if len(t) == 0 {
+			values[path] = "{}"
+		}
+		for k, child := range t {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			flattenJSON(childPath, child, values)
+		}
+	case []interface{}:
+		if len(t) == 0 {
+			values[path] = "[]"
+		}
+		for i, child := range t {
+			flattenJSON(path+"["+strconv.Itoa(i)+"]", child, values)
+		}
+	default:
+		encoded, _ := json.Marshal(t)
+		values[path] = string(encoded)
+	}
+}
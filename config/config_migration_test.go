@@ -0,0 +1,143 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+func TestMigrationsOrdered(t *testing.T) {
+	if len(migrations) != CurrentConfigVersion {
+		t.Errorf("Test failed. Expected %d migrations, got %d",
+			CurrentConfigVersion, len(migrations))
+	}
+	for i := range migrations {
+		if migrations[i].Version != i {
+			t.Errorf("Test failed. Migration %d has version %d", i,
+				migrations[i].Version)
+		}
+		if migrations[i].Description == "" || migrations[i].Migrate == nil {
+			t.Errorf("Test failed. Migration %d is incomplete", i)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	cfg := Config{
+		Exchanges:           []ExchangeConfig{{Name: "GDAX"}},
+		Cryptocurrencies:    "BTC,LTC",
+		FiatDisplayCurrency: "AUD",
+		CurrencyPairFormat:  &CurrencyPairFormatConfig{Delimiter: "_"},
+		SMS: &SMSGlobalConfig{
+			Enabled:  true,
+			Username: "bob",
+			Contacts: []SMSContact{{Name: "Bobby", Number: "4321"}},
+		},
+	}
+
+	applied, err := cfg.Migrate()
+	if err != nil {
+		t.Fatal("Test failed. Migrate error", err)
+	}
+	if len(applied) != CurrentConfigVersion || cfg.Version != CurrentConfigVersion {
+		t.Errorf("Test failed. Migrate applied %d migrations to version %d",
+			len(applied), cfg.Version)
+	}
+
+	if cfg.Exchanges[0].Name != "CoinbasePro" {
+		t.Error("Test failed. Migrate did not rename GDAX")
+	}
+	if cfg.Currency.Cryptocurrencies != "BTC,LTC" || cfg.Cryptocurrencies != "" ||
+		cfg.Currency.FiatDisplayCurrency != "AUD" || cfg.FiatDisplayCurrency != "" ||
+		cfg.Currency.CurrencyPairFormat == nil || cfg.CurrencyPairFormat != nil ||
+		cfg.Currency.CurrencyPairFormat.Delimiter != "_" {
+		t.Error("Test failed. Migrate unexpected currency config", cfg.Currency)
+	}
+	if cfg.SMS != nil || cfg.Communications.SMSGlobalConfig.Name != "SMSGlobal" ||
+		!cfg.Communications.SMSGlobalConfig.Enabled ||
+		cfg.Communications.SMSGlobalConfig.Contacts[0].Name != "Bobby" {
+		t.Error("Test failed. Migrate unexpected SMSGlobal config",
+			cfg.Communications.SMSGlobalConfig)
+	}
+
+	applied, err = cfg.Migrate()
+	if err != nil || len(applied) != 0 {
+		t.Error("Test failed. Migrate reapplied migrations", err)
+	}
+
+	cfg.Version = CurrentConfigVersion + 1
+	_, err = cfg.Migrate()
+	if err == nil {
+		t.Error("Test failed. Migrate accepted a newer config version")
+	}
+}
+
+func TestDiffMigration(t *testing.T) {
+	cfg := Config{
+		Version:   2,
+		Exchanges: []ExchangeConfig{{Name: "GDAX"}},
+		SMS:       &SMSGlobalConfig{Username: "bob", Contacts: []SMSContact{}},
+	}
+
+	applied, diff, err := cfg.DiffMigration()
+	if err != nil {
+		t.Fatal("Test failed. DiffMigration error", err)
+	}
+	if len(applied) != 1 || applied[0].Version != 2 {
+		t.Error("Test failed. DiffMigration unexpected migrations", applied)
+	}
+	if cfg.Version != 2 || cfg.SMS == nil {
+		t.Error("Test failed. DiffMigration modified the config")
+	}
+
+	for _, line := range []string{"- version: 2", "+ version: 3",
+		"- smsGlobal.username: \"bob\"",
+		"+ communications.smsGlobal.username: \"bob\""} {
+		if !common.StringDataCompare(diff, line) {
+			t.Errorf("Test failed. DiffMigration missing %s in %v", line, diff)
+		}
+	}
+}
+
+func TestBackupConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-config-backup")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	oldDir := MigrationBackupDir
+	MigrationBackupDir = filepath.Join(dir, configBackupDir)
+	defer func() { MigrationBackupDir = oldDir }()
+
+	configPath := filepath.Join(dir, "config.json")
+	err = common.WriteFile(configPath, []byte(`{"name":"v0"}`))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+
+	backup, err := BackupConfigFile(configPath, 0)
+	if err != nil {
+		t.Fatal("Test failed. BackupConfigFile error", err)
+	}
+	if backup != filepath.Join(MigrationBackupDir, "config.json.v0.bak") {
+		t.Error("Test failed. BackupConfigFile unexpected path", backup)
+	}
+
+	err = common.WriteFile(configPath, []byte(`{"name":"v1"}`))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+	_, err = BackupConfigFile(configPath, 0)
+	if err != nil {
+		t.Fatal("Test failed. BackupConfigFile error", err)
+	}
+
+	data, err := common.ReadFile(backup)
+	if err != nil || string(data) != `{"name":"v0"}` {
+		t.Error("Test failed. BackupConfigFile overwrote the original backup", err)
+	}
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+// ProfileName selects the config profile applied when the config is loaded,
+// overriding the profile set in the config file
+var ProfileName string
+
+// ProfileConfig holds a named set of overrides applied over the config, so
+// several bot instances can share one config
+type ProfileConfig struct {
+	Name string `json:"name"`
+	// EnabledExchanges enables only the listed exchanges when set
+	EnabledExchanges []string `json:"enabledExchanges,omitempty"`
+	// EnabledPairs replaces the enabled pairs of an exchange, keyed by the
+	// exchange name
+	EnabledPairs  map[string]string `json:"enabledPairs,omitempty"`
+	ListenAddress string            `json:"listenAddress,omitempty"`
+	DryRun        bool              `json:"dryRun,omitempty"`
+}
+
+// profileBase holds the settings a profile overrode, so the config can be
+// saved with its own settings rather than the profile overrides
+type profileBase struct {
+	profile       ProfileConfig
+	enabled       map[string]bool
+	enabledPairs  map[string]string
+	listenAddress string
+}
+
+// GetProfile returns the profile by its case insensitive name
+func (c *Config) GetProfile(name string) (ProfileConfig, error) {
+	for i := range c.Profiles {
+		if common.StringToLower(c.Profiles[i].Name) == common.StringToLower(name) {
+			return c.Profiles[i], nil
+		}
+	}
+	return ProfileConfig{}, fmt.Errorf("config profile %s not found", name)
+}
+
+// ActiveProfile returns the profile applied to the config, false is returned
+// when no profile is applied
+func (c *Config) ActiveProfile() (ProfileConfig, bool) {
+	if c.Profile == "" {
+		return ProfileConfig{}, false
+	}
+	p, err := c.GetProfile(c.Profile)
+	return p, err == nil
+}
+
+// ApplyProfile applies the profile overrides to the config. The profile set
+// in the config file is used when name is empty, if neither is set the config
+// is left unchanged
+func (c *Config) ApplyProfile(name string) error {
+	if name == "" {
+		name = c.Profile
+	}
+	if name == "" {
+		return nil
+	}
+
+	p, err := c.GetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	for i := range p.EnabledExchanges {
+		_, err = c.GetExchangeConfig(p.EnabledExchanges[i])
+		if err != nil {
+			return fmt.Errorf("config profile %s: %s", p.Name, err)
+		}
+	}
+
+	for exch := range p.EnabledPairs {
+		_, err = c.GetExchangeConfig(exch)
+		if err != nil {
+			return fmt.Errorf("config profile %s: %s", p.Name, err)
+		}
+	}
+
+	// a config which already has a profile applied keeps the settings from
+	// before the first profile
+	base := c.profileBase
+	if base == nil {
+		base = &profileBase{
+			enabled:       make(map[string]bool),
+			enabledPairs:  make(map[string]string),
+			listenAddress: c.Webserver.ListenAddress,
+		}
+	}
+	base.profile = p
+
+	for i := range c.Exchanges {
+		name := c.Exchanges[i].Name
+		if p.EnabledExchanges != nil {
+			if _, ok := base.enabled[name]; !ok {
+				base.enabled[name] = c.Exchanges[i].Enabled
+			}
+			c.Exchanges[i].Enabled = common.StringDataCompare(p.EnabledExchanges, name)
+		}
+		if pairs, ok := p.EnabledPairs[name]; ok {
+			if _, ok := base.enabledPairs[name]; !ok {
+				base.enabledPairs[name] = c.Exchanges[i].EnabledPairs
+			}
+			c.Exchanges[i].EnabledPairs = pairs
+		}
+	}
+
+	if p.ListenAddress != "" {
+		c.Webserver.ListenAddress = p.ListenAddress
+	}
+	c.profileBase = base
+
+	if common.StringToLower(p.Name) != common.StringToLower(c.Profile) {
+		c.profileOverlay = true
+	}
+	c.Profile = p.Name
+	return nil
+}
+
+// withoutProfileOverrides returns the config with the settings overridden by
+// the applied profile set back to their values from before it was applied. A
+// setting changed since the profile was applied is kept, the config is copied
+// before it is modified if it is the receiver
+func (c *Config) withoutProfileOverrides(cfg *Config) (*Config, error) {
+	base := c.profileBase
+	if base == nil {
+		return cfg, nil
+	}
+
+	if cfg == c {
+		copied, err := c.Copy()
+		if err != nil {
+			return nil, err
+		}
+		cfg = &copied
+	}
+
+	p := base.profile
+	for i := range cfg.Exchanges {
+		name := cfg.Exchanges[i].Name
+		if enabled, ok := base.enabled[name]; ok &&
+			cfg.Exchanges[i].Enabled == common.StringDataCompare(p.EnabledExchanges, name) {
+			cfg.Exchanges[i].Enabled = enabled
+		}
+		if pairs, ok := base.enabledPairs[name]; ok &&
+			cfg.Exchanges[i].EnabledPairs == p.EnabledPairs[name] {
+			cfg.Exchanges[i].EnabledPairs = pairs
+		}
+	}
+
+	if p.ListenAddress != "" && cfg.Webserver.ListenAddress == p.ListenAddress {
+		cfg.Webserver.ListenAddress = base.listenAddress
+	}
+	return cfg, nil
+}
+
+// profileErrors returns the problems found with the config profiles
+func (c *Config) profileErrors() []error {
+	var errs []error
+	seen := make(map[string]bool)
+	for i := range c.Profiles {
+		p := c.Profiles[i]
+		if p.Name == "" {
+			errs = append(errs, fmt.Errorf("profile #%d name is empty", i))
+			continue
+		}
+
+		name := common.StringToLower(p.Name)
+		if seen[name] {
+			errs = append(errs, fmt.Errorf("profile %s is defined more than once", p.Name))
+		}
+		seen[name] = true
+
+		exchanges := append([]string{}, p.EnabledExchanges...)
+		for exch := range p.EnabledPairs {
+			exchanges = append(exchanges, exch)
+		}
+		for j := range exchanges {
+			if _, err := c.GetExchangeConfig(exchanges[j]); err != nil {
+				errs = append(errs, fmt.Errorf("profile %s: %s", p.Name, err))
+			}
+		}
+
+		if p.ListenAddress != "" && !isValidListenAddress(p.ListenAddress) {
+			errs = append(errs, fmt.Errorf("profile %s listen address %s is invalid",
+				p.Name, p.ListenAddress))
+		}
+	}
+
+	if c.Profile != "" {
+		if _, err := c.GetProfile(c.Profile); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApplyProfile(t *testing.T) {
+	var cfg Config
+	err := cfg.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+
+	cfg.Profiles = []ProfileConfig{
+		{
+			Name:             "marketdata",
+			EnabledExchanges: []string{"Bitstamp"},
+			EnabledPairs:     map[string]string{"Bitstamp": "BTCUSD"},
+			ListenAddress:    ":9060",
+			DryRun:           true,
+		},
+		{
+			Name:             "broken",
+			EnabledExchanges: []string{"asdf"},
+		},
+	}
+
+	err = cfg.ApplyProfile("")
+	if err != nil || cfg.Profile != "" {
+		t.Error("Test failed. ApplyProfile applied a profile when none was set", err)
+	}
+
+	err = cfg.ApplyProfile("asdf")
+	if err == nil {
+		t.Error("Test failed. ApplyProfile applied a profile which doesn't exist")
+	}
+
+	err = cfg.ApplyProfile("broken")
+	if err == nil {
+		t.Error("Test failed. ApplyProfile applied a profile with an unknown exchange")
+	}
+
+	err = cfg.ApplyProfile("MarketData")
+	if err != nil {
+		t.Fatal("Test failed. ApplyProfile error", err)
+	}
+
+	if cfg.CountEnabledExchanges() != 1 || cfg.Webserver.ListenAddress != ":9060" {
+		t.Error("Test failed. ApplyProfile didn't apply the overrides")
+	}
+
+	bitstamp, err := cfg.GetExchangeConfig("Bitstamp")
+	if err != nil || !bitstamp.Enabled || bitstamp.EnabledPairs != "BTCUSD" {
+		t.Error("Test failed. ApplyProfile didn't override the exchange", err)
+	}
+
+	profile, ok := cfg.ActiveProfile()
+	if !ok || profile.Name != "marketdata" || !profile.DryRun {
+		t.Error("Test failed. ActiveProfile unexpected result", profile)
+	}
+
+	if !cfg.IsComposed() || cfg.SaveConfig(ConfigTestFile) != ErrComposedConfig {
+		t.Error("Test failed. SaveConfig saved a config with a profile applied")
+	}
+	var fileCfg Config
+	err = fileCfg.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+
+	fileCfg.Profiles = cfg.Profiles
+	fileCfg.Profile = "marketdata"
+	err = fileCfg.ApplyProfile("")
+	if err != nil {
+		t.Fatal("Test failed. ApplyProfile error", err)
+	}
+
+	if fileCfg.IsComposed() {
+		t.Error("Test failed. Config with a profile named in the file treated as composed")
+	}
+
+	err = fileCfg.ApplyProfile("broken")
+	if err == nil || fileCfg.IsComposed() {
+		t.Error("Test failed. Failed ApplyProfile marked the config as composed")
+	}
+
+	// the profile overrides aren't saved over the config file settings, a
+	// setting changed after the profile was applied is saved
+	dir, err := ioutil.TempDir("", "gct-config-profile")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var original Config
+	err = original.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+	fileCfg.Webserver.ListenAddress = ":9070"
+	path := filepath.Join(dir, "config.json")
+	err = fileCfg.SaveConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig error", err)
+	}
+
+	var saved Config
+	err = saved.ReadConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+	if saved.CountEnabledExchanges() != original.CountEnabledExchanges() {
+		t.Error("Test failed. SaveConfig saved the profile exchange overrides")
+	}
+	savedBitstamp, err := saved.GetExchangeConfig("Bitstamp")
+	originalBitstamp, _ := original.GetExchangeConfig("Bitstamp")
+	if err != nil || savedBitstamp.EnabledPairs != originalBitstamp.EnabledPairs {
+		t.Error("Test failed. SaveConfig saved the profile pairs override", err)
+	}
+	if saved.Webserver.ListenAddress != ":9070" {
+		t.Error("Test failed. SaveConfig didn't save a setting changed after the profile",
+			saved.Webserver.ListenAddress)
+	}
+
+	bitstamp, err = fileCfg.GetExchangeConfig("Bitstamp")
+	if err != nil || bitstamp.EnabledPairs != "BTCUSD" {
+		t.Error("Test failed. SaveConfig modified the running config", err)
+	}
+}
+
+func TestProfileErrors(t *testing.T) {
+	var cfg Config
+	err := cfg.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+
+	cfg.Profiles = []ProfileConfig{
+		{Name: "trading", EnabledExchanges: []string{"Bitfinex"}},
+		{Name: "Trading"},
+		{Name: ""},
+		{Name: "paper", EnabledPairs: map[string]string{"asdf": "BTCUSD"}},
+		{Name: "web", ListenAddress: "asdf"},
+	}
+	cfg.Profile = "asdf"
+
+	errs := cfg.profileErrors()
+	if len(errs) != 5 {
+		t.Error("Test failed. profileErrors unexpected errors", errs)
+	}
+
+	report := cfg.Validate()
+	if report.Valid {
+		t.Error("Test failed. Validate accepted invalid profiles")
+	}
+}
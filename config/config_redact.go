@@ -0,0 +1,37 @@
+package config
+
+import "log"
+
+// RedactedValue replaces secrets in configs returned to clients which are not
+// permitted to view them
+const RedactedValue = "REDACTED"
+
+// Redacted returns a copy of the config with every secret listed by
+// secretFields, such as API keys, passwords, tokens and bank account numbers,
+// replaced so it can be returned to read-only clients. The original config is
+// not modified
+func (c *Config) Redacted() Config {
+	m.Lock()
+	defer m.Unlock()
+
+	r, err := c.Copy()
+	if err != nil {
+		// never fall back to returning the secrets
+		log.Printf("Unable to copy config for redaction. Err: %s", err)
+		return Config{}
+	}
+
+	for _, field := range r.secretFields() {
+		field.set(redact(field.get()))
+	}
+	return r
+}
+
+// redact returns RedactedValue for any non empty value so clients can still
+// tell whether a secret has been set
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return RedactedValue
+}
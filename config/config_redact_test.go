@@ -0,0 +1,50 @@
+package config
+
+import "testing"
+
+func TestRedacted(t *testing.T) {
+	var cfg Config
+	err := cfg.LoadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. Redacted LoadConfig error", err)
+	}
+
+	cfg.Exchanges[0].APIKey = "key"
+	cfg.Exchanges[0].APISecret = "secret"
+	cfg.Exchanges[0].ClientID = ""
+	cfg.Webserver.APITokens = []APIToken{{Name: "test", Token: "token", Scope: APIScopeRead}}
+	cfg.Currency.ForexProviders[0].APIKey = "forexkey"
+
+	r := cfg.Redacted()
+	if r.Exchanges[0].APIKey != RedactedValue ||
+		r.Exchanges[0].APISecret != RedactedValue ||
+		r.Exchanges[0].ClientID != "" ||
+		r.Webserver.AdminPassword != RedactedValue ||
+		r.Webserver.APITokens[0].Token != RedactedValue ||
+		r.Currency.ForexProviders[0].APIKey != RedactedValue ||
+		r.Communications.SMTPConfig.AccountPassword != RedactedValue {
+		t.Error("Test failed. Redacted secrets not redacted")
+	}
+
+	if cfg.Exchanges[0].APIKey != "key" ||
+		cfg.Webserver.APITokens[0].Token != "token" ||
+		cfg.Currency.ForexProviders[0].APIKey != "forexkey" ||
+		cfg.Webserver.AdminPassword == RedactedValue {
+		t.Error("Test failed. Redacted modified the original config")
+	}
+
+	if r.Exchanges[0].Name != cfg.Exchanges[0].Name {
+		t.Error("Test failed. Redacted non secret value modified")
+	}
+
+	cfg.SMS = &SMSGlobalConfig{Password: "password"}
+	r = cfg.Redacted()
+	for path, field := range r.secretFields() {
+		if value := field.get(); value != "" && value != RedactedValue {
+			t.Error("Test failed. Redacted secret not redacted", path)
+		}
+	}
+	if cfg.SMS.Password != "password" {
+		t.Error("Test failed. Redacted modified the original config")
+	}
+}
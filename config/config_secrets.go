@@ -0,0 +1,223 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+const (
+	// SecretEnvPrefix marks a secret value read from an environment variable,
+	// for example "env:BINANCE_API_KEY"
+	SecretEnvPrefix = "env:"
+	// SecretFilePrefix marks a secret value read from a file, for example
+	// "file:/run/secrets/binance_api_key"
+	SecretFilePrefix = "file:"
+	// EncryptionKeyEnvVar is the environment variable the config encryption
+	// key is read from when EncryptionKeySource is not set
+	EncryptionKeyEnvVar = "GCT_CONFIG_KEY"
+)
+
+// EncryptionKeySource is a secret reference the config encryption key is read
+// from instead of prompting for it, allowing encrypted configs to be loaded
+// by headless deployments
+var EncryptionKeySource string
+
+// secretRef holds a secret reference from the config file and the value it
+// resolved to, so the reference can be written back in place of the value
+type secretRef struct {
+	reference string
+	value     string
+}
+
+// IsSecretReference returns whether the value references a secret held in an
+// environment variable or file rather than the secret itself
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, SecretEnvPrefix) ||
+		strings.HasPrefix(value, SecretFilePrefix)
+}
+
+// ResolveSecret returns the secret a reference points to, values which are
+// not references are returned unchanged
+func ResolveSecret(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, SecretEnvPrefix):
+		name := strings.TrimPrefix(value, SecretEnvPrefix)
+		secret, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return secret, nil
+	case strings.HasPrefix(value, SecretFilePrefix):
+		path := strings.TrimPrefix(value, SecretFilePrefix)
+		data, err := common.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("unable to read secret file %s: %s", path, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return value, nil
+}
+
+// EncryptionKey returns the config encryption key from EncryptionKeySource or
+// the GCT_CONFIG_KEY environment variable, nil is returned when neither is set
+// and the key must be entered at the prompt
+func EncryptionKey() ([]byte, error) {
+	if EncryptionKeySource != "" {
+		if !IsSecretReference(EncryptionKeySource) {
+			return nil, fmt.Errorf("config encryption key source %s must start with %s or %s",
+				EncryptionKeySource, SecretEnvPrefix, SecretFilePrefix)
+		}
+
+		key, err := ResolveSecret(EncryptionKeySource)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			return nil, errors.New("config encryption key is empty")
+		}
+		return []byte(key), nil
+	}
+
+	key := os.Getenv(EncryptionKeyEnvVar)
+	if key == "" {
+		return nil, nil
+	}
+	return []byte(key), nil
+}
+
+// secretField reads and writes a secret held in the config
+type secretField struct {
+	get func() string
+	set func(value string)
+}
+
+func stringField(value *string) secretField {
+	return secretField{
+		get: func() string { return *value },
+		set: func(v string) { *value = v },
+	}
+}
+
+func headerField(headers map[string]string, name string) secretField {
+	return secretField{
+		get: func() string { return headers[name] },
+		set: func(v string) { headers[name] = v },
+	}
+}
+
+// secretFields returns each secret in the config keyed by its path, it is the
+// single list of secrets used both to resolve secret references and by
+// Redacted. Exchanges, forex providers, webhook endpoints and API tokens are
+// keyed by name so references survive reordering
+func (c *Config) secretFields() map[string]secretField {
+	comms := &c.Communications
+	fields := map[string]secretField{
+		"webserver.adminPassword":                   stringField(&c.Webserver.AdminPassword),
+		"communications.slack.verificationToken":    stringField(&comms.SlackConfig.VerificationToken),
+		"communications.smsGlobal.password":         stringField(&comms.SMSGlobalConfig.Password),
+		"communications.smtp.accountPassword":       stringField(&comms.SMTPConfig.AccountPassword),
+		"communications.telegram.verificationToken": stringField(&comms.TelegramConfig.VerificationToken),
+		"communications.discord.botToken":           stringField(&comms.DiscordConfig.BotToken),
+		"communications.matrix.accessToken":         stringField(&comms.MatrixConfig.AccessToken),
+	}
+
+	for i := range c.Webserver.APITokens {
+		fields["webserver.apiTokens."+c.Webserver.APITokens[i].Name+".token"] =
+			stringField(&c.Webserver.APITokens[i].Token)
+	}
+
+	for i := range comms.WebhookConfig.Endpoints {
+		endpoint := &comms.WebhookConfig.Endpoints[i]
+		prefix := "communications.webhook." + endpoint.Name + "."
+		fields[prefix+"hmacSecret"] = stringField(&endpoint.HMACSecret)
+		for name := range endpoint.Headers {
+			fields[prefix+"headers."+name] = headerField(endpoint.Headers, name)
+		}
+	}
+
+	for i := range c.Exchanges {
+		prefix := "exchanges." + c.Exchanges[i].Name + "."
+		if _, ok := fields[prefix+"apiKey"]; ok {
+			prefix = fmt.Sprintf("exchanges[%d].", i)
+		}
+		fields[prefix+"apiKey"] = stringField(&c.Exchanges[i].APIKey)
+		fields[prefix+"apiSecret"] = stringField(&c.Exchanges[i].APISecret)
+		fields[prefix+"apiAuthPemKey"] = stringField(&c.Exchanges[i].APIAuthPEMKey)
+		fields[prefix+"clientId"] = stringField(&c.Exchanges[i].ClientID)
+		addBankAccountFields(fields, prefix+"bankAccounts", c.Exchanges[i].BankAccounts)
+	}
+	addBankAccountFields(fields, "bankAccounts", c.BankAccounts)
+
+	for i := range c.Currency.ForexProviders {
+		path := "currencyConfig.forexProviders." + c.Currency.ForexProviders[i].Name + ".apiKey"
+		if _, ok := fields[path]; ok {
+			path = fmt.Sprintf("currencyConfig.forexProviders[%d].apiKey", i)
+		}
+		fields[path] = stringField(&c.Currency.ForexProviders[i].APIKey)
+	}
+
+	if c.SMS != nil {
+		fields["smsGlobal.password"] = stringField(&c.SMS.Password)
+	}
+	return fields
+}
+
+// addBankAccountFields adds the account numbers of each bank account, keyed by
+// its index
+func addBankAccountFields(fields map[string]secretField, path string, accounts []BankAccount) {
+	for i := range accounts {
+		prefix := fmt.Sprintf("%s[%d].", path, i)
+		fields[prefix+"accountNumber"] = stringField(&accounts[i].AccountNumber)
+		fields[prefix+"iban"] = stringField(&accounts[i].IBAN)
+		fields[prefix+"bsbNumber"] = stringField(&accounts[i].BSBNumber)
+		fields[prefix+"swiftCode"] = stringField(&accounts[i].SWIFTCode)
+	}
+}
+
+// ResolveSecrets replaces each secret reference in the config with the secret
+// it points to, the references are kept so SaveConfig writes them back instead
+// of the secrets
+func (c *Config) ResolveSecrets() error {
+	c.secrets = make(map[string]secretRef)
+	for path, field := range c.secretFields() {
+		reference := field.get()
+		if !IsSecretReference(reference) {
+			continue
+		}
+
+		value, err := ResolveSecret(reference)
+		if err != nil {
+			return fmt.Errorf("unable to resolve secret %s: %s", path, err)
+		}
+		c.secrets[path] = secretRef{reference: reference, value: value}
+		field.set(value)
+	}
+	return nil
+}
+
+// withSecretReferences returns a copy of the config with resolved secrets
+// replaced by their references, a secret changed since it was resolved is
+// kept as is
+func (c *Config) withSecretReferences() (*Config, error) {
+	if len(c.secrets) == 0 {
+		return c, nil
+	}
+
+	cfg, err := c.Copy()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := cfg.secretFields()
+	for path, ref := range c.secrets {
+		field, ok := fields[path]
+		if ok && field.get() == ref.value {
+			field.set(ref.reference)
+		}
+	}
+	return &cfg, nil
+}
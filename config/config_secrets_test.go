@@ -0,0 +1,217 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+func TestResolveSecret(t *testing.T) {
+	os.Setenv("GCT_TEST_SECRET", "envsecret")
+	defer os.Unsetenv("GCT_TEST_SECRET")
+
+	secret, err := ResolveSecret("env:GCT_TEST_SECRET")
+	if err != nil || secret != "envsecret" {
+		t.Error("Test failed. ResolveSecret env error", err)
+	}
+
+	_, err = ResolveSecret("env:GCT_TEST_SECRET_UNSET")
+	if err == nil {
+		t.Error("Test failed. ResolveSecret resolved an unset environment variable")
+	}
+
+	dir, err := ioutil.TempDir("", "gct-config-secrets")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "secret")
+	err = common.WriteFile(path, []byte("filesecret\n"))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+
+	secret, err = ResolveSecret("file:" + path)
+	if err != nil || secret != "filesecret" {
+		t.Error("Test failed. ResolveSecret file error", err)
+	}
+
+	_, err = ResolveSecret("file:" + filepath.Join(dir, "missing"))
+	if err == nil {
+		t.Error("Test failed. ResolveSecret resolved a missing file")
+	}
+
+	secret, err = ResolveSecret("plaintext")
+	if err != nil || secret != "plaintext" {
+		t.Error("Test failed. ResolveSecret changed a plain value", err)
+	}
+}
+
+func TestEncryptionKey(t *testing.T) {
+	defer func() { EncryptionKeySource = "" }()
+	os.Unsetenv(EncryptionKeyEnvVar)
+
+	key, err := EncryptionKey()
+	if err != nil || key != nil {
+		t.Error("Test failed. EncryptionKey returned a key when none was set", err)
+	}
+
+	os.Setenv(EncryptionKeyEnvVar, "defaultkey")
+	defer os.Unsetenv(EncryptionKeyEnvVar)
+	key, err = EncryptionKey()
+	if err != nil || string(key) != "defaultkey" {
+		t.Error("Test failed. EncryptionKey env var error", err)
+	}
+
+	os.Setenv("GCT_TEST_CONFIG_KEY", "sourcekey")
+	defer os.Unsetenv("GCT_TEST_CONFIG_KEY")
+	EncryptionKeySource = "env:GCT_TEST_CONFIG_KEY"
+	key, err = EncryptionKey()
+	if err != nil || string(key) != "sourcekey" {
+		t.Error("Test failed. EncryptionKey source error", err)
+	}
+
+	EncryptionKeySource = "sourcekey"
+	_, err = EncryptionKey()
+	if err == nil {
+		t.Error("Test failed. EncryptionKey accepted a key which isn't a reference")
+	}
+}
+
+func TestSecretReferences(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-config-secrets")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var cfg Config
+	err = cfg.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+	cfg.Exchanges[0].APIKey = "env:GCT_TEST_API_KEY"
+	cfg.Webserver.AdminPassword = "env:GCT_TEST_ADMIN_PASSWORD"
+
+	path := filepath.Join(dir, "config.json")
+	err = cfg.SaveConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig error", err)
+	}
+
+	var loaded Config
+	err = loaded.LoadConfig(path)
+	if err == nil {
+		t.Error("Test failed. LoadConfig resolved unset secret references")
+	}
+
+	os.Setenv("GCT_TEST_API_KEY", "apikey")
+	os.Setenv("GCT_TEST_ADMIN_PASSWORD", "adminpassword")
+	defer os.Unsetenv("GCT_TEST_API_KEY")
+	defer os.Unsetenv("GCT_TEST_ADMIN_PASSWORD")
+
+	loaded = Config{}
+	err = loaded.LoadConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. LoadConfig error", err)
+	}
+	if loaded.Exchanges[0].APIKey != "apikey" ||
+		loaded.Webserver.AdminPassword != "adminpassword" {
+		t.Error("Test failed. LoadConfig did not resolve secret references")
+	}
+
+	loaded.Webserver.AdminPassword = "changed"
+	err = loaded.SaveConfig(path)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig error", err)
+	}
+	if loaded.Exchanges[0].APIKey != "apikey" {
+		t.Error("Test failed. SaveConfig modified the resolved secrets")
+	}
+
+	data, err := common.ReadFile(path)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+	if !strings.Contains(string(data), `"env:GCT_TEST_API_KEY"`) ||
+		strings.Contains(string(data), `"apikey"`) {
+		t.Error("Test failed. SaveConfig wrote the resolved secret")
+	}
+	if !strings.Contains(string(data), `"changed"`) {
+		t.Error("Test failed. SaveConfig did not write the changed secret")
+	}
+}
+
+func TestResolveSecrets(t *testing.T) {
+	os.Setenv("GCT_TEST_FOREX_KEY", "forexkey")
+	os.Setenv("GCT_TEST_SMS_PASSWORD", "smspassword")
+	defer os.Unsetenv("GCT_TEST_FOREX_KEY")
+	defer os.Unsetenv("GCT_TEST_SMS_PASSWORD")
+
+	var cfg Config
+	err := cfg.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+	cfg.Currency.ForexProviders[0].APIKey = "env:GCT_TEST_FOREX_KEY"
+	cfg.SMS = &SMSGlobalConfig{Password: "env:GCT_TEST_SMS_PASSWORD"}
+
+	err = cfg.ResolveSecrets()
+	if err != nil {
+		t.Fatal("Test failed. ResolveSecrets error", err)
+	}
+	if cfg.Currency.ForexProviders[0].APIKey != "forexkey" ||
+		cfg.SMS.Password != "smspassword" {
+		t.Error("Test failed. ResolveSecrets did not resolve secret references")
+	}
+
+	path := "currencyConfig.forexProviders." + cfg.Currency.ForexProviders[0].Name + ".apiKey"
+	if _, ok := cfg.secrets[path]; !ok {
+		t.Error("Test failed. ResolveSecrets did not store the reference for", path)
+	}
+}
+
+func TestReadConfigEncryptionKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-config-secrets")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	data, err := common.ReadFile(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+
+	sessionDK = nil
+	encrypted, err := EncryptConfigFile(data, []byte("headlesskey"))
+	if err != nil {
+		t.Fatal("Test failed. EncryptConfigFile error", err)
+	}
+
+	path := filepath.Join(dir, "config.dat")
+	err = common.WriteFile(path, encrypted)
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+
+	os.Setenv(EncryptionKeyEnvVar, "wrongkey")
+	defer os.Unsetenv(EncryptionKeyEnvVar)
+	var cfg Config
+	err = cfg.ReadConfig(path)
+	if err == nil {
+		t.Error("Test failed. ReadConfig decrypted the config with the wrong key")
+	}
+
+	os.Setenv(EncryptionKeyEnvVar, "headlesskey")
+	cfg = Config{}
+	err = cfg.ReadConfig(path)
+	if err != nil || len(cfg.Exchanges) == 0 {
+		t.Error("Test failed. ReadConfig encryption key error", err)
+	}
+}
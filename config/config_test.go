@@ -2,8 +2,10 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/forexprovider/base"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 )
 
@@ -181,7 +183,7 @@ func TestUpdateCommunicationsConfig(t *testing.T) {
 }
 
 func TestCheckCommunicationsConfig(t *testing.T) {
-	cfg := GetConfig()
+	var cfg Config
 	err := cfg.LoadConfig(ConfigTestFile)
 	if err != nil {
 		t.Error("Test failed. CheckCommunicationsConfig LoadConfig error", err)
@@ -195,39 +197,20 @@ func TestCheckCommunicationsConfig(t *testing.T) {
 	if cfg.Communications.SlackConfig.Name != "Slack" ||
 		cfg.Communications.SMSGlobalConfig.Name != "SMSGlobal" ||
 		cfg.Communications.SMTPConfig.Name != "SMTP" ||
-		cfg.Communications.TelegramConfig.Name != "Telegram" {
+		cfg.Communications.TelegramConfig.Name != "Telegram" ||
+		cfg.Communications.WebhookConfig.Name != "Webhook" ||
+		cfg.Communications.DiscordConfig.Name != "Discord" ||
+		cfg.Communications.MatrixConfig.Name != "Matrix" {
 		t.Error("Test failed. CheckCommunicationsConfig unexpected data:",
 			cfg.Communications)
 	}
 
-	cfg.SMS = &SMSGlobalConfig{}
 	cfg.Communications.SMSGlobalConfig.Name = ""
 	err = cfg.CheckCommunicationsConfig()
 	if err != nil || cfg.Communications.SMSGlobalConfig.Password != "test" {
 		t.Error("Test failed. CheckCommunicationsConfig error:", err)
 	}
 
-	cfg.SMS.Contacts = append(cfg.SMS.Contacts, SMSContact{
-		Name:    "Bobby",
-		Number:  "4321",
-		Enabled: false,
-	})
-	cfg.Communications.SMSGlobalConfig.Name = ""
-	err = cfg.CheckCommunicationsConfig()
-	if err != nil || cfg.Communications.SMSGlobalConfig.Contacts[0].Name != "Bobby" {
-		t.Error("Test failed. CheckCommunicationsConfig error:", err)
-	}
-
-	cfg.SMS = &SMSGlobalConfig{}
-	err = cfg.CheckCommunicationsConfig()
-	if err != nil {
-		t.Error("Test failed. CheckCommunicationsConfig error:", err)
-	}
-	if cfg.SMS != nil {
-		t.Error("Test failed. CheckCommunicationsConfig unexpected data:",
-			cfg.SMS)
-	}
-
 	cfg.Communications.SlackConfig.Name = "NOT Slack"
 	err = cfg.CheckCommunicationsConfig()
 	if err.Error() != "Communications config name/s not set correctly" {
@@ -264,6 +247,99 @@ func TestCheckCommunicationsConfig(t *testing.T) {
 	if err.Error() != "Telegram enabled in config but variable data not set" {
 		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
 	}
+
+	cfg.Communications.TelegramConfig.VerificationToken = "testest"
+	cfg.Communications.TelegramConfig.AuthorisedUsers = []CommsUser{
+		{ID: "1337", Role: "superuser"},
+	}
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != `Telegram authorised user 1337 has invalid role "superuser"` {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.TelegramConfig.AuthorisedUsers[0].Role = CommsRoleTrader
+	err = cfg.CheckCommunicationsConfig()
+	if err != nil {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.TelegramConfig.Enabled = false
+	cfg.Communications.WebhookConfig.Enabled = true
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != "Webhook enabled in config but no endpoints enabled" {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.WebhookConfig.Endpoints[0].Enabled = true
+	cfg.Communications.WebhookConfig.Endpoints[0].URL = ""
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != "Webhook endpoint example enabled in config but URL not set" {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.WebhookConfig.Enabled = false
+	cfg.Communications.DiscordConfig.Enabled = true
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != "Discord enabled in config but variable data not set" {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.DiscordConfig.Enabled = false
+	cfg.Communications.MatrixConfig.Enabled = true
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != "Matrix enabled in config but variable data not set" {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+}
+
+func TestCheckAlertRules(t *testing.T) {
+	var cfg Config
+	err := cfg.LoadConfig(ConfigTestFile)
+	if err != nil {
+		t.Error("Test failed. CheckAlertRules LoadConfig error", err)
+	}
+
+	cfg.Communications.AlertRules = []AlertRule{{Medium: "Pager"}}
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != `Alert rule 0 has unknown medium "Pager"` {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.AlertRules = []AlertRule{{
+		Medium:     "SMSGlobal",
+		Categories: []string{"lol"},
+	}}
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != `Alert rule for SMSGlobal has unknown category "lol"` {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.AlertRules = []AlertRule{{
+		Medium:          "SMSGlobal",
+		QuietHoursStart: "22:00",
+		QuietHoursEnd:   "7am",
+	}}
+	err = cfg.CheckCommunicationsConfig()
+	if err.Error() != "Alert rule for SMSGlobal quiet hours must be in HH:MM format" {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+
+	cfg.Communications.AlertRules = []AlertRule{{
+		Medium:          "SMSGlobal",
+		Categories:      []string{AlertCategoryError},
+		MinSeverity:     AlertSeverityWarning,
+		RateLimit:       5,
+		QuietHoursStart: "22:00",
+		QuietHoursEnd:   "07:00",
+	}}
+	err = cfg.CheckCommunicationsConfig()
+	if err != nil {
+		t.Error("Test failed. CheckCommunicationsConfig unexpected error:", err)
+	}
+	if cfg.Communications.AlertRules[0].RateLimitWindow != time.Minute {
+		t.Error("Test failed. CheckCommunicationsConfig default rate limit window not set")
+	}
 }
 
 func TestCheckPairConsistency(t *testing.T) {
@@ -587,6 +663,33 @@ func TestGetPrimaryForexProvider(t *testing.T) {
 	}
 }
 
+func TestForexProviderErrors(t *testing.T) {
+	usable, errs := forexProviderErrors(&base.Settings{Name: "Fixer", APIKey: "Key"})
+	if usable || len(errs) != 1 {
+		t.Error("Test failed. forexProviderErrors error", usable, errs)
+	}
+
+	usable, errs = forexProviderErrors(&base.Settings{Name: "Fixer", APIKey: "abc", APIKeyLvl: -1})
+	if !usable || len(errs) != 1 {
+		t.Error("Test failed. forexProviderErrors error", usable, errs)
+	}
+
+	usable, errs = forexProviderErrors(&base.Settings{Name: "CurrencyConverter", APIKeyLvl: -1})
+	if !usable || len(errs) != 0 {
+		t.Error("Test failed. forexProviderErrors error", usable, errs)
+	}
+}
+
+func TestSplitUnavailablePairs(t *testing.T) {
+	available := pair.FormatPairs([]string{"BTC-USD", "LTC-USD"}, "-", "")
+	enabled := pair.FormatPairs([]string{"BTC-USD", "ETH-USD"}, "-", "")
+	pairs, unavailable := splitUnavailablePairs(available, enabled)
+	if len(pairs) != 1 || pairs[0].Pair().String() != "BTC-USD" ||
+		len(unavailable) != 1 || unavailable[0].Pair().String() != "ETH-USD" {
+		t.Error("Test failed. splitUnavailablePairs error", pairs, unavailable)
+	}
+}
+
 func TestUpdateExchangeConfig(t *testing.T) {
 	UpdateExchangeConfig := GetConfig()
 	err := UpdateExchangeConfig.LoadConfig(ConfigTestFile)
@@ -749,6 +852,19 @@ func TestCheckWebserverConfigValues(t *testing.T) {
 		)
 	}
 
+	checkWebserverConfigValues.Webserver.TLS = WebserverTLSConfig{
+		Enabled:  true,
+		CertFile: "cert.pem",
+	}
+	err = checkWebserverConfigValues.CheckWebserverConfigValues()
+	if err == nil || err.Error() != WarningWebserverTLSCertKeyMismatch {
+		t.Error(
+			"Test failed. checkWebserverConfigValues.CheckWebserverConfigValues error",
+			err,
+		)
+	}
+	checkWebserverConfigValues.Webserver.TLS = WebserverTLSConfig{}
+
 	checkWebserverConfigValues.Webserver.ListenAddress = ":0"
 	err = checkWebserverConfigValues.CheckWebserverConfigValues()
 	if err == nil {
@@ -782,6 +898,33 @@ func TestCheckWebserverConfigValues(t *testing.T) {
 	}
 }
 
+func TestCheckGRPCConfigValues(t *testing.T) {
+	var cfg Config
+	err := cfg.LoadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. CheckGRPCConfigValues.LoadConfig error", err)
+	}
+
+	cfg.Webserver.GRPC = GRPCConfig{Enabled: true, ListenAddress: "localhost:9052"}
+	err = cfg.CheckGRPCConfigValues()
+	if err != nil {
+		t.Error("Test failed. CheckGRPCConfigValues error", err)
+	}
+
+	cfg.Webserver.GRPC.ListenAddress = "localhost"
+	err = cfg.CheckGRPCConfigValues()
+	if err == nil || err.Error() != WarningGRPCListenAddressInvalid {
+		t.Error("Test failed. CheckGRPCConfigValues invalid listen address accepted")
+	}
+
+	cfg.Webserver.GRPC.ListenAddress = "localhost:9052"
+	cfg.Webserver.AdminPassword = ""
+	err = cfg.CheckGRPCConfigValues()
+	if err == nil || err.Error() != WarningGRPCCredentialValuesEmpty {
+		t.Error("Test failed. CheckGRPCConfigValues empty credentials accepted")
+	}
+}
+
 func TestRetrieveConfigCurrencyPairs(t *testing.T) {
 	cfg := GetConfig()
 	err := cfg.LoadConfig(ConfigTestFile)
@@ -0,0 +1,342 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/forexprovider"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/currency/symbol"
+)
+
+// Severities of the problems found when validating a config, errors prevent
+// the bot from starting and warnings are problems the bot works around by
+// disabling or defaulting a setting
+const (
+	ValidationError   = "error"
+	ValidationWarning = "warning"
+)
+
+// Output formats for a validation report
+const (
+	ValidationFormatText = "text"
+	ValidationFormatJSON = "json"
+)
+
+// ValidationProblem is a problem found when validating a config, Path is the
+// config setting it relates to
+type ValidationProblem struct {
+	Severity string `json:"severity"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+}
+
+// ValidationReport holds every problem found when validating a config, the
+// config is valid when there are no errors
+type ValidationReport struct {
+	Valid    bool                `json:"valid"`
+	Errors   int                 `json:"errors"`
+	Warnings int                 `json:"warnings"`
+	Problems []ValidationProblem `json:"problems"`
+}
+
+func (r *ValidationReport) add(severity, path string, err error) {
+	if severity == ValidationError {
+		r.Errors++
+	} else {
+		r.Warnings++
+	}
+	r.Problems = append(r.Problems, ValidationProblem{
+		Severity: severity,
+		Path:     path,
+		Message:  strings.TrimPrefix(err.Error(), "WARNING -- "),
+	})
+}
+
+func (r *ValidationReport) addAll(severity, path string, errs []error) {
+	for i := range errs {
+		r.add(severity, path, errs[i])
+	}
+}
+
+// Format returns the report as human readable text or JSON
+func (r *ValidationReport) Format(format string) (string, error) {
+	switch format {
+	case ValidationFormatJSON:
+		if r.Problems == nil {
+			r.Problems = []ValidationProblem{}
+		}
+		data, err := json.MarshalIndent(r, "", " ")
+		return string(data), err
+	case ValidationFormatText, "":
+		var b bytes.Buffer
+		for i := range r.Problems {
+			fmt.Fprintf(&b, "%-7s %s: %s\n", common.StringToUpper(r.Problems[i].Severity),
+				r.Problems[i].Path, r.Problems[i].Message)
+		}
+		if r.Valid {
+			fmt.Fprintf(&b, "Config is valid with %d warnings.", r.Warnings)
+		} else {
+			fmt.Fprintf(&b, "Config is invalid with %d errors and %d warnings.",
+				r.Errors, r.Warnings)
+		}
+		return b.String(), nil
+	}
+	return "", fmt.Errorf("unknown validation format %q, expected %s or %s",
+		format, ValidationFormatText, ValidationFormatJSON)
+}
+
+// ValidateConfigFile reads a config file, decrypting it when needed, and
+// validates it without applying defaults, migrating or saving it
+func ValidateConfigFile(configPath string) (ValidationReport, error) {
+	defaultPath, err := GetFilePath(configPath)
+	if err != nil {
+		return ValidationReport{}, err
+	}
+
+	file, err := common.ReadFile(defaultPath)
+	if err != nil {
+		return ValidationReport{}, err
+	}
+
+	if ConfirmECS(file) {
+		key, err := EncryptionKey()
+		if err != nil {
+			return ValidationReport{}, err
+		}
+		if key == nil {
+			key, err = PromptForConfigKey(false)
+			if err != nil {
+				return ValidationReport{}, err
+			}
+		}
+
+		file, err = DecryptConfigFile(file, key)
+		if err != nil {
+			return ValidationReport{}, err
+		}
+	}
+
+	var cfg Config
+	err = cfg.decodeConfigFile(defaultPath, file)
+	if err != nil {
+		return ValidationReport{}, fmt.Errorf("config isn't valid %s: %s",
+			common.StringToUpper(DetectConfigFormat(defaultPath, file)), err)
+	}
+
+	if ProfileName != "" {
+		cfg.Profile = ProfileName
+	}
+	// a profile which can't be applied is reported by Validate
+	cfg.ApplyProfile("")
+	return cfg.Validate(), nil
+}
+
+// Validate runs every config check and returns all of the problems found
+// rather than stopping at the first one. The config isn't modified, checks
+// run against a migrated copy with its secret references resolved
+func (c *Config) Validate() ValidationReport {
+	var r ValidationReport
+
+	cfg, err := c.Copy()
+	if err != nil {
+		r.add(ValidationError, "config", err)
+		r.Valid = false
+		return r
+	}
+
+	pending, err := cfg.PendingMigrations()
+	if err != nil {
+		r.add(ValidationError, "version", err)
+	} else if len(pending) > 0 {
+		r.add(ValidationWarning, "version",
+			fmt.Errorf("config version %d is out of date, %d migrations will be applied when loaded",
+				cfg.Version, len(pending)))
+		cfg.Migrate()
+	}
+
+	cfg.validateSecrets(&r)
+	cfg.validateExchanges(&r)
+	r.addAll(ValidationError, "bankAccounts", clientBankAccountErrors(cfg.BankAccounts))
+	r.addAll(ValidationError, "profiles", cfg.profileErrors())
+
+	cfg.setCommunicationsDefaults()
+	r.addAll(ValidationError, "communications", cfg.communicationsErrors())
+
+	if cfg.Webserver.Enabled {
+		r.addAll(ValidationWarning, "webserver", cfg.webserverErrors())
+	}
+	if cfg.Webserver.GRPC.Enabled {
+		r.addAll(ValidationWarning, "webserver.grpc", cfg.grpcErrors())
+	}
+
+	cfg.validateCurrency(&r)
+
+	if cfg.GlobalHTTPTimeout <= 0 {
+		r.add(ValidationWarning, "globalHTTPTimeout",
+			fmt.Errorf("global HTTP timeout not set, defaults to %v", configDefaultHTTPTimeout))
+	}
+
+	r.Valid = r.Errors == 0
+	return r
+}
+
+// validateSecrets resolves each secret reference, reporting those which can't
+// be resolved
+func (c *Config) validateSecrets(r *ValidationReport) {
+	fields := c.secretFields()
+	var paths []string
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		reference := fields[path].get()
+		if !IsSecretReference(reference) {
+			continue
+		}
+
+		value, err := ResolveSecret(reference)
+		if err != nil {
+			r.add(ValidationError, path, err)
+			continue
+		}
+		fields[path].set(value)
+	}
+}
+
+func (c *Config) validateExchanges(r *ValidationReport) {
+	names := make(map[string]bool)
+	enabled := 0
+	for i := range c.Exchanges {
+		exch := &c.Exchanges[i]
+		path := "exchanges." + exch.Name
+		if exch.Name == "" {
+			path = fmt.Sprintf("exchanges[%d]", i)
+		}
+
+		name := common.StringToLower(exch.Name)
+		if name != "" && names[name] {
+			r.add(ValidationError, path, fmt.Errorf("Exchange %s: Defined more than once.", exch.Name))
+		}
+		names[name] = true
+
+		if !exch.Enabled {
+			continue
+		}
+		enabled++
+
+		r.addAll(ValidationError, path, exchangeErrors(i, exch))
+
+		for _, base := range common.SplitStrings(exch.BaseCurrencies, ",") {
+			if _, err := symbol.GetSymbolByCurrencyName(common.StringToUpper(base)); base != "" && err != nil {
+				r.add(ValidationWarning, path+".baseCurrencies",
+					fmt.Errorf("Exchange %s: Base currency %s is not a known fiat currency.", exch.Name, base))
+			}
+		}
+
+		if exch.ConfigCurrencyPairFormat == nil {
+			r.add(ValidationError, path+".configCurrencyPairFormat",
+				fmt.Errorf("Exchange %s: Config currency pair format is not set.", exch.Name))
+		} else if exch.AvailablePairs != "" && exch.EnabledPairs != "" {
+			available := validPairs(r, path+".availablePairs", exch, exch.AvailablePairs)
+			enabledPairs := validPairs(r, path+".enabledPairs", exch, exch.EnabledPairs)
+			_, unavailable := splitUnavailablePairs(available, enabledPairs)
+			for x := range unavailable {
+				r.add(ValidationWarning, path+".enabledPairs",
+					fmt.Errorf("Exchange %s: Enabled pair %s isn't an available pair and will be removed.",
+						exch.Name, unavailable[x].Pair().String()))
+			}
+		}
+
+		if exch.AuthenticatedAPISupport && !ExchangeCredentialsRegistered(exch.Name) {
+			r.add(ValidationWarning, path,
+				fmt.Errorf(WarningExchangeCredentialsNotRegistered, exch.Name))
+		}
+
+		if exch.AuthenticatedAPISupport && !hasAuthAPIValues(exch) {
+			r.add(ValidationWarning, path,
+				fmt.Errorf(WarningExchangeAuthAPIDefaultOrEmptyValues, exch.Name))
+		}
+
+		if pairsUpdateOverdue(exch) {
+			r.add(ValidationWarning, path+".pairsLastUpdated",
+				fmt.Errorf(WarningPairsLastUpdatedThresholdExceeded, exch.Name,
+					configPairsLastUpdatedWarningThreshold))
+		}
+	}
+
+	if enabled == 0 {
+		r.add(ValidationError, "exchanges", errors.New(ErrNoEnabledExchanges))
+	}
+}
+
+// validPairs returns the pairs which can be parsed with the exchange config
+// pair format, reporting those which can't
+func validPairs(r *ValidationReport, path string, exch *ExchangeConfig, pairs string) []pair.CurrencyPair {
+	format := exch.ConfigCurrencyPairFormat
+	var valid []string
+	for _, p := range common.SplitStrings(pairs, ",") {
+		var ok bool
+		switch {
+		case p == "":
+			continue
+		case format.Delimiter != "":
+			ok = len(common.SplitStrings(p, format.Delimiter)) == 2
+		case format.Index != "":
+			ok = common.StringContains(p, format.Index) && len(p) > len(format.Index)
+		default:
+			ok = len(p) > 3
+		}
+
+		if !ok {
+			r.add(ValidationError, path,
+				fmt.Errorf("Exchange %s: Pair %s doesn't match the config currency pair format.",
+					exch.Name, p))
+			continue
+		}
+		valid = append(valid, p)
+	}
+	return pair.FormatPairs(valid, format.Delimiter, format.Index)
+}
+
+func (c *Config) validateCurrency(r *ValidationReport) {
+	available := forexprovider.GetAvailableForexProviders()
+	enabled := 0
+	for i := range c.Currency.ForexProviders {
+		provider := &c.Currency.ForexProviders[i]
+		path := "currencyConfig.forexProviders." + provider.Name
+		if !common.StringDataCompare(available, provider.Name) {
+			r.add(ValidationWarning, path,
+				fmt.Errorf("%s forex provider is unknown and will be ignored", provider.Name))
+			continue
+		}
+
+		if !provider.Enabled {
+			continue
+		}
+
+		usable, errs := forexProviderErrors(provider)
+		r.addAll(ValidationWarning, path, errs)
+		if usable {
+			enabled++
+		}
+	}
+
+	if enabled == 0 {
+		r.add(ValidationWarning, "currencyConfig.forexProviders",
+			errors.New(WarningNoForexProvidersEnabled))
+	}
+
+	fiat := c.Currency.FiatDisplayCurrency
+	if _, err := symbol.GetSymbolByCurrencyName(common.StringToUpper(fiat)); fiat != "" && err != nil {
+		r.add(ValidationWarning, "currencyConfig.fiatDisplayCurrency",
+			fmt.Errorf("Fiat display currency %s is not a known fiat currency", fiat))
+	}
+}
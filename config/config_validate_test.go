@@ -0,0 +1,125 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func hasProblem(r ValidationReport, severity, path string) bool {
+	for i := range r.Problems {
+		if r.Problems[i].Severity == severity && r.Problems[i].Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidate(t *testing.T) {
+	var cfg Config
+	err := cfg.ReadConfig(ConfigTestFile)
+	if err != nil {
+		t.Fatal("Test failed. ReadConfig error", err)
+	}
+
+	report := cfg.Validate()
+	if !report.Valid || report.Errors != 0 {
+		t.Error("Test failed. Validate unexpected errors", report.Problems)
+	}
+
+	for i := range cfg.Exchanges {
+		if cfg.Exchanges[i].Name == "Bitfinex" {
+			cfg.Exchanges[i].Enabled = true
+			cfg.Exchanges[i].EnabledPairs = ""
+			cfg.Exchanges[i].BaseCurrencies = "USD,LOL"
+			cfg.Exchanges[i].APIKey = "env:GCT_TEST_VALIDATE_UNSET"
+		}
+	}
+	dup := cfg.Exchanges[0]
+	dup.Enabled = false
+	cfg.Exchanges = append(cfg.Exchanges, dup)
+
+	cfg.Version = CurrentConfigVersion + 1
+	cfg.BankAccounts = []BankAccount{{BankName: "test", Enabled: true}}
+	cfg.Communications.SlackConfig.Enabled = true
+	cfg.Communications.SlackConfig.VerificationToken = ""
+	cfg.Communications.TelegramConfig.Enabled = true
+	cfg.Communications.TelegramConfig.VerificationToken = ""
+	cfg.Webserver.Enabled = true
+	cfg.Webserver.ListenAddress = "LOLOLOL"
+	cfg.Webserver.AdminPassword = ""
+
+	report = cfg.Validate()
+	if report.Valid {
+		t.Error("Test failed. Validate accepted an invalid config")
+	}
+
+	for _, p := range []struct {
+		severity, path string
+	}{
+		{ValidationError, "version"},
+		{ValidationError, "exchanges.Bitfinex.apiKey"},
+		{ValidationError, "exchanges.Bitfinex"},
+		{ValidationWarning, "exchanges.Bitfinex.baseCurrencies"},
+		{ValidationError, "exchanges." + dup.Name},
+		{ValidationError, "bankAccounts"},
+		{ValidationError, "communications"},
+		{ValidationWarning, "webserver"},
+	} {
+		if !hasProblem(report, p.severity, p.path) {
+			t.Errorf("Test failed. Validate missing %s for %s", p.severity, p.path)
+		}
+	}
+
+	comms := 0
+	webserver := 0
+	for i := range report.Problems {
+		switch report.Problems[i].Path {
+		case "communications":
+			comms++
+		case "webserver":
+			webserver++
+		}
+	}
+	if comms != 2 || webserver != 2 {
+		t.Errorf("Test failed. Validate expected every problem, got %d communications and %d webserver",
+			comms, webserver)
+	}
+
+	exch, err := cfg.GetExchangeConfig("Bitfinex")
+	if err != nil || exch.APIKey != "env:GCT_TEST_VALIDATE_UNSET" ||
+		cfg.Version != CurrentConfigVersion+1 {
+		t.Error("Test failed. Validate modified the config")
+	}
+}
+
+func TestValidationReportFormat(t *testing.T) {
+	var report ValidationReport
+	report.add(ValidationError, "webserver", errors.New("WARNING -- bad listen address"))
+	report.Valid = report.Errors == 0
+
+	text, err := report.Format(ValidationFormatText)
+	if err != nil {
+		t.Fatal("Test failed. Format error", err)
+	}
+	if !strings.Contains(text, "ERROR   webserver: bad listen address") ||
+		!strings.Contains(text, "invalid with 1 errors") {
+		t.Error("Test failed. Format unexpected text", text)
+	}
+
+	data, err := report.Format(ValidationFormatJSON)
+	if err != nil {
+		t.Fatal("Test failed. Format error", err)
+	}
+	var decoded ValidationReport
+	err = json.Unmarshal([]byte(data), &decoded)
+	if err != nil || decoded.Valid || len(decoded.Problems) != 1 {
+		t.Error("Test failed. Format unexpected JSON", data)
+	}
+
+	_, err = report.Format("xml")
+	if err == nil {
+		t.Error("Test failed. Format accepted an unknown format")
+	}
+}
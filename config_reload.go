@@ -0,0 +1,343 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency"
+	"github.com/thrasher-/gocryptotrader/currency/forexprovider"
+)
+
+// configWatchInterval is how often the config file is checked for changes
+const configWatchInterval = time.Second * 5
+
+// ErrConfigEncrypted is returned when reloading an encrypted config file
+// without a non-interactive encryption key, which would require prompting for
+// the decryption key
+var ErrConfigEncrypted = errors.New("encrypted config files cannot be reloaded")
+
+// configReloadMtx serialises config reloads from the watcher and the APIs
+var configReloadMtx sync.Mutex
+
+// configFileState identifies a version of the config file
+type configFileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// getConfigFileState returns the state of the config file and the files it
+// includes, using the latest modification time and the total size
+func getConfigFileState() configFileState {
+	path, err := config.GetFilePath(bot.configFile)
+	if err != nil {
+		return configFileState{}
+	}
+
+	bot.configMtx.RLock()
+	files := append([]string{path}, bot.config.IncludedFiles()...)
+	bot.configMtx.RUnlock()
+
+	var state configFileState
+	for i := range files {
+		info, err := os.Stat(files[i])
+		if err != nil {
+			return configFileState{}
+		}
+		if info.ModTime().After(state.modTime) {
+			state.modTime = info.ModTime()
+		}
+		state.size += info.Size()
+	}
+	return state
+}
+
+// StartConfigWatcher reloads the config when the config file or a file it
+// includes changes or the bot receives SIGHUP
+func StartConfigWatcher() {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	ticker := time.NewTicker(configWatchInterval)
+	defer ticker.Stop()
+
+	last := getConfigFileState()
+	log.Println("Config watcher started.")
+	for {
+		select {
+		case <-sighup:
+			log.Println("Config reload: captured SIGHUP, reloading config..")
+		case <-ticker.C:
+			current := getConfigFileState()
+			if current == last {
+				continue
+			}
+			last = current
+			log.Println("Config reload: config file changed, reloading config..")
+		}
+
+		err := ReloadConfig()
+		if err != nil {
+			log.Printf("Config reload: failed to reload config. Err: %s", err)
+		}
+	}
+}
+
+// ReloadConfig loads the config file and applies any changes to the running
+// bot, the running config is left unchanged if the file fails to load
+func ReloadConfig() error {
+	path, err := config.GetFilePath(bot.configFile)
+	if err != nil {
+		return err
+	}
+
+	file, err := common.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if config.ConfirmECS(file) {
+		key, err := config.EncryptionKey()
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return ErrConfigEncrypted
+		}
+	}
+
+	var newCfg config.Config
+	err = newCfg.LoadConfig(path)
+	if err != nil {
+		return err
+	}
+
+	configReloadMtx.Lock()
+	defer configReloadMtx.Unlock()
+
+	bot.configMtx.Lock()
+	oldCfg, err := bot.config.Copy()
+	if err != nil {
+		bot.configMtx.Unlock()
+		return err
+	}
+
+	bot.config.Replace(&newCfg)
+	applyProfileDryRun()
+	changes := config.DiffConfig(&oldCfg, bot.config)
+	bot.configMtx.Unlock()
+
+	ApplyConfigChanges(changes)
+	return nil
+}
+
+// getWebserverConfig returns the webserver config of the running bot
+func getWebserverConfig() config.WebserverConfig {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+	return bot.config.Webserver
+}
+
+// getFiatDisplayCurrency returns the fiat display currency of the running bot
+func getFiatDisplayCurrency() string {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+	return bot.config.Currency.FiatDisplayCurrency
+}
+
+// SaveAndApplyConfig saves a config received through the APIs then applies
+// any changes to the running bot
+func SaveAndApplyConfig(newCfg config.Config) error {
+	configReloadMtx.Lock()
+	defer configReloadMtx.Unlock()
+
+	bot.configMtx.Lock()
+	oldCfg, err := bot.config.Copy()
+	if err == nil {
+		err = bot.config.UpdateConfig(bot.configFile, newCfg)
+	}
+	if err != nil {
+		bot.configMtx.Unlock()
+		return err
+	}
+
+	applyProfileDryRun()
+	changes := config.DiffConfig(&oldCfg, bot.config)
+	bot.configMtx.Unlock()
+
+	ApplyConfigChanges(changes)
+	return nil
+}
+
+// applyProfileDryRun enables dry run mode when the active config profile
+// requests it, it must be called with the config locked. Dry run mode is
+// never disabled by a reload as it may have been set by the -dryrun flag
+func applyProfileDryRun() {
+	profile, ok := bot.config.ActiveProfile()
+	if !ok || !profile.DryRun || bot.dryRun {
+		return
+	}
+	bot.dryRun = true
+	log.Printf("Config reload: dry run mode enabled by config profile %s.", profile.Name)
+}
+
+// ValidateConfig validates the config file and prints the report, returning
+// the exit code for the validate mode
+func ValidateConfig(configPath, format string) int {
+	report, err := config.ValidateConfigFile(configPath)
+	if err != nil {
+		log.Printf("Failed to validate config. Err: %s", err)
+		return 1
+	}
+
+	output, err := report.Format(format)
+	if err != nil {
+		log.Printf("Failed to validate config. Err: %s", err)
+		return 1
+	}
+
+	fmt.Println(output)
+	if !report.Valid {
+		return 1
+	}
+	return 0
+}
+
+// ApplyConfigChanges applies config changes to each subsystem of the running
+// bot, logging every change applied
+func ApplyConfigChanges(changes config.Changes) {
+	if changes.IsEmpty() {
+		log.Println("Config reload: no changes to apply.")
+		return
+	}
+
+	applyExchangeChanges(changes)
+
+	if bot.comms != nil {
+		if len(changes.CommsMediums) > 0 {
+			enabled := bot.comms.Reload(bot.config.GetCommunicationsConfig(),
+				changes.CommsMediums)
+			for _, medium := range changes.CommsMediums {
+				if common.StringDataCompare(enabled, medium) {
+					log.Printf("Config reload: %s communications medium restarted.", medium)
+				} else {
+					log.Printf("Config reload: %s communications medium disabled.", medium)
+				}
+			}
+		}
+
+		if changes.AlertRules {
+			base.SetAlertRules(bot.config.Communications.AlertRules)
+			log.Println("Config reload: communications alert rules updated.")
+		}
+	}
+
+	if changes.ForexProviders {
+		currency.SetForexProviders(forexprovider.StartFXService(
+			bot.config.GetCurrencyConfig().ForexProviders))
+		log.Printf("Config reload: forex providers updated, primary provider: %s.",
+			bot.config.GetPrimaryForexProvider())
+	}
+
+	if changes.FiatDisplayCurrency {
+		currency.SetBaseCurrency(bot.config.Currency.FiatDisplayCurrency)
+		log.Printf("Config reload: fiat display currency set to %s.",
+			bot.config.Currency.FiatDisplayCurrency)
+	}
+
+	if changes.ForexProviders || changes.FiatDisplayCurrency {
+		err := currency.SeedCurrencyData(common.JoinStrings(currency.FiatCurrencies, ","))
+		if err != nil {
+			log.Printf("Config reload: unable to fetch forex data. Err: %s", err)
+		}
+	}
+
+	if changes.GlobalHTTPTimeout {
+		common.HTTPClient = common.NewHTTPClientWithTimeout(bot.config.GlobalHTTPTimeout)
+		log.Printf("Config reload: global HTTP request timeout set to %v.",
+			bot.config.GlobalHTTPTimeout)
+	}
+
+	if changes.Portfolio && bot.portfolio != nil {
+		bot.portfolio.SeedPortfolio(bot.config.Portfolio)
+		log.Printf("Config reload: portfolio updated with %d addresses.",
+			len(bot.config.Portfolio.Addresses))
+	}
+
+	for _, setting := range changes.RestartRequired {
+		log.Printf("Config reload: %s settings changed, restart the bot to apply them.",
+			setting)
+	}
+}
+
+func applyExchangeChanges(changes config.Changes) {
+	for _, name := range changes.ExchangesDisabled {
+		err := removeExchange(name)
+		if err != nil && err != ErrExchangeNotFound {
+			log.Printf("Config reload: failed to unload %s exchange. Err: %s", name, err)
+			continue
+		}
+		log.Printf("Config reload: %s exchange unloaded.", name)
+	}
+
+	var wg sync.WaitGroup
+	for _, name := range changes.ExchangesEnabled {
+		err := LoadExchange(name, true, &wg)
+		if err != nil {
+			log.Printf("Config reload: failed to load %s exchange. Err: %s", name, err)
+			continue
+		}
+		log.Printf("Config reload: %s exchange loaded.", name)
+	}
+	wg.Wait()
+
+	for _, name := range changes.ExchangesUpdated {
+		err := ReloadExchange(name)
+		if err != nil {
+			log.Printf("Config reload: failed to reload %s exchange. Err: %s", name, err)
+			continue
+		}
+		log.Printf("Config reload: %s exchange settings updated.", name)
+	}
+
+	updated := make(map[string]bool)
+	for name := range changes.PairsEnabled {
+		updated[name] = true
+	}
+	for name := range changes.PairsDisabled {
+		updated[name] = true
+	}
+
+	for name := range updated {
+		exch := GetExchangeByName(name)
+		if exch == nil {
+			continue
+		}
+
+		pairs, err := bot.config.GetEnabledPairs(name)
+		if err == nil {
+			err = exch.SetCurrencies(pairs, true)
+		}
+		if err != nil {
+			log.Printf("Config reload: failed to update %s enabled pairs. Err: %s", name, err)
+			continue
+		}
+
+		if len(changes.PairsEnabled[name]) > 0 {
+			log.Printf("Config reload: %s enabled pairs added: %s.", name,
+				common.JoinStrings(changes.PairsEnabled[name], ","))
+		}
+		if len(changes.PairsDisabled[name]) > 0 {
+			log.Printf("Config reload: %s enabled pairs removed: %s.", name,
+				common.JoinStrings(changes.PairsDisabled[name], ","))
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+func TestReloadConfig(t *testing.T) {
+	SetupTest(t)
+
+	dir, err := ioutil.TempDir("", "gct-reload")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	savedCfg, err := bot.config.Copy()
+	if err != nil {
+		t.Fatal("Test failed. Copy error", err)
+	}
+	savedFile := bot.configFile
+	defer func() {
+		*bot.config = savedCfg
+		bot.configFile = savedFile
+		ReloadExchange("Bitfinex")
+	}()
+
+	// earlier tests may have unloaded Bitfinex in the config
+	exchCfg, err := bot.config.GetExchangeConfig("Bitfinex")
+	if err != nil {
+		t.Fatal("Test failed. GetExchangeConfig error", err)
+	}
+	exchCfg.Enabled = true
+	bot.config.UpdateExchangeConfig(exchCfg)
+
+	bot.configFile = filepath.Join(dir, "config.json")
+	newCfg, _ := bot.config.Copy()
+	for i := range newCfg.Exchanges {
+		if newCfg.Exchanges[i].Name == "Bitfinex" {
+			newCfg.Exchanges[i].EnabledPairs = "BTCUSD,LTCUSD"
+		}
+	}
+	err = newCfg.SaveConfig(bot.configFile)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig error", err)
+	}
+
+	state := getConfigFileState()
+	if state.size == 0 {
+		t.Error("Test failed. getConfigFileState did not stat the config file")
+	}
+
+	err = ReloadConfig()
+	if err != nil {
+		t.Fatal("Test failed. ReloadConfig error", err)
+	}
+
+	pairs := GetExchangeByName("Bitfinex").GetEnabledCurrencies()
+	if len(pairs) != 2 {
+		t.Error("Test failed. ReloadConfig did not update the enabled pairs", pairs)
+	}
+
+	err = common.WriteFile(bot.configFile, []byte(config.EncryptConfirmString))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+	if ReloadConfig() != ErrConfigEncrypted {
+		t.Error("Test failed. ReloadConfig reloaded an encrypted config")
+	}
+
+	err = common.WriteFile(bot.configFile, []byte("{"))
+	if err != nil {
+		t.Fatal("Test failed. WriteFile error", err)
+	}
+	if ReloadConfig() == nil {
+		t.Error("Test failed. ReloadConfig accepted an invalid config")
+	}
+	pairs = GetExchangeByName("Bitfinex").GetEnabledCurrencies()
+	if len(pairs) != 2 {
+		t.Error("Test failed. ReloadConfig changed the running config after a failure")
+	}
+}
+
+func TestReloadConfigProfileDryRun(t *testing.T) {
+	SetupTest(t)
+
+	dir, err := ioutil.TempDir("", "gct-reload")
+	if err != nil {
+		t.Fatal("Test failed. TempDir error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	savedCfg, err := bot.config.Copy()
+	if err != nil {
+		t.Fatal("Test failed. Copy error", err)
+	}
+	savedFile, savedDryRun := bot.configFile, bot.dryRun
+	defer func() {
+		*bot.config = savedCfg
+		bot.configFile, bot.dryRun = savedFile, savedDryRun
+	}()
+
+	bot.dryRun = false
+	bot.configFile = filepath.Join(dir, "config.json")
+	newCfg, _ := bot.config.Copy()
+	newCfg.Profiles = []config.ProfileConfig{{Name: "paper", DryRun: true}}
+	newCfg.Profile = "paper"
+	err = newCfg.SaveConfig(bot.configFile)
+	if err != nil {
+		t.Fatal("Test failed. SaveConfig error", err)
+	}
+
+	err = ReloadConfig()
+	if err != nil {
+		t.Fatal("Test failed. ReloadConfig error", err)
+	}
+
+	if !bot.dryRun {
+		t.Error("Test failed. ReloadConfig did not apply the profile dry run mode")
+	}
+}
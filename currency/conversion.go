@@ -0,0 +1,167 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/thrasher-/gocryptotrader/currency/registry"
+	"github.com/thrasher-/gocryptotrader/exchanges/stats"
+)
+
+// Conversion sources used by steps which don't come from an exchange market
+const (
+	ConversionSourceForex = "forex"
+	ConversionSourcePeg   = "peg"
+)
+
+// Weights of the conversion graph edges, pegs are only assumed so a market or
+// forex rate of the same length is preferred
+const (
+	rateWeight = 1.0
+	pegWeight  = 1.5
+)
+
+// ConversionStep is a single rate along a conversion path, the source is the
+// exchange the rate came from, forex or peg
+type ConversionStep struct {
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+	Rate   float64 `json:"rate"`
+	Source string  `json:"source"`
+}
+
+type conversionEdge struct {
+	to     string
+	rate   float64
+	source string
+	weight float64
+}
+
+// conversionGraph maps a currency to the rates it can be converted with
+type conversionGraph map[string][]conversionEdge
+
+func (g conversionGraph) add(from, to string, rate float64, source string, weight float64) {
+	if from == "" || to == "" || from == to || rate <= 0 {
+		return
+	}
+	g[from] = append(g[from], conversionEdge{to, rate, source, weight})
+	g[to] = append(g[to], conversionEdge{from, 1 / rate, source, weight})
+}
+
+// buildConversionGraph returns the conversion graph of the current forex
+// rates, stablecoin pegs and the most liquid exchange of every market tracked
+// by the stats package, built from snapshots so the routines can keep
+// updating them
+func buildConversionGraph() conversionGraph {
+	graph := make(conversionGraph)
+
+	for key, rate := range GetExchangeRates() {
+		if len(key) != 6 {
+			continue
+		}
+		graph.add(key[0:3], key[3:], rate, ConversionSourceForex, rateWeight)
+	}
+
+	for _, c := range registry.GetCurrencies(registry.Stablecoin) {
+		graph.add(c.Code, c.PeggedTo, 1, ConversionSourcePeg, pegWeight)
+	}
+
+	markets := make(map[string]stats.Item)
+	for _, item := range stats.GetItems() {
+		if item.Price <= 0 || item.Volume <= 0 {
+			continue
+		}
+
+		item.Pair = registry.CanonicalPair(item.Exchange, item.Pair)
+		key := item.Pair.FirstCurrency.String() + "/" + item.Pair.SecondCurrency.String()
+		if liquid, ok := markets[key]; ok && liquid.Volume >= item.Volume {
+			continue
+		}
+		markets[key] = item
+	}
+
+	for _, item := range markets {
+		graph.add(item.Pair.FirstCurrency.String(), item.Pair.SecondCurrency.String(),
+			item.Price, item.Exchange, rateWeight)
+	}
+	return graph
+}
+
+// FindConversionPath returns the rates needed to convert from one currency to
+// another through forex rates and exchange markets, the path with the fewest
+// conversions is used
+func FindConversionPath(from, to string) ([]ConversionStep, error) {
+	from = registry.Canonical("", from)
+	to = registry.Canonical("", to)
+	if from == to {
+		return nil, nil
+	}
+
+	graph := buildConversionGraph()
+	distance := map[string]float64{from: 0}
+	previous := make(map[string]ConversionStep)
+	visited := make(map[string]bool)
+
+	for {
+		current := ""
+		for code, d := range distance {
+			if visited[code] {
+				continue
+			}
+			if current == "" || d < distance[current] || d == distance[current] && code < current {
+				current = code
+			}
+		}
+
+		if current == "" {
+			return nil, fmt.Errorf("Currency conversion failed. Unable to find a conversion path [%s -> %s]", from, to)
+		}
+
+		if current == to {
+			break
+		}
+		visited[current] = true
+
+		for _, edge := range graph[current] {
+			d := distance[current] + edge.weight
+			if existing, ok := distance[edge.to]; ok && existing <= d {
+				continue
+			}
+			distance[edge.to] = d
+			previous[edge.to] = ConversionStep{
+				From:   current,
+				To:     edge.to,
+				Rate:   edge.rate,
+				Source: edge.source,
+			}
+		}
+	}
+
+	var path []ConversionStep
+	for code := to; code != from; code = previous[code].From {
+		path = append([]ConversionStep{previous[code]}, path...)
+	}
+	return path, nil
+}
+
+// ConvertCrossRate converts an amount between any two currencies. Fiat
+// currencies are converted with the forex rates, other currencies or fiat
+// currencies missing from the forex rates are converted along the conversion
+// path, for example XMR to BTC to USD to AUD
+func ConvertCrossRate(amount float64, from, to string) (float64, error) {
+	if IsFiatCurrency(fiatCode(from)) && IsFiatCurrency(fiatCode(to)) {
+		conv, err := ConvertCurrency(amount, from, to)
+		if err == nil {
+			return conv, nil
+		}
+	}
+
+	path, err := FindConversionPath(from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	for x := range path {
+		amount *= path[x].Rate
+	}
+	return amount, nil
+}
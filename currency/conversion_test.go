@@ -0,0 +1,96 @@
+package currency
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/forexprovider"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/stats"
+)
+
+func setupConversionTest() func() {
+	backupRates, backupItems, backupProviders := FXRates, stats.Items, FXProviders
+
+	FXProviders = forexprovider.NewDefaultFXProvider()
+	FXRates = map[string]float64{"USDAUD": 1.4, "USDEUR": 0.9}
+	stats.Items = []stats.Item{
+		{Exchange: "Bittrex", Pair: pair.NewCurrencyPair("XMR", "BTC"), AssetType: "SPOT", Price: 0.02, Volume: 50},
+		{Exchange: "Poloniex", Pair: pair.NewCurrencyPair("XMR", "BTC"), AssetType: "SPOT", Price: 0.025, Volume: 100},
+		{Exchange: "Kraken", Pair: pair.NewCurrencyPair("XBT", "USD"), AssetType: "SPOT", Price: 4000, Volume: 1000},
+		{Exchange: "Binance", Pair: pair.NewCurrencyPair("LTC", "USDT"), AssetType: "SPOT", Price: 30, Volume: 500},
+	}
+
+	return func() {
+		FXRates, stats.Items, FXProviders = backupRates, backupItems, backupProviders
+	}
+}
+
+func TestFindConversionPath(t *testing.T) {
+	defer setupConversionTest()()
+
+	path, err := FindConversionPath("XMR", "AUD")
+	if err != nil {
+		t.Fatal("Test failed. FindConversionPath error", err)
+	}
+
+	if len(path) != 3 {
+		t.Fatalf("Test failed. FindConversionPath unexpected path %v", path)
+	}
+
+	if path[0].To != "BTC" || path[0].Source != "Poloniex" ||
+		path[1].To != "USD" || path[1].Source != "Kraken" ||
+		path[2].To != "AUD" || path[2].Source != ConversionSourceForex {
+		t.Errorf("Test failed. FindConversionPath unexpected path %v", path)
+	}
+
+	path, err = FindConversionPath("LTC", "EUR")
+	if err != nil {
+		t.Fatal("Test failed. FindConversionPath error", err)
+	}
+
+	if len(path) != 3 || path[1].Source != ConversionSourcePeg {
+		t.Errorf("Test failed. FindConversionPath unexpected path %v", path)
+	}
+
+	path, err = FindConversionPath("BTC", "XBT")
+	if err != nil || len(path) != 0 {
+		t.Error("Test failed. FindConversionPath returned a path between aliases")
+	}
+
+	_, err = FindConversionPath("XMR", "birds123")
+	if err == nil {
+		t.Error("Test failed. FindConversionPath found a path to an unknown currency")
+	}
+}
+
+func TestConvertCrossRate(t *testing.T) {
+	defer setupConversionTest()()
+
+	result, err := ConvertCrossRate(10, "XMR", "AUD")
+	if err != nil {
+		t.Fatal("Test failed. ConvertCrossRate error", err)
+	}
+
+	if expected := 10 * 0.025 * 4000 * 1.4; math.Abs(result-expected) > 1e-9 {
+		t.Errorf("Test failed. ConvertCrossRate expected %f, got %f", expected, result)
+	}
+
+	result, err = ConvertCrossRate(1.4, "AUD", "USD")
+	if err != nil {
+		t.Fatal("Test failed. ConvertCrossRate error", err)
+	}
+
+	if math.Abs(result-1) > 1e-9 {
+		t.Errorf("Test failed. ConvertCrossRate expected 1, got %f", result)
+	}
+
+	result, err = ConvertCrossRate(4000, "USD", "BTC")
+	if err != nil {
+		t.Fatal("Test failed. ConvertCrossRate error", err)
+	}
+
+	if math.Abs(result-1) > 1e-9 {
+		t.Errorf("Test failed. ConvertCrossRate expected 1, got %f", result)
+	}
+}
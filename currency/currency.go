@@ -3,10 +3,13 @@ package currency
 import (
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/currency/registry"
 )
 
 const (
@@ -29,13 +32,23 @@ var (
 	FXProviders  *forexprovider.ForexProviders
 )
 
+// fxLastUpdated is when the forex rates were last fetched successfully
+var fxLastUpdated time.Time
+
+// fxMtx guards the forex rates, providers, base currency and last update time
+// while the bot reloads them. FXRates is never modified once set, updates
+// replace the map
+var fxMtx sync.RWMutex
+
 // SetDefaults sets the default currency provider and settings for
 // currency conversion used outside of the bot setting
 func SetDefaults() {
+	fxMtx.Lock()
 	FXRates = make(map[string]float64)
 	BaseCurrency = DefaultBaseCurrency
-
 	FXProviders = forexprovider.NewDefaultFXProvider()
+	fxMtx.Unlock()
+
 	err := SeedCurrencyData(DefaultCurrencies)
 	if err != nil {
 		log.Printf("Failed to seed currency data. Err: %s", err)
@@ -43,30 +56,70 @@ func SetDefaults() {
 	}
 }
 
+// SetForexProviders replaces the forex providers used to fetch rates
+func SetForexProviders(providers *forexprovider.ForexProviders) {
+	fxMtx.Lock()
+	FXProviders = providers
+	fxMtx.Unlock()
+}
+
+// SetBaseCurrency sets the currency forex rates are fetched against
+func SetBaseCurrency(currency string) {
+	fxMtx.Lock()
+	BaseCurrency = currency
+	fxMtx.Unlock()
+}
+
 // SeedCurrencyData returns rates correlated with suported currencies
 func SeedCurrencyData(currencies string) error {
-	if FXRates == nil {
-		FXRates = make(map[string]float64)
-	}
-
+	fxMtx.Lock()
 	if FXProviders == nil {
 		FXProviders = forexprovider.NewDefaultFXProvider()
 	}
+	providers, baseCurrency := FXProviders, BaseCurrency
+	fxMtx.Unlock()
 
-	newRates, err := FXProviders.GetCurrencyData(BaseCurrency, currencies)
+	newRates, err := providers.GetCurrencyData(baseCurrency, currencies)
 	if err != nil {
 		return err
 	}
 
+	fxMtx.Lock()
+	defer fxMtx.Unlock()
+
+	rates := make(map[string]float64, len(FXRates)+len(newRates))
+	for key, value := range FXRates {
+		rates[key] = value
+	}
 	for key, value := range newRates {
-		FXRates[key] = value
+		rates[key] = value
 	}
 
+	FXRates = rates
+	fxLastUpdated = time.Now()
 	return nil
 }
 
-// GetExchangeRates returns the currency exchange rates
+// GetFXLastUpdated returns when the forex rates were last fetched
+// successfully, the zero time is returned before the first fetch
+func GetFXLastUpdated() time.Time {
+	fxMtx.RLock()
+	defer fxMtx.RUnlock()
+	return fxLastUpdated
+}
+
+// SetFXLastUpdated sets when the forex rates were last fetched
+func SetFXLastUpdated(lastUpdated time.Time) {
+	fxMtx.Lock()
+	fxLastUpdated = lastUpdated
+	fxMtx.Unlock()
+}
+
+// GetExchangeRates returns the currency exchange rates, the returned map must
+// not be modified
 func GetExchangeRates() map[string]float64 {
+	fxMtx.RLock()
+	defer fxMtx.RUnlock()
 	return FXRates
 }
 
@@ -84,14 +137,16 @@ func IsDefaultCryptocurrency(currency string) bool {
 	return common.StringDataCompare(cryptoCurrencies, common.StringToUpper(currency))
 }
 
-// IsFiatCurrency checks if the currency passed is an enabled fiat currency
+// IsFiatCurrency checks if the currency passed is a fiat currency in the
+// currency registry
 func IsFiatCurrency(currency string) bool {
-	return common.StringDataCompare(FiatCurrencies, common.StringToUpper(currency))
+	return registry.IsFiat(currency)
 }
 
-// IsCryptocurrency checks if the currency passed is an enabled CRYPTO currency.
+// IsCryptocurrency checks if the currency passed is a cryptocurrency or
+// stablecoin in the currency registry
 func IsCryptocurrency(currency string) bool {
-	return common.StringDataCompare(CryptoCurrencies, common.StringToUpper(currency))
+	return registry.IsCrypto(currency)
 }
 
 // IsCryptoPair checks to see if the pair is a crypto pair e.g. BTCLTC
@@ -112,14 +167,17 @@ func IsFiatPair(p pair.CurrencyPair) bool {
 		IsFiatCurrency(p.SecondCurrency.String())
 }
 
-// Update updates the local crypto currency or base currency store
+// Update updates the local crypto currency or base currency store, currencies
+// unknown to the currency registry are registered with their class
 func Update(input []string, cryptos bool) {
 	for x := range input {
 		if cryptos {
+			registry.RegisterIfUnknown(input[x], registry.Crypto)
 			if !common.StringDataCompare(CryptoCurrencies, input[x]) {
 				CryptoCurrencies = append(CryptoCurrencies, common.StringToUpper(input[x]))
 			}
 		} else {
+			registry.RegisterIfUnknown(input[x], registry.Fiat)
 			if !common.StringDataCompare(FiatCurrencies, input[x]) {
 				FiatCurrencies = append(FiatCurrencies, common.StringToUpper(input[x]))
 			}
@@ -127,8 +185,23 @@ func Update(input []string, cryptos bool) {
 	}
 }
 
+// fiatCode returns the canonical code of a currency, stablecoins are converted
+// at par with the fiat currency they track
+func fiatCode(currency string) string {
+	currency = registry.Canonical("", currency)
+	if peg, ok := registry.GetPeg(currency); ok {
+		return peg
+	}
+	return currency
+}
+
 func extractBaseCurrency() string {
-	for k := range FXRates {
+	return baseCurrencyOf(GetExchangeRates())
+}
+
+// baseCurrencyOf returns the currency the forex rates are quoted against
+func baseCurrencyOf(rates map[string]float64) string {
+	for k := range rates {
 		return k[0:3]
 	}
 	return ""
@@ -137,39 +210,36 @@ func extractBaseCurrency() string {
 // ConvertCurrency for example converts $1 USD to the equivalent Japanese Yen
 // or vice versa.
 func ConvertCurrency(amount float64, from, to string) (float64, error) {
-	if FXProviders == nil {
+	fxMtx.RLock()
+	providers := FXProviders
+	fxMtx.RUnlock()
+	if providers == nil {
 		SetDefaults()
 	}
 
-	from = common.StringToUpper(from)
-	to = common.StringToUpper(to)
+	from = fiatCode(from)
+	to = fiatCode(to)
 
 	if from == to {
 		return amount, nil
 	}
 
-	if from == "RUR" {
-		from = "RUB"
-	}
-
-	if to == "RUR" {
-		to = "RUB"
-	}
-
-	if len(FXRates) == 0 {
+	rates := GetExchangeRates()
+	if len(rates) == 0 {
 		SeedCurrencyData(from + "," + to)
+		rates = GetExchangeRates()
 	}
 
 	// Need to extract the base currency to see if we actually got it from the Forex API
 	// Fixer free API sets the base currency to EUR
-	baseCurr := extractBaseCurrency()
+	baseCurr := baseCurrencyOf(rates)
 
 	var resultFrom float64
 	var resultTo float64
 
 	// check to see if we're converting from the base currency
 	if to == baseCurr {
-		resultFrom, ok := FXRates[baseCurr+from]
+		resultFrom, ok := rates[baseCurr+from]
 		if !ok {
 			return 0, fmt.Errorf("Currency conversion failed. Unable to find %s in currency map [%s -> %s]", from, from, to)
 		}
@@ -178,7 +248,7 @@ func ConvertCurrency(amount float64, from, to string) (float64, error) {
 
 	// Check to see if we're converting from the base currency
 	if from == baseCurr {
-		resultTo, ok := FXRates[baseCurr+to]
+		resultTo, ok := rates[baseCurr+to]
 		if !ok {
 			return 0, fmt.Errorf("Currency conversion failed. Unable to find %s in currency map [%s -> %s]", to, from, to)
 		}
@@ -186,13 +256,13 @@ func ConvertCurrency(amount float64, from, to string) (float64, error) {
 	}
 
 	// Otherwise convert to base currency, then to the target currency
-	resultFrom, ok := FXRates[baseCurr+from]
+	resultFrom, ok := rates[baseCurr+from]
 	if !ok {
 		return 0, fmt.Errorf("Currency conversion failed. Unable to find %s in currency map [%s -> %s]", from, from, to)
 	}
 
 	converted := amount / resultFrom
-	resultTo, ok = FXRates[baseCurr+to]
+	resultTo, ok = rates[baseCurr+to]
 	if !ok {
 		return 0, fmt.Errorf("Currency conversion failed. Unable to find %s in currency map [%s -> %s]", to, from, to)
 	}
@@ -2,6 +2,7 @@ package currency
 
 import (
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 )
@@ -46,6 +47,18 @@ func TestGetExchangeRates(t *testing.T) {
 	FXRates = backup
 }
 
+func TestGetFXLastUpdated(t *testing.T) {
+	backup := GetFXLastUpdated()
+
+	lastUpdated := time.Now()
+	SetFXLastUpdated(lastUpdated)
+	if !GetFXLastUpdated().Equal(lastUpdated) {
+		t.Error("Test failed. GetFXLastUpdated returned an unexpected time")
+	}
+
+	SetFXLastUpdated(backup)
+}
+
 func TestIsDefaultCurrency(t *testing.T) {
 	t.Parallel()
 
@@ -254,3 +267,17 @@ func TestConvertCurrency(t *testing.T) {
 	}
 
 }
+
+func TestFiatCode(t *testing.T) {
+	if fiatCode("rur") != "RUB" {
+		t.Error("Test failed. fiatCode did not convert RUR to RUB")
+	}
+
+	if fiatCode("USDT") != "USD" {
+		t.Error("Test failed. fiatCode did not convert USDT to USD")
+	}
+
+	if fiatCode("AUD") != "AUD" {
+		t.Error("Test failed. fiatCode changed AUD")
+	}
+}
@@ -0,0 +1,175 @@
+package registry
+
+// defaultCurrencies are registered on startup, currencies enabled in the
+// config which aren't listed here are registered with only their code and
+// class
+var defaultCurrencies = []Currency{
+	// Fiat currencies
+	{Code: "USD", Name: "US Dollar", Class: Fiat, Decimals: 2},
+	{Code: "EUR", Name: "Euro", Class: Fiat, Decimals: 2},
+	{Code: "AUD", Name: "Australian Dollar", Class: Fiat, Decimals: 2},
+	{Code: "CNY", Name: "Chinese Yuan", Class: Fiat, Decimals: 2},
+	{Code: "JPY", Name: "Japanese Yen", Class: Fiat, Decimals: 0},
+	{Code: "GBP", Name: "British Pound", Class: Fiat, Decimals: 2},
+	{Code: "CAD", Name: "Canadian Dollar", Class: Fiat, Decimals: 2},
+	{Code: "CHF", Name: "Swiss Franc", Class: Fiat, Decimals: 2},
+	{Code: "NZD", Name: "New Zealand Dollar", Class: Fiat, Decimals: 2},
+	{Code: "KRW", Name: "South Korean Won", Class: Fiat, Decimals: 0},
+	{Code: "HKD", Name: "Hong Kong Dollar", Class: Fiat, Decimals: 2},
+	{Code: "SGD", Name: "Singapore Dollar", Class: Fiat, Decimals: 2},
+	{Code: "INR", Name: "Indian Rupee", Class: Fiat, Decimals: 2},
+	{Code: "BRL", Name: "Brazilian Real", Class: Fiat, Decimals: 2},
+	{Code: "MXN", Name: "Mexican Peso", Class: Fiat, Decimals: 2},
+	{Code: "PLN", Name: "Polish Zloty", Class: Fiat, Decimals: 2},
+	{Code: "TRY", Name: "Turkish Lira", Class: Fiat, Decimals: 2},
+	{Code: "UAH", Name: "Ukrainian Hryvnia", Class: Fiat, Decimals: 2},
+	{Code: "ZAR", Name: "South African Rand", Class: Fiat, Decimals: 2},
+	{Code: "SEK", Name: "Swedish Krona", Class: Fiat, Decimals: 2},
+	{Code: "NOK", Name: "Norwegian Krone", Class: Fiat, Decimals: 2},
+	{Code: "DKK", Name: "Danish Krone", Class: Fiat, Decimals: 2},
+	{Code: "CZK", Name: "Czech Koruna", Class: Fiat, Decimals: 2},
+	{Code: "IDR", Name: "Indonesian Rupiah", Class: Fiat, Decimals: 2},
+	{
+		Code: "RUB", Name: "Russian Ruble", Class: Fiat, Decimals: 2,
+		Aliases: []Alias{{Code: "RUR"}},
+	},
+
+	// Cryptocurrencies
+	{
+		Code: "BTC", Name: "Bitcoin", Class: Crypto, Decimals: 8,
+		Chains: []string{"Bitcoin"},
+		Aliases: []Alias{
+			{Code: "XBT"},
+			{Exchange: "Kraken", Code: "XBT"},
+			{Exchange: "Bitmex", Code: "XBT"},
+			{Exchange: "ITBIT", Code: "XBT"},
+		},
+	},
+	{
+		Code: "BCH", Name: "Bitcoin Cash", Class: Crypto, Decimals: 8,
+		Chains: []string{"Bitcoin Cash"},
+		Aliases: []Alias{
+			{Exchange: "Yobit", Code: "BCC"},
+			{Exchange: "Binance", Code: "BCHABC"},
+			{Exchange: "BTC Markets", Code: "BCHABC"},
+			{Exchange: "Liqui", Code: "BCHABC"},
+			{Exchange: "OKEX", Code: "BCHABC"},
+			{Exchange: "OKCOIN International", Code: "BCHABC"},
+		},
+	},
+	{
+		Code: "BSV", Name: "Bitcoin SV", Class: Crypto, Decimals: 8,
+		Chains:  []string{"Bitcoin SV"},
+		Aliases: []Alias{{Code: "BCHSV"}},
+	},
+	{
+		Code: "ETH", Name: "Ethereum", Class: Crypto, Decimals: 18,
+		Chains: []string{"Ethereum"},
+	},
+	{
+		Code: "ETC", Name: "Ethereum Classic", Class: Crypto, Decimals: 18,
+		Chains: []string{"Ethereum Classic"},
+	},
+	{
+		Code: "LTC", Name: "Litecoin", Class: Crypto, Decimals: 8,
+		Chains: []string{"Litecoin"},
+	},
+	{
+		Code: "DOGE", Name: "Dogecoin", Class: Crypto, Decimals: 8,
+		Chains:  []string{"Dogecoin"},
+		Aliases: []Alias{{Exchange: "Kraken", Code: "XDG"}},
+	},
+	{
+		Code: "DASH", Name: "Dash", Class: Crypto, Decimals: 8,
+		Chains: []string{"Dash"},
+		Aliases: []Alias{
+			{Exchange: "WEX", Code: "DSH"},
+			{Exchange: "Bitfinex", Code: "DSH"},
+		},
+	},
+	{
+		Code: "XRP", Name: "Ripple", Class: Crypto, Decimals: 6,
+		Chains: []string{"XRP Ledger"},
+	},
+	{
+		Code: "XMR", Name: "Monero", Class: Crypto, Decimals: 12,
+		Chains: []string{"Monero"},
+	},
+	{
+		Code: "ZEC", Name: "Zcash", Class: Crypto, Decimals: 8,
+		Chains: []string{"Zcash"},
+	},
+	{
+		Code: "IOTA", Name: "IOTA", Class: Crypto, Decimals: 0,
+		Chains: []string{"IOTA Tangle"},
+		Aliases: []Alias{
+			{Code: "MIOTA"},
+			{Exchange: "Bitfinex", Code: "IOT"},
+		},
+	},
+	{
+		Code: "QTUM", Name: "Qtum", Class: Crypto, Decimals: 8,
+		Chains:  []string{"Qtum"},
+		Aliases: []Alias{{Exchange: "Bitfinex", Code: "QTM"}},
+	},
+	{
+		Code: "EOS", Name: "EOS", Class: Crypto, Decimals: 4,
+		Chains: []string{"EOS"},
+	},
+	{
+		Code: "NEO", Name: "NEO", Class: Crypto, Decimals: 0,
+		Chains: []string{"NEO"},
+	},
+	{
+		Code: "XLM", Name: "Stellar", Class: Crypto, Decimals: 7,
+		Chains: []string{"Stellar"},
+	},
+	{
+		Code: "ADA", Name: "Cardano", Class: Crypto, Decimals: 6,
+		Chains: []string{"Cardano"},
+	},
+	{
+		Code: "TRX", Name: "TRON", Class: Crypto, Decimals: 6,
+		Chains: []string{"TRON"},
+	},
+	{
+		Code: "BNB", Name: "Binance Coin", Class: Crypto, Decimals: 8,
+		Chains: []string{"Binance Chain", "Ethereum"},
+	},
+
+	// Stablecoins
+	{
+		Code: "USDT", Name: "Tether", Class: Stablecoin, Decimals: 6,
+		Chains:   []string{"Omni", "Ethereum", "TRON"},
+		PeggedTo: "USD",
+		Aliases: []Alias{
+			{Exchange: "Bitfinex", Code: "UST"},
+			{Exchange: "HitBTC", Code: "USD"},
+		},
+	},
+	{
+		Code: "USDC", Name: "USD Coin", Class: Stablecoin, Decimals: 6,
+		Chains:   []string{"Ethereum"},
+		PeggedTo: "USD",
+	},
+	{
+		Code: "TUSD", Name: "TrueUSD", Class: Stablecoin, Decimals: 18,
+		Chains:   []string{"Ethereum"},
+		PeggedTo: "USD",
+	},
+	{
+		Code: "PAX", Name: "Paxos Standard", Class: Stablecoin, Decimals: 18,
+		Chains:   []string{"Ethereum"},
+		PeggedTo: "USD",
+	},
+	{
+		Code: "GUSD", Name: "Gemini Dollar", Class: Stablecoin, Decimals: 2,
+		Chains:   []string{"Ethereum"},
+		PeggedTo: "USD",
+	},
+	{
+		Code: "DAI", Name: "Dai", Class: Stablecoin, Decimals: 18,
+		Chains:   []string{"Ethereum"},
+		PeggedTo: "USD",
+	},
+}
@@ -0,0 +1,260 @@
+package registry
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+// Class is the classification of a currency
+type Class string
+
+// Currency classifications
+const (
+	Fiat       Class = "fiat"
+	Crypto     Class = "crypto"
+	Stablecoin Class = "stablecoin"
+)
+
+// Alias is a code used in place of a canonical currency code, an alias
+// without an exchange applies to every exchange
+type Alias struct {
+	Exchange string `json:"exchange,omitempty"`
+	Code     string `json:"code"`
+}
+
+// Currency holds the details of a registered currency
+type Currency struct {
+	Code     string   `json:"code"`
+	Name     string   `json:"name"`
+	Class    Class    `json:"class"`
+	Decimals int32    `json:"decimals"`
+	Chains   []string `json:"chains,omitempty"`
+	// PeggedTo is the fiat currency a stablecoin tracks
+	PeggedTo string  `json:"peggedTo,omitempty"`
+	Aliases  []Alias `json:"aliases,omitempty"`
+}
+
+type aliasKey struct {
+	exchange string
+	code     string
+}
+
+var (
+	mtx        sync.RWMutex
+	currencies = make(map[string]Currency)
+	aliases    = make(map[aliasKey]string)
+)
+
+func init() {
+	for i := range defaultCurrencies {
+		Register(defaultCurrencies[i])
+	}
+}
+
+// Register adds a currency to the registry or replaces the registered
+// currency with the same code
+func Register(c Currency) {
+	c.Code = strings.ToUpper(c.Code)
+	c.PeggedTo = strings.ToUpper(c.PeggedTo)
+
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if old, ok := currencies[c.Code]; ok {
+		for _, a := range old.Aliases {
+			delete(aliases, aliasKey{strings.ToLower(a.Exchange), strings.ToUpper(a.Code)})
+		}
+	}
+
+	for i := range c.Aliases {
+		c.Aliases[i].Code = strings.ToUpper(c.Aliases[i].Code)
+		aliases[aliasKey{strings.ToLower(c.Aliases[i].Exchange), c.Aliases[i].Code}] = c.Code
+	}
+	currencies[c.Code] = c
+}
+
+// RegisterIfUnknown registers a currency with only a code and class when the
+// code isn't already known as a currency or alias
+func RegisterIfUnknown(code string, class Class) {
+	if code == "" {
+		return
+	}
+
+	if _, ok := Get(code); ok {
+		return
+	}
+	Register(Currency{Code: code, Class: class})
+}
+
+// canonical returns the canonical code of a currency, it must be called with
+// the registry locked. Aliases of other exchanges are never used, an exchange
+// may list a code another exchange aliases as a distinct currency
+func canonical(exchange, code string) string {
+	code = strings.ToUpper(code)
+	if exchange != "" {
+		if c, ok := aliases[aliasKey{strings.ToLower(exchange), code}]; ok {
+			return c
+		}
+	}
+
+	if c, ok := aliases[aliasKey{"", code}]; ok {
+		return c
+	}
+
+	return code
+}
+
+// Canonical returns the canonical code of a currency code used by an
+// exchange, the exchange can be empty. Unknown codes are returned uppercased
+func Canonical(exchange, code string) string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return canonical(exchange, code)
+}
+
+// CanonicalPair returns the pair with both currencies in their canonical codes
+func CanonicalPair(exchange string, p pair.CurrencyPair) pair.CurrencyPair {
+	p.FirstCurrency = pair.CurrencyItem(Canonical(exchange, p.FirstCurrency.String()))
+	p.SecondCurrency = pair.CurrencyItem(Canonical(exchange, p.SecondCurrency.String()))
+	return p
+}
+
+// ExchangeCode returns the code an exchange uses for a canonical currency
+// code, codes without an alias on the exchange are returned unchanged
+func ExchangeCode(exchange, code string) string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	c, ok := currencies[canonical(exchange, code)]
+	if !ok || strings.ToUpper(code) != c.Code {
+		return code
+	}
+
+	for _, a := range c.Aliases {
+		if a.Exchange != "" && strings.EqualFold(a.Exchange, exchange) {
+			return a.Code
+		}
+	}
+	return code
+}
+
+// ExchangePair returns the pair with both currencies in the codes the exchange
+// uses
+func ExchangePair(exchange string, p pair.CurrencyPair) pair.CurrencyPair {
+	p.FirstCurrency = pair.CurrencyItem(ExchangeCode(exchange, p.FirstCurrency.String()))
+	p.SecondCurrency = pair.CurrencyItem(ExchangeCode(exchange, p.SecondCurrency.String()))
+	return p
+}
+
+// Get returns a registered currency by its canonical code or an alias
+func Get(code string) (Currency, bool) {
+	return GetByExchange("", code)
+}
+
+// GetByExchange returns a registered currency by a code used by an exchange
+func GetByExchange(exchange, code string) (Currency, bool) {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	c, ok := currencies[canonical(exchange, code)]
+	return c, ok
+}
+
+// GetCurrencies returns every registered currency of a class, all currencies
+// are returned when the class is empty
+func GetCurrencies(class Class) []Currency {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	var result []Currency
+	for _, c := range currencies {
+		if class == "" || c.Class == class {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// IsFiat returns whether the code is a registered fiat currency
+func IsFiat(code string) bool {
+	c, ok := Get(code)
+	return ok && c.Class == Fiat
+}
+
+// IsCrypto returns whether the code is a registered cryptocurrency, including
+// stablecoins
+func IsCrypto(code string) bool {
+	c, ok := Get(code)
+	return ok && (c.Class == Crypto || c.Class == Stablecoin)
+}
+
+// IsStablecoin returns whether the code is a registered stablecoin
+func IsStablecoin(code string) bool {
+	c, ok := Get(code)
+	return ok && c.Class == Stablecoin
+}
+
+// GetPeg returns the fiat currency a stablecoin tracks, false is returned if
+// the code isn't a stablecoin
+func GetPeg(code string) (string, bool) {
+	c, ok := Get(code)
+	if !ok || c.Class != Stablecoin || c.PeggedTo == "" {
+		return "", false
+	}
+	return c.PeggedTo, true
+}
+
+// GetDecimals returns the number of decimal places of a currency
+func GetDecimals(code string) (int32, bool) {
+	c, ok := Get(code)
+	return c.Decimals, ok
+}
+
+// Equivalents returns every code which refers to the same value as the
+// currency: the canonical code first, followed by its aliases, the fiat
+// currency of a stablecoin or the stablecoins which track a fiat currency
+func Equivalents(code string) []string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	canonicalCode := canonical("", code)
+	result := []string{canonicalCode}
+	add := func(code string) {
+		for x := range result {
+			if result[x] == code {
+				return
+			}
+		}
+		result = append(result, code)
+	}
+
+	c, ok := currencies[canonicalCode]
+	if !ok {
+		return result
+	}
+
+	for _, a := range c.Aliases {
+		add(a.Code)
+	}
+
+	switch c.Class {
+	case Stablecoin:
+		if c.PeggedTo != "" {
+			add(c.PeggedTo)
+		}
+	case Fiat:
+		var stablecoins []string
+		for _, s := range currencies {
+			if s.Class == Stablecoin && s.PeggedTo == c.Code {
+				stablecoins = append(stablecoins, s.Code)
+			}
+		}
+		sort.Strings(stablecoins)
+		for x := range stablecoins {
+			add(stablecoins[x])
+		}
+	}
+	return result
+}
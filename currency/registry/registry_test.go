@@ -0,0 +1,160 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+func TestCanonical(t *testing.T) {
+	if r := Canonical("Kraken", "xbt"); r != "BTC" {
+		t.Errorf("Test failed. Canonical expected BTC, got %s", r)
+	}
+
+	if r := Canonical("", "RUR"); r != "RUB" {
+		t.Errorf("Test failed. Canonical expected RUB, got %s", r)
+	}
+
+	if r := Canonical("HitBTC", "USD"); r != "USDT" {
+		t.Errorf("Test failed. Canonical expected USDT, got %s", r)
+	}
+
+	if r := Canonical("Bitstamp", "USD"); r != "USD" {
+		t.Errorf("Test failed. Canonical expected USD, got %s", r)
+	}
+
+	if r := Canonical("ANX", "XBT"); r != "BTC" {
+		t.Errorf("Test failed. Canonical expected BTC, got %s", r)
+	}
+
+	if r := Canonical("ITBIT", "XBT"); r != "BTC" {
+		t.Errorf("Test failed. Canonical expected BTC, got %s", r)
+	}
+
+	if r := Canonical("Poloniex", "BCHABC"); r != "BCHABC" {
+		t.Errorf("Test failed. Canonical resolved through another exchanges alias, got %s", r)
+	}
+
+	if r := Canonical("Binance", "BCHABC"); r != "BCH" {
+		t.Errorf("Test failed. Canonical expected BCH, got %s", r)
+	}
+
+	if r := Canonical("", "birds123"); r != "BIRDS123" {
+		t.Errorf("Test failed. Canonical expected BIRDS123, got %s", r)
+	}
+}
+
+func TestExchangeCode(t *testing.T) {
+	if r := ExchangeCode("Kraken", "BTC"); r != "XBT" {
+		t.Errorf("Test failed. ExchangeCode expected XBT, got %s", r)
+	}
+
+	if r := ExchangeCode("kraken", "XBT"); r != "XBT" {
+		t.Errorf("Test failed. ExchangeCode expected XBT, got %s", r)
+	}
+
+	if r := ExchangeCode("Bitstamp", "BTC"); r != "BTC" {
+		t.Errorf("Test failed. ExchangeCode expected BTC, got %s", r)
+	}
+
+	if r := ExchangeCode("WEX", "RUR"); r != "RUR" {
+		t.Errorf("Test failed. ExchangeCode expected RUR, got %s", r)
+	}
+
+	p := ExchangePair("Kraken", pair.NewCurrencyPairDelimiter("btc-usd", "-"))
+	if p.Pair() != "XBT-usd" {
+		t.Errorf("Test failed. ExchangePair unexpected pair %s", p.Pair())
+	}
+
+	p = CanonicalPair("Kraken", pair.NewCurrencyPair("XDG", "XBT"))
+	if p.Pair() != "DOGEBTC" {
+		t.Errorf("Test failed. CanonicalPair unexpected pair %s", p.Pair())
+	}
+}
+
+func TestClassification(t *testing.T) {
+	if !IsFiat("aud") || IsFiat("BTC") || IsFiat("") {
+		t.Error("Test failed. IsFiat returned an unexpected result")
+	}
+
+	if !IsCrypto("XBT") || !IsCrypto("USDT") || IsCrypto("USD") {
+		t.Error("Test failed. IsCrypto returned an unexpected result")
+	}
+
+	if !IsStablecoin("USDT") || IsStablecoin("UST") || IsStablecoin("BTC") {
+		t.Error("Test failed. IsStablecoin returned an unexpected result")
+	}
+
+	if c, ok := GetByExchange("Bitfinex", "UST"); !ok || c.Code != "USDT" {
+		t.Error("Test failed. GetByExchange returned an unexpected result")
+	}
+
+	if peg, ok := GetPeg("USDT"); !ok || peg != "USD" {
+		t.Error("Test failed. GetPeg returned an unexpected result")
+	}
+
+	if _, ok := GetPeg("BTC"); ok {
+		t.Error("Test failed. GetPeg returned a peg for a cryptocurrency")
+	}
+
+	if decimals, ok := GetDecimals("JPY"); !ok || decimals != 0 {
+		t.Error("Test failed. GetDecimals returned an unexpected result")
+	}
+
+	c, ok := Get("USDT")
+	if !ok || len(c.Chains) == 0 {
+		t.Error("Test failed. Get returned no chains for USDT")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	RegisterIfUnknown("XYZ", Crypto)
+	if !IsCrypto("XYZ") {
+		t.Error("Test failed. RegisterIfUnknown did not register XYZ")
+	}
+
+	RegisterIfUnknown("XBT", Fiat)
+	if IsFiat("XBT") {
+		t.Error("Test failed. RegisterIfUnknown registered a known alias")
+	}
+
+	Register(Currency{
+		Code:    "xyz",
+		Class:   Crypto,
+		Aliases: []Alias{{Exchange: "Bitstamp", Code: "ZYX"}},
+	})
+	if r := Canonical("Bitstamp", "ZYX"); r != "XYZ" {
+		t.Errorf("Test failed. Canonical expected XYZ, got %s", r)
+	}
+
+	Register(Currency{Code: "XYZ", Class: Crypto})
+	if r := Canonical("Bitstamp", "ZYX"); r != "ZYX" {
+		t.Errorf("Test failed. Register did not remove the old alias, got %s", r)
+	}
+
+	if len(GetCurrencies(Stablecoin)) == 0 || len(GetCurrencies("")) <= len(GetCurrencies(Fiat)) {
+		t.Error("Test failed. GetCurrencies returned an unexpected result")
+	}
+}
+
+func TestEquivalents(t *testing.T) {
+	r := Equivalents("XBT")
+	if len(r) != 2 || r[0] != "BTC" || r[1] != "XBT" {
+		t.Errorf("Test failed. Equivalents unexpected result %v", r)
+	}
+
+	r = Equivalents("USDT")
+	if r[0] != "USDT" || r[len(r)-1] != "USD" {
+		t.Errorf("Test failed. Equivalents unexpected result %v", r)
+	}
+
+	r = Equivalents("USD")
+	if r[0] != "USD" || len(r) < 2 {
+		t.Errorf("Test failed. Equivalents unexpected result %v", r)
+	}
+
+	r = Equivalents("birds123")
+	if len(r) != 1 {
+		t.Errorf("Test failed. Equivalents unexpected result %v", r)
+	}
+}
@@ -0,0 +1,400 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DivisionPrecision is the number of decimal places Div rounds quotients to
+const DivisionPrecision = 16
+
+// MaxScale bounds the exponent and scale of parsed decimals, larger values
+// would need huge powers of ten and aren't used by any exchange
+const MaxScale = 1000
+
+// Zero is the zero decimal value, the zero value of Decimal is also zero
+var Zero = Decimal{}
+
+// ErrInvalidDecimal is returned when parsing a string which isn't a decimal
+// number
+var ErrInvalidDecimal = errors.New("invalid decimal number")
+
+var ten = big.NewInt(10)
+
+// Decimal is an immutable fixed-point decimal number used for prices, amounts,
+// fees and balances. Arithmetic is exact so values don't accumulate the binary
+// rounding error of float64, its value is coefficient * 10^-scale
+type Decimal struct {
+	coefficient *big.Int
+	scale       int32
+}
+
+// New returns a decimal of value * 10^-scale
+func New(value int64, scale int32) Decimal {
+	if scale < 0 {
+		return Decimal{coefficient: new(big.Int).Mul(big.NewInt(value), pow10(-scale))}
+	}
+	return Decimal{coefficient: big.NewInt(value), scale: scale}
+}
+
+// NewFromInt returns a decimal of the integer value
+func NewFromInt(value int64) Decimal {
+	return New(value, 0)
+}
+
+// NewFromFloat returns the decimal with the shortest representation which
+// round trips to the float, so 0.1 is exactly 0.1. NaN and infinite values
+// return zero
+func NewFromFloat(value float64) Decimal {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Zero
+	}
+	d, err := NewFromString(strconv.FormatFloat(value, 'f', -1, 64))
+	if err != nil {
+		return Zero
+	}
+	return d
+}
+
+// NewFromString parses a decimal number such as "-12.345" or "1.5e-8"
+func NewFromString(value string) (Decimal, error) {
+	s := strings.TrimSpace(value)
+	exp := int64(0)
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		var err error
+		exp, err = strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Zero, fmt.Errorf("%s: %s", ErrInvalidDecimal, value)
+		}
+		s = s[:i]
+	}
+
+	digits := s
+	scale := int64(0)
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		digits = s[:i] + s[i+1:]
+		scale = int64(len(s) - i - 1)
+	}
+
+	unsigned := strings.TrimLeft(digits, "+-")
+	if unsigned == "" || len(digits)-len(unsigned) > 1 ||
+		strings.IndexFunc(unsigned, func(r rune) bool { return r < '0' || r > '9' }) != -1 {
+		return Zero, fmt.Errorf("%s: %s", ErrInvalidDecimal, value)
+	}
+
+	coefficient, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Zero, fmt.Errorf("%s: %s", ErrInvalidDecimal, value)
+	}
+
+	scale -= exp
+	if exp > MaxScale || exp < -MaxScale || scale > MaxScale || scale < -MaxScale {
+		return Zero, fmt.Errorf("%s: %s", ErrInvalidDecimal, value)
+	}
+	if scale < 0 {
+		return Decimal{coefficient: coefficient.Mul(coefficient, pow10(int32(-scale)))}, nil
+	}
+	return Decimal{coefficient: coefficient, scale: int32(scale)}, nil
+}
+
+// RequireFromString parses a decimal number and panics if it is invalid, it
+// is intended for constants
+func RequireFromString(value string) Decimal {
+	d, err := NewFromString(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) value() *big.Int {
+	if d.coefficient == nil {
+		return new(big.Int)
+	}
+	return d.coefficient
+}
+
+// rescale returns the coefficient of the decimal at a larger scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return d.value()
+	}
+	return new(big.Int).Mul(d.value(), pow10(scale-d.scale))
+}
+
+// align returns the coefficients of both decimals at their common scale
+func align(d1, d2 Decimal) (*big.Int, *big.Int, int32) {
+	if d1.scale >= d2.scale {
+		return d1.value(), d2.rescale(d1.scale), d1.scale
+	}
+	return d1.rescale(d2.scale), d2.value(), d2.scale
+}
+
+// Add returns d + d2
+func (d Decimal) Add(d2 Decimal) Decimal {
+	c1, c2, scale := align(d, d2)
+	return Decimal{coefficient: new(big.Int).Add(c1, c2), scale: scale}
+}
+
+// Sub returns d - d2
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	c1, c2, scale := align(d, d2)
+	return Decimal{coefficient: new(big.Int).Sub(c1, c2), scale: scale}
+}
+
+// Mul returns d * d2
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{
+		coefficient: new(big.Int).Mul(d.value(), d2.value()),
+		scale:       d.scale + d2.scale,
+	}
+}
+
+// Div returns d / d2 rounded to DivisionPrecision decimal places, it panics
+// if d2 is zero
+func (d Decimal) Div(d2 Decimal) Decimal {
+	return d.DivRound(d2, DivisionPrecision)
+}
+
+// DivRound returns d / d2 rounded half away from zero to the decimal places,
+// it panics if d2 is zero
+func (d Decimal) DivRound(d2 Decimal, places int32) Decimal {
+	if d2.IsZero() {
+		panic("decimal division by zero")
+	}
+
+	// compute the quotient with one extra place then round it
+	shift := int64(places) + 1 + int64(d2.scale) - int64(d.scale)
+	num := d.value()
+	den := d2.value()
+	if shift >= 0 {
+		num = new(big.Int).Mul(num, pow10(int32(shift)))
+	} else {
+		den = new(big.Int).Mul(den, pow10(int32(-shift)))
+	}
+	q := new(big.Int).Quo(num, den)
+	return Decimal{coefficient: q, scale: places + 1}.Round(places)
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coefficient: new(big.Int).Neg(d.value()), scale: d.scale}
+}
+
+// Abs returns the absolute value of d
+func (d Decimal) Abs() Decimal {
+	return Decimal{coefficient: new(big.Int).Abs(d.value()), scale: d.scale}
+}
+
+// Round returns d rounded half away from zero to the decimal places
+func (d Decimal) Round(places int32) Decimal {
+	if places >= d.scale {
+		return d
+	}
+
+	q, r := new(big.Int).QuoRem(d.value(), pow10(d.scale-places), new(big.Int))
+	half := new(big.Int).Mul(big.NewInt(5), pow10(d.scale-places-1))
+	if r.CmpAbs(half) >= 0 {
+		if d.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return fromScaled(q, places)
+}
+
+// fromScaled returns the decimal of coefficient * 10^-places, negative places
+// round to tens, hundreds and so on
+func fromScaled(coefficient *big.Int, places int32) Decimal {
+	if places < 0 {
+		return Decimal{coefficient: coefficient.Mul(coefficient, pow10(-places))}
+	}
+	return Decimal{coefficient: coefficient, scale: places}
+}
+
+// Truncate returns d rounded towards zero to the decimal places, so an amount
+// is never rounded up past the value available
+func (d Decimal) Truncate(places int32) Decimal {
+	if places >= d.scale {
+		return d
+	}
+	q := new(big.Int).Quo(d.value(), pow10(d.scale-places))
+	return fromScaled(q, places)
+}
+
+// Cmp returns -1 if d < d2, 0 if d == d2 and 1 if d > d2
+func (d Decimal) Cmp(d2 Decimal) int {
+	c1, c2, _ := align(d, d2)
+	return c1.Cmp(c2)
+}
+
+// Equal returns whether d == d2, regardless of the scale of either value
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// GreaterThan returns whether d > d2
+func (d Decimal) GreaterThan(d2 Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+// GreaterThanOrEqual returns whether d >= d2
+func (d Decimal) GreaterThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) >= 0
+}
+
+// LessThan returns whether d < d2
+func (d Decimal) LessThan(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+// LessThanOrEqual returns whether d <= d2
+func (d Decimal) LessThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) <= 0
+}
+
+// Sign returns -1 if d < 0, 0 if d == 0 and 1 if d > 0
+func (d Decimal) Sign() int {
+	return d.value().Sign()
+}
+
+// IsZero returns whether d == 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// IsNegative returns whether d < 0
+func (d Decimal) IsNegative() bool {
+	return d.Sign() < 0
+}
+
+// IsPositive returns whether d > 0
+func (d Decimal) IsPositive() bool {
+	return d.Sign() > 0
+}
+
+// Places returns the number of decimal places needed to represent d exactly
+func (d Decimal) Places() int32 {
+	return d.normalise().scale
+}
+
+// normalise removes trailing zeros from the fraction
+func (d Decimal) normalise() Decimal {
+	if d.scale == 0 || d.IsZero() {
+		return Decimal{coefficient: d.value(), scale: 0}
+	}
+
+	c := new(big.Int).Set(d.value())
+	scale := d.scale
+	r := new(big.Int)
+	for scale > 0 {
+		q, m := new(big.Int).QuoRem(c, ten, r)
+		if m.Sign() != 0 {
+			break
+		}
+		c = q
+		scale--
+	}
+	return Decimal{coefficient: c, scale: scale}
+}
+
+// IntPart returns the integer part of d, truncated towards zero
+func (d Decimal) IntPart() int64 {
+	return d.Truncate(0).rescale(0).Int64()
+}
+
+// Float64 returns the nearest float64 to d
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns d in plain decimal notation without trailing zeros, for
+// example "0.00012" or "-1500"
+func (d Decimal) String() string {
+	n := d.normalise()
+	return n.format(n.scale)
+}
+
+// StringFixed returns d rounded to exactly the decimal places, for example
+// NewFromFloat(1.5).StringFixed(3) returns "1.500"
+func (d Decimal) StringFixed(places int32) string {
+	r := d.Round(places)
+	if r.scale < places {
+		r = Decimal{coefficient: r.rescale(places), scale: places}
+	}
+	return r.format(places)
+}
+
+// format returns the coefficient as a decimal number with scale places
+func (d Decimal) format(scale int32) string {
+	digits := new(big.Int).Abs(d.value()).String()
+	sign := ""
+	if d.Sign() < 0 {
+		sign = "-"
+	}
+	if scale <= 0 {
+		return sign + digits
+	}
+	if int32(len(digits)) <= scale {
+		digits = strings.Repeat("0", int(scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// MarshalJSON encodes d as a JSON number, keeping its exact value
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number or a string holding a number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*d = Zero
+		return nil
+	}
+
+	s = strings.Trim(s, `"`)
+	if s == "" {
+		*d = Zero
+		return nil
+	}
+
+	v, err := NewFromString(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Sum returns the total of the values
+func Sum(values ...Decimal) Decimal {
+	total := Zero
+	for i := range values {
+		total = total.Add(values[i])
+	}
+	return total
+}
+
+// CalculateFee returns the fee on an amount for a fee given as a percentage
+func CalculateFee(amount, feePercent Decimal) Decimal {
+	return amount.Mul(feePercent).Div(NewFromInt(100))
+}
+
+// CalculateAmountWithFee returns the amount including a fee given as a
+// percentage
+func CalculateAmountWithFee(amount, feePercent Decimal) Decimal {
+	return amount.Add(CalculateFee(amount, feePercent))
+}
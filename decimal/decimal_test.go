@@ -0,0 +1,192 @@
+package decimal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewFromString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "0"},
+		{"-0.00", "0"},
+		{"1.50", "1.5"},
+		{"+12.345", "12.345"},
+		{"-0.00012", "-0.00012"},
+		{".5", "0.5"},
+		{"1e3", "1000"},
+		{"1.5e-8", "0.000000015"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+		{"1e-1000", "0." + strings.Repeat("0", 999) + "1"},
+	}
+
+	for _, test := range tests {
+		d, err := NewFromString(test.input)
+		if err != nil {
+			t.Errorf("Test failed. NewFromString %s error %s", test.input, err)
+			continue
+		}
+		if d.String() != test.expected {
+			t.Errorf("Test failed. NewFromString %s expected %s got %s",
+				test.input, test.expected, d)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "1.2.3", "--1", "1e", "0x10", "1,5",
+		"1e2000000000", "1e-2000000000", "1e1001", "0.1e-1000", "0." + strings.Repeat("0", 1000) + "1"} {
+		if _, err := NewFromString(input); err == nil {
+			t.Errorf("Test failed. NewFromString accepted %q", input)
+		}
+	}
+}
+
+func TestNewFromFloat(t *testing.T) {
+	if NewFromFloat(0.1).String() != "0.1" {
+		t.Error("Test failed. NewFromFloat 0.1 didn't round trip", NewFromFloat(0.1))
+	}
+
+	sum := NewFromFloat(0.1).Add(NewFromFloat(0.2))
+	if !sum.Equal(NewFromFloat(0.3)) || sum.Float64() != 0.3 {
+		t.Error("Test failed. 0.1 + 0.2 expected 0.3 got", sum)
+	}
+
+	if !NewFromFloat(1e-8).Equal(New(1, 8)) {
+		t.Error("Test failed. NewFromFloat 1e-8 unexpected value", NewFromFloat(1e-8))
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a := RequireFromString("10.25")
+	b := RequireFromString("0.005")
+
+	if a.Add(b).String() != "10.255" || a.Sub(b).String() != "10.245" ||
+		a.Mul(b).String() != "0.05125" || b.Sub(a).String() != "-10.245" {
+		t.Error("Test failed. Add, Sub or Mul unexpected result")
+	}
+
+	if a.Div(NewFromInt(3)).String() != "3.4166666666666667" {
+		t.Error("Test failed. Div unexpected result", a.Div(NewFromInt(3)))
+	}
+
+	if NewFromInt(2).DivRound(NewFromInt(3), 2).String() != "0.67" ||
+		NewFromInt(-2).DivRound(NewFromInt(3), 2).String() != "-0.67" {
+		t.Error("Test failed. DivRound unexpected result")
+	}
+
+	if a.Neg().Abs().String() != "10.25" || !a.Neg().IsNegative() {
+		t.Error("Test failed. Neg or Abs unexpected result")
+	}
+
+	if Sum(a, b, NewFromInt(-10)).String() != "0.255" {
+		t.Error("Test failed. Sum unexpected result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Test failed. Div by zero didn't panic")
+		}
+	}()
+	a.Div(Zero)
+}
+
+func TestRoundAndTruncate(t *testing.T) {
+	tests := []struct {
+		input    string
+		places   int32
+		round    string
+		truncate string
+	}{
+		{"1.005", 2, "1.01", "1"},
+		{"-1.005", 2, "-1.01", "-1"},
+		{"1.004999", 2, "1", "1"},
+		{"0.123456789", 8, "0.12345679", "0.12345678"},
+		{"12345.6", -2, "12300", "12300"},
+		{"1.5", 4, "1.5", "1.5"},
+	}
+
+	for _, test := range tests {
+		d := RequireFromString(test.input)
+		if r := d.Round(test.places); r.String() != test.round {
+			t.Errorf("Test failed. Round %s %d expected %s got %s",
+				test.input, test.places, test.round, r)
+		}
+		if r := d.Truncate(test.places); r.String() != test.truncate {
+			t.Errorf("Test failed. Truncate %s %d expected %s got %s",
+				test.input, test.places, test.truncate, r)
+		}
+	}
+
+	if RequireFromString("1.5").StringFixed(3) != "1.500" ||
+		RequireFromString("0.0051").StringFixed(2) != "0.01" ||
+		RequireFromString("-0.004").StringFixed(2) != "0.00" {
+		t.Error("Test failed. StringFixed unexpected result")
+	}
+
+	if RequireFromString("1.2300").Places() != 2 || NewFromInt(100).Places() != 0 {
+		t.Error("Test failed. Places unexpected result")
+	}
+
+	if RequireFromString("28999999.99").IntPart() != 28999999 ||
+		RequireFromString("-1.9").IntPart() != -1 {
+		t.Error("Test failed. IntPart unexpected result")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := RequireFromString("1.10")
+	b := RequireFromString("1.1")
+	c := RequireFromString("2")
+
+	if !a.Equal(b) || a.Cmp(c) != -1 || c.Cmp(a) != 1 || !c.GreaterThan(a) ||
+		!a.LessThan(c) || !a.GreaterThanOrEqual(b) || !a.LessThanOrEqual(b) {
+		t.Error("Test failed. Compare unexpected result")
+	}
+
+	var zero Decimal
+	if !zero.IsZero() || zero.Sign() != 0 || zero.IsPositive() || !zero.Equal(Zero) ||
+		zero.String() != "0" {
+		t.Error("Test failed. Zero value unexpected result")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var v struct {
+		Price  Decimal `json:"price"`
+		Amount Decimal `json:"amount"`
+		Fee    Decimal `json:"fee"`
+	}
+
+	err := json.Unmarshal([]byte(`{"price": 0.30000000000000004, "amount": "1.5", "fee": null}`), &v)
+	if err != nil {
+		t.Fatal("Test failed. Unmarshal error", err)
+	}
+	if v.Price.String() != "0.30000000000000004" || v.Amount.String() != "1.5" || !v.Fee.IsZero() {
+		t.Error("Test failed. Unmarshal unexpected result", v)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil || string(data) != `{"price":0.30000000000000004,"amount":1.5,"fee":0}` {
+		t.Error("Test failed. Marshal unexpected result", string(data), err)
+	}
+
+	err = json.Unmarshal([]byte(`{"price": "abc"}`), &v)
+	if err == nil {
+		t.Error("Test failed. Unmarshal accepted an invalid number")
+	}
+}
+
+func TestCalculateFee(t *testing.T) {
+	amount := NewFromFloat(1.1)
+	fee := NewFromFloat(0.25)
+
+	if CalculateFee(amount, fee).String() != "0.00275" {
+		t.Error("Test failed. CalculateFee unexpected result", CalculateFee(amount, fee))
+	}
+	if CalculateAmountWithFee(amount, fee).String() != "1.10275" {
+		t.Error("Test failed. CalculateAmountWithFee unexpected result",
+			CalculateAmountWithFee(amount, fee))
+	}
+}
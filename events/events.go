@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/metrics"
 )
 
 const (
@@ -34,6 +35,9 @@ var (
 
 	// NOTE comms is an interim implementation
 	comms *communications.Communications
+
+	// triggerHandler is called whenever an event triggers
+	triggerHandler func(e *Event)
 )
 
 // Event struct holds the event variables
@@ -58,6 +62,12 @@ func SetComms(commsP *communications.Communications) {
 	comms = commsP
 }
 
+// SetTriggerHandler sets a function which is called whenever an event
+// triggers
+func SetTriggerHandler(handler func(e *Event)) {
+	triggerHandler = handler
+}
+
 // AddEvent adds an event to the Events chain and returns an index/eventID
 // and an error
 func AddEvent(Exchange, Item, Condition string, CurrencyPair pair.CurrencyPair, Asset, Action string) (int, error) {
@@ -112,12 +122,21 @@ func GetEventCounter() (int, int) {
 
 // ExecuteAction will execute the action pending on the chain
 func (e *Event) ExecuteAction() bool {
+	metrics.AddEventTrigger(e.Exchange)
+	if triggerHandler != nil {
+		triggerHandler(e)
+	}
+
 	if common.StringContains(e.Action, ",") {
 		action := common.SplitStrings(e.Action, ",")
 		if action[0] == actionSMSNotify {
 			message := fmt.Sprintf("Event triggered: %s", e.String())
 			if action[1] == "ALL" {
-				comms.PushEvent(base.Event{TradeDetails: message})
+				comms.PushEvent(base.Event{
+					TradeDetails: message,
+					Severity:     base.SeverityInfo,
+					Category:     base.CategoryEventTrigger,
+				})
 			}
 		}
 	} else {
@@ -138,6 +157,7 @@ func (e *Event) String() string {
 // CheckCondition will check the event structure to see if there is a condition
 // met
 func (e *Event) CheckCondition() bool {
+	metrics.AddEventCheck()
 	condition := common.SplitStrings(e.Condition, ",")
 	targetPrice, _ := strconv.ParseFloat(condition[1], 64)
 
@@ -219,7 +239,11 @@ func IsValidEvent(Exchange, Item, Condition, Action string) error {
 		}
 
 		if action[1] != "ALL" {
-			comms.PushEvent(base.Event{Type: action[1]})
+			comms.PushEvent(base.Event{
+				Type:     action[1],
+				Severity: base.SeverityInfo,
+				Category: base.CategoryEventTrigger,
+			})
 		}
 	} else {
 		if Action != actionConsolePrint && Action != actionTest {
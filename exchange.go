@@ -7,35 +7,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
-	"github.com/thrasher-/gocryptotrader/exchanges/anx"
-	"github.com/thrasher-/gocryptotrader/exchanges/binance"
-	"github.com/thrasher-/gocryptotrader/exchanges/bitfinex"
-	"github.com/thrasher-/gocryptotrader/exchanges/bitflyer"
-	"github.com/thrasher-/gocryptotrader/exchanges/bithumb"
-	"github.com/thrasher-/gocryptotrader/exchanges/bitmex"
-	"github.com/thrasher-/gocryptotrader/exchanges/bitstamp"
-	"github.com/thrasher-/gocryptotrader/exchanges/bittrex"
-	"github.com/thrasher-/gocryptotrader/exchanges/btcc"
-	"github.com/thrasher-/gocryptotrader/exchanges/btcmarkets"
-	"github.com/thrasher-/gocryptotrader/exchanges/coinbasepro"
-	"github.com/thrasher-/gocryptotrader/exchanges/coinut"
-	"github.com/thrasher-/gocryptotrader/exchanges/exmo"
-	"github.com/thrasher-/gocryptotrader/exchanges/gateio"
-	"github.com/thrasher-/gocryptotrader/exchanges/gemini"
-	"github.com/thrasher-/gocryptotrader/exchanges/hitbtc"
-	"github.com/thrasher-/gocryptotrader/exchanges/huobi"
-	"github.com/thrasher-/gocryptotrader/exchanges/huobihadax"
-	"github.com/thrasher-/gocryptotrader/exchanges/itbit"
-	"github.com/thrasher-/gocryptotrader/exchanges/kraken"
-	"github.com/thrasher-/gocryptotrader/exchanges/lakebtc"
-	"github.com/thrasher-/gocryptotrader/exchanges/liqui"
-	"github.com/thrasher-/gocryptotrader/exchanges/localbitcoins"
-	"github.com/thrasher-/gocryptotrader/exchanges/okcoin"
-	"github.com/thrasher-/gocryptotrader/exchanges/okex"
-	"github.com/thrasher-/gocryptotrader/exchanges/poloniex"
-	"github.com/thrasher-/gocryptotrader/exchanges/wex"
-	"github.com/thrasher-/gocryptotrader/exchanges/yobit"
-	"github.com/thrasher-/gocryptotrader/exchanges/zb"
+	"github.com/thrasher-/gocryptotrader/exchanges/supported"
 )
 
 // vars related to exchange functions
@@ -113,8 +85,14 @@ func UnloadExchange(name string) error {
 		return err
 	}
 
+	return removeExchange(name)
+}
+
+// removeExchange disables an exchange and removes it from the loaded exchanges
+// without updating the config
+func removeExchange(name string) error {
 	for x := range bot.exchanges {
-		if bot.exchanges[x].GetName() == name {
+		if common.StringToLower(bot.exchanges[x].GetName()) == common.StringToLower(name) {
 			bot.exchanges[x].SetEnabled(false)
 			bot.exchanges = append(bot.exchanges[:x], bot.exchanges[x+1:]...)
 			return nil
@@ -135,73 +113,9 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 		}
 	}
 
-	switch nameLower {
-	case "anx":
-		exch = new(anx.ANX)
-	case "binance":
-		exch = new(binance.Binance)
-	case "bitfinex":
-		exch = new(bitfinex.Bitfinex)
-	case "bitflyer":
-		exch = new(bitflyer.Bitflyer)
-	case "bithumb":
-		exch = new(bithumb.Bithumb)
-	case "bitmex":
-		exch = new(bitmex.Bitmex)
-	case "bitstamp":
-		exch = new(bitstamp.Bitstamp)
-	case "bittrex":
-		exch = new(bittrex.Bittrex)
-	case "btcc":
-		exch = new(btcc.BTCC)
-	case "btc markets":
-		exch = new(btcmarkets.BTCMarkets)
-	case "coinut":
-		exch = new(coinut.COINUT)
-	case "exmo":
-		exch = new(exmo.EXMO)
-	case "coinbasepro":
-		exch = new(coinbasepro.CoinbasePro)
-	case "gateio":
-		exch = new(gateio.Gateio)
-	case "gemini":
-		exch = new(gemini.Gemini)
-	case "hitbtc":
-		exch = new(hitbtc.HitBTC)
-	case "huobi":
-		exch = new(huobi.HUOBI)
-	case "huobihadax":
-		exch = new(huobihadax.HUOBIHADAX)
-	case "itbit":
-		exch = new(itbit.ItBit)
-	case "kraken":
-		exch = new(kraken.Kraken)
-	case "lakebtc":
-		exch = new(lakebtc.LakeBTC)
-	case "liqui":
-		exch = new(liqui.Liqui)
-	case "localbitcoins":
-		exch = new(localbitcoins.LocalBitcoins)
-	case "okcoin china":
-		exch = new(okcoin.OKCoin)
-	case "okcoin international":
-		exch = new(okcoin.OKCoin)
-	case "okex":
-		exch = new(okex.OKEX)
-	case "poloniex":
-		exch = new(poloniex.Poloniex)
-	case "wex":
-		exch = new(wex.WEX)
-	case "yobit":
-		exch = new(yobit.Yobit)
-	case "zb":
-		exch = new(zb.ZB)
-	default:
-		return ErrExchangeNotFound
-	}
-
+	exch = supported.New(nameLower)
 	if exch == nil {
-		return ErrExchangeFailedToLoad
+		return ErrExchangeNotFound
 	}
 
 	exch.SetDefaults()
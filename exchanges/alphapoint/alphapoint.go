@@ -10,6 +10,8 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -55,6 +57,12 @@ func (a *Alphapoint) SetDefaults() {
 	a.AssetTypes = []string{ticker.Spot}
 	a.SupportsAutoPairUpdating = false
 	a.SupportsRESTTickerBatching = false
+	a.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret,
+		config.CredentialClientID}
+	a.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	a.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "WithdrawCryptocurrencyFunds", "WithdrawFiatFunds",
+		"GetWebsocket"}
 	a.APIWithdrawPermissions = exchange.WithdrawCryptoWith2FA | exchange.AutoWithdrawCryptoWithAPIPermission
 	a.Requester = request.New(a.Name,
 		request.NewRateLimit(time.Minute*10, alphapointAuthRate),
@@ -373,14 +381,14 @@ func (a *Alphapoint) convertOrderTypeToOrderTypeNumber(orderType string) (orderT
 // orderType - “1” for market orders, “0” for limit orders
 // quantity - Quantity
 // price - Price in USD
-func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price float64) (int64, error) {
+func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price decimal.Decimal) (int64, error) {
 	orderTypeNumber := a.convertOrderTypeToOrderTypeNumber(orderType)
 	request := make(map[string]interface{})
 	request["ins"] = symbol
 	request["side"] = side
 	request["orderType"] = orderTypeNumber
-	request["qty"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	request["px"] = strconv.FormatFloat(price, 'f', -1, 64)
+	request["qty"] = a.FormatOrderAmount(quantity)
+	request["px"] = a.FormatOrderPrice(price)
 	response := Response{}
 
 	err := a.SendAuthenticatedHTTPRequest(
@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -24,8 +25,8 @@ func (a *Alphapoint) GetAccountInfo() (exchange.AccountInfo, error) {
 	for i := 0; i < len(account.Currencies); i++ {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = account.Currencies[i].Name
-		exchangeCurrency.TotalValue = float64(account.Currencies[i].Balance)
-		exchangeCurrency.Hold = float64(account.Currencies[i].Hold)
+		exchangeCurrency.TotalValue = decimal.NewFromInt(int64(account.Currencies[i].Balance))
+		exchangeCurrency.Hold = decimal.NewFromInt(int64(account.Currencies[i].Hold))
 
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
@@ -107,8 +108,12 @@ func (a *Alphapoint) GetExchangeHistory(p pair.CurrencyPair, assetType string) (
 
 // SubmitOrder submits a new order and returns a true value when
 // successfully submitted
-func (a *Alphapoint) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (a *Alphapoint) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := a.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
 
 	response, err := a.CreateOrder(p.Pair().String(), side.ToString(), orderType.ToString(), amount, price)
 	if response > 0 {
@@ -197,8 +202,8 @@ func (a *Alphapoint) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (a *Alphapoint) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return 0, common.ErrNotYetImplemented
+func (a *Alphapoint) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	return decimal.Zero, common.ErrNotYetImplemented
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
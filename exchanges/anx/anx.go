@@ -12,6 +12,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -63,6 +64,11 @@ func (a *ANX) SetDefaults() {
 	a.AssetTypes = []string{ticker.Spot}
 	a.SupportsAutoPairUpdating = true
 	a.SupportsRESTTickerBatching = false
+	a.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	a.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	a.UnsupportedFunctions = []string{"GetAccountInfo", "GetFundingHistory",
+		"GetExchangeHistory", "ModifyOrder", "CancelAllOrders", "GetOrderInfo",
+		"GetDepositAddress", "WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	a.Requester = request.New(a.Name,
 		request.NewRateLimit(time.Second, anxAuthRate),
 		request.NewRateLimit(time.Second, anxUnauthRate),
@@ -197,7 +203,7 @@ func (a *ANX) GetDataToken() (string, error) {
 }
 
 // NewOrder sends a new order request to the exchange.
-func (a *ANX) NewOrder(orderType string, buy bool, tradedCurrency string, tradedCurrencyAmount float64, settlementCurrency string, settlementCurrencyAmount float64, limitPriceSettlement float64,
+func (a *ANX) NewOrder(orderType string, buy bool, tradedCurrency string, tradedCurrencyAmount decimal.Decimal, settlementCurrency string, settlementCurrencyAmount decimal.Decimal, limitPriceSettlement decimal.Decimal,
 	replace bool, replaceUUID string, replaceIfActive bool) (string, error) {
 
 	request := make(map[string]interface{})
@@ -205,15 +211,18 @@ func (a *ANX) NewOrder(orderType string, buy bool, tradedCurrency string, traded
 	order.OrderType = orderType
 	order.BuyTradedCurrency = buy
 
+	tradedAmount, settlementAmount := decimal.Zero, decimal.Zero
 	if buy {
-		order.TradedCurrencyAmount = tradedCurrencyAmount
+		tradedAmount = tradedCurrencyAmount
 	} else {
-		order.SettlementCurrencyAmount = settlementCurrencyAmount
+		settlementAmount = settlementCurrencyAmount
 	}
 
 	order.TradedCurrency = tradedCurrency
 	order.SettlementCurrency = settlementCurrency
-	order.LimitPriceInSettlementCurrency = limitPriceSettlement
+	order.TradedCurrencyAmount = a.FormatOrderAmount(tradedAmount)
+	order.SettlementCurrencyAmount = a.FormatOrderAmount(settlementAmount)
+	order.LimitPriceInSettlementCurrency = a.FormatOrderPrice(limitPriceSettlement)
 
 	if replace {
 		order.ReplaceExistingOrderUUID = replaceUUID
@@ -425,8 +434,8 @@ func (a *ANX) SendAuthenticatedHTTPRequest(path string, params map[string]interf
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (a *ANX) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (a *ANX) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
@@ -436,33 +445,33 @@ func (a *ANX) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getInternationalBankWithdrawalFee(feeBuilder.CurrencyItem, feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
 
-func (a *ANX) calculateTradingFee(purchasePrice, amount float64, isMaker bool) float64 {
-	var fee float64
+func (a *ANX) calculateTradingFee(purchasePrice, amount decimal.Decimal, isMaker bool) decimal.Decimal {
+	var fee decimal.Decimal
 
 	if isMaker {
-		fee = a.MakerFee * amount * purchasePrice
+		fee = decimal.NewFromFloat(a.MakerFee).Mul(amount).Mul(purchasePrice)
 	} else {
-		fee = a.TakerFee * amount * purchasePrice
+		fee = decimal.NewFromFloat(a.TakerFee).Mul(amount).Mul(purchasePrice)
 	}
 
 	return fee
 }
 
-func getCryptocurrencyWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getCryptocurrencyWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
 	if currency == symbol.HKD {
-		fee = 250 + (WithdrawalFees[currency] * amount)
+		fee = decimal.NewFromInt(250).Add(decimal.NewFromFloat(WithdrawalFees[currency]).Mul(amount))
 	}
 	//TODO, other fiat currencies require consultation with ANXPRO
 	return fee
@@ -6,6 +6,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 )
 
@@ -132,13 +133,13 @@ func TestGetAPIKey(t *testing.T) {
 
 func setFeeBuilder() exchange.FeeBuilder {
 	return exchange.FeeBuilder{
-		Amount:         1,
+		Amount:         decimal.NewFromFloat(1),
 		Delimiter:      "",
 		FeeType:        exchange.CryptocurrencyTradeFee,
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 		IsMaker:        false,
-		PurchasePrice:  1,
+		PurchasePrice:  decimal.NewFromFloat(1),
 	}
 }
 
@@ -149,49 +150,49 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	// CryptocurrencyTradeFee Basic
-	if resp, err := a.GetFee(feeBuilder); resp != float64(0.02) || err != nil {
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.02)) || err != nil {
 		t.Error(err)
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 	}
 
 	// CryptocurrencyTradeFee High quantity
 	feeBuilder = setFeeBuilder()
-	feeBuilder.Amount = 1000
-	feeBuilder.PurchasePrice = 1000
-	if resp, err := a.GetFee(feeBuilder); resp != float64(20000) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(20000), resp)
+	feeBuilder.Amount = decimal.NewFromFloat(1000)
+	feeBuilder.PurchasePrice = decimal.NewFromFloat(1000)
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(20000)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(20000), resp)
 		t.Error(err)
 	}
 
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := a.GetFee(feeBuilder); resp != float64(0.01) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.01), resp)
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.01)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.01), resp)
 		t.Error(err)
 	}
 
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
-	feeBuilder.PurchasePrice = -1000
-	if resp, err := a.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	feeBuilder.PurchasePrice = decimal.NewFromFloat(-1000)
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := a.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.002)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := a.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -199,8 +200,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := a.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -208,8 +209,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := a.GetFee(feeBuilder); resp != float64(250.01) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(250.01), resp)
+	if resp, err := a.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(250.01)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(250.01), resp)
 		t.Error(err)
 	}
 }
@@ -251,7 +252,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := a.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := a.SubmitOrder(p, exchange.Buy, exchange.Market, decimal.NewFromFloat(1), decimal.NewFromFloat(1), "clientId")
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
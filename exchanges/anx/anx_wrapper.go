@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -195,11 +196,15 @@ func (a *ANX) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]excha
 }
 
 // SubmitOrder submits a new order
-func (a *ANX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (a *ANX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := a.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
 
 	var isBuying bool
-	var limitPriceInSettlementCurrency float64
+	var limitPriceInSettlementCurrency decimal.Decimal
 
 	if side == exchange.Buy {
 		isBuying = true
@@ -273,7 +278,7 @@ func (a *ANX) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (a *ANX) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (a *ANX) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return a.GetFee(feeBuilder)
 }
 
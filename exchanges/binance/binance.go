@@ -12,6 +12,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -70,6 +71,16 @@ func (b *Binance) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = true
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	b.WebsocketChannels = []string{exchange.WebsocketTickerChannel,
+		exchange.WebsocketTradeChannel, exchange.WebsocketKlineChannel,
+		exchange.WebsocketOrderbookChannel}
+	b.KlineIntervals = []string{"1m", "3m", "5m", "15m", "30m", "1h", "2h", "4h", "6h", "8h",
+		"12h", "1d", "3d", "1w", "1M"}
+	b.UnsupportedFunctions = []string{"GetAccountInfo", "GetFundingHistory",
+		"GetExchangeHistory", "ModifyOrder", "CancelAllOrders", "GetOrderInfo",
+		"GetDepositAddress", "WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	b.APIWithdrawPermissions = exchange.AutoWithdrawCrypto
 	b.SetValues()
 	b.Requester = request.New(b.Name,
@@ -429,9 +440,9 @@ func (b *Binance) NewOrder(o NewOrderRequest) (NewOrderResponse, error) {
 	params.Set("symbol", o.Symbol)
 	params.Set("side", string(o.Side))
 	params.Set("type", string(o.TradeType))
-	params.Set("quantity", strconv.FormatFloat(o.Quantity, 'f', -1, 64))
+	params.Set("quantity", b.FormatOrderAmount(o.Quantity))
 	if o.TradeType == "LIMIT" {
-		params.Set("price", strconv.FormatFloat(o.Price, 'f', -1, 64))
+		params.Set("price", b.FormatOrderPrice(o.Price))
 	}
 	if o.TimeInForce != "" {
 		params.Set("timeInForce", string(o.TimeInForce))
@@ -659,21 +670,21 @@ func (b *Binance) SetValues() {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Binance) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *Binance) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		multiplier, err := b.getMultiplier(feeBuilder.IsMaker)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount, multiplier)
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getCryptocurrencyWithdrawalFee(feeBuilder.FirstCurrency, feeBuilder.PurchasePrice, feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
@@ -694,11 +705,11 @@ func (b *Binance) getMultiplier(isMaker bool) (float64, error) {
 }
 
 // calculateTradingFee returns the fee for trading any currency on Bittrex
-func calculateTradingFee(purchasePrice, amount, multiplier float64) float64 {
-	return (multiplier / 100) * purchasePrice * amount
+func calculateTradingFee(purchasePrice, amount decimal.Decimal, multiplier float64) decimal.Decimal {
+	return decimal.CalculateFee(purchasePrice.Mul(amount), decimal.NewFromFloat(multiplier))
 }
 
 // getCryptocurrencyWithdrawalFee returns the fee for withdrawing from the exchange
-func getCryptocurrencyWithdrawalFee(currency string, purchasePrice, amount float64) float64 {
-	return WithdrawalFees[currency]
+func getCryptocurrencyWithdrawalFee(currency string, purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 )
 
@@ -149,8 +150,8 @@ func TestNewOrder(t *testing.T) {
 		Side:        BinanceRequestParamsSideSell,
 		TradeType:   BinanceRequestParamsOrderLimit,
 		TimeInForce: BinanceRequestParamsTimeGTC,
-		Quantity:    0.01,
-		Price:       1536.1,
+		Quantity:    decimal.NewFromFloat(0.01),
+		Price:       decimal.NewFromFloat(1536.1),
 	})
 
 	if err == nil {
@@ -234,13 +235,13 @@ func TestGetAccount(t *testing.T) {
 
 func setFeeBuilder() exchange.FeeBuilder {
 	return exchange.FeeBuilder{
-		Amount:         1,
+		Amount:         decimal.NewFromFloat(1),
 		Delimiter:      "",
 		FeeType:        exchange.CryptocurrencyTradeFee,
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 		IsMaker:        false,
-		PurchasePrice:  1,
+		PurchasePrice:  decimal.NewFromFloat(1),
 	}
 }
 
@@ -252,33 +253,33 @@ func TestGetFee(t *testing.T) {
 
 	if testAPIKey != "" || testAPISecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.1)) || err != nil {
 			t.Error(err)
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		}
 
 		// CryptocurrencyTradeFee High quantity
 		feeBuilder = setFeeBuilder()
-		feeBuilder.Amount = 1000
-		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(100000) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(100000), resp)
+		feeBuilder.Amount = decimal.NewFromFloat(1000)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(1000)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(100000)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(100000), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.1), resp)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.1)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.1), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
-		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(-1000)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 			t.Error(err)
 		}
 
@@ -287,16 +288,16 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0005), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.0005)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.0005), resp)
 		t.Error(err)
 	}
 
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -304,8 +305,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -313,8 +314,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 }
@@ -356,7 +357,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, decimal.NewFromFloat(1), decimal.NewFromFloat(1), "clientId")
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 )
 
 // Response holds basic binance api response data
@@ -287,8 +288,8 @@ type NewOrderRequest struct {
 	// Examples are (Good Till Cancel (GTC), Immediate or Cancel (IOC) and Fill Or Kill (FOK))
 	TimeInForce RequestParamsTimeForceType
 	// Quantity
-	Quantity         float64
-	Price            float64
+	Quantity         decimal.Decimal
+	Price            decimal.Decimal
 	NewClientOrderID string
 	StopPrice        float64 //Used with STOP_LOSS, STOP_LOSS_LIMIT, TAKE_PROFIT, and TAKE_PROFIT_LIMIT orders.
 	IcebergQty       float64 //Used with LIMIT, STOP_LOSS_LIMIT, and TAKE_PROFIT_LIMIT to create an iceberg order.
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -54,6 +55,43 @@ func (b *Binance) Run() {
 			log.Printf("%s Failed to get config.\n", b.GetName())
 		}
 	}
+
+	err = b.UpdateMarketRules()
+	if err != nil {
+		log.Printf("%s Failed to update market rules. Err: %s\n", b.GetName(), err)
+	}
+}
+
+// UpdateMarketRules updates the tick size, lot size and minimum notional of
+// every symbol from the exchange info filters
+func (b *Binance) UpdateMarketRules() error {
+	info, err := b.GetExchangeInfo()
+	if err != nil {
+		return err
+	}
+
+	var rules []exchange.MarketRules
+	for _, symbol := range info.Symbols {
+		rule := exchange.MarketRules{
+			Pair: pair.NewCurrencyPairDelimiter(symbol.BaseAsset+"-"+symbol.QuoteAsset, "-"),
+		}
+		for _, filter := range symbol.Filters {
+			switch filter.FilterType {
+			case "PRICE_FILTER":
+				rule.TickSize = decimal.NewFromFloat(filter.TickSize)
+			case "LOT_SIZE":
+				rule.StepSize = decimal.NewFromFloat(filter.StepSize)
+				rule.MinAmount = decimal.NewFromFloat(filter.MinQty)
+				rule.MaxAmount = decimal.NewFromFloat(filter.MaxQty)
+			case "MIN_NOTIONAL":
+				rule.MinNotional = decimal.NewFromFloat(filter.MinNotional)
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	b.SetMarketRules(rules)
+	return nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -143,8 +181,12 @@ func (b *Binance) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]e
 }
 
 // SubmitOrder submits a new order
-func (b *Binance) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Binance) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := b.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
 
 	var sideType RequestParamsSideType
 	if side == exchange.Buy {
@@ -243,7 +285,7 @@ func (b *Binance) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Binance) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Binance) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return b.GetFee(feeBuilder)
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -101,6 +102,13 @@ func (b *Bitfinex) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = true
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	b.WebsocketChannels = []string{exchange.WebsocketOrderbookChannel,
+		exchange.WebsocketTradeChannel, exchange.WebsocketTickerChannel}
+	b.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second*60, bitfinexAuthRate),
 		request.NewRateLimit(time.Second*60, bitfinexUnauthRate),
@@ -598,12 +606,12 @@ func (b *Bitfinex) Withdrawal(withdrawType, wallet, address string, amount float
 
 // NewOrder submits a new order and returns a order information
 // Major Upgrade needed on this function to include all query params
-func (b *Bitfinex) NewOrder(currencyPair string, amount float64, price float64, buy bool, Type string, hidden bool) (Order, error) {
+func (b *Bitfinex) NewOrder(currencyPair string, amount, price decimal.Decimal, buy bool, Type string, hidden bool) (Order, error) {
 	response := Order{}
 	request := make(map[string]interface{})
 	request["symbol"] = currencyPair
-	request["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
-	request["price"] = strconv.FormatFloat(price, 'f', -1, 64)
+	request["amount"] = b.FormatOrderAmount(amount)
+	request["price"] = b.FormatOrderPrice(price)
 	request["exchange"] = "bitfinex"
 	request["type"] = Type
 	request["is_hidden"] = hidden
@@ -921,81 +929,82 @@ func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitfinex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *Bitfinex) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		accountInfos, err := b.GetAccountInformation()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee, err = b.CalculateTradingFee(accountInfos, feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.FirstCurrency, feeBuilder.IsMaker)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 	case exchange.CyptocurrencyDepositFee:
 		//TODO: fee is charged when < $1000USD is transferred, need to infer value in some way
-		fee = 0
+		fee = decimal.Zero
 	case exchange.CryptocurrencyWithdrawalFee:
 		accountFees, err := b.GetAccountFees()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee, err = b.GetCryptocurrencyWithdrawalFee(feeBuilder.FirstCurrency, accountFees)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 	case exchange.InternationalBankDepositFee:
 		fee = getInternationalBankDepositFee(feeBuilder.Amount)
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getInternationalBankWithdrawalFee(feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
 
 // GetCryptocurrencyWithdrawalFee returns an estimate of fee based on type of transaction
-func (b *Bitfinex) GetCryptocurrencyWithdrawalFee(currency string, accountFees AccountFees) (fee float64, err error) {
+func (b *Bitfinex) GetCryptocurrencyWithdrawalFee(currency string, accountFees AccountFees) (fee decimal.Decimal, err error) {
 	switch accountFees.Withdraw[currency].(type) {
 	case string:
-		fee, err = strconv.ParseFloat(accountFees.Withdraw[currency].(string), 64)
+		fee, err = decimal.NewFromString(accountFees.Withdraw[currency].(string))
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 	case float64:
-		fee = accountFees.Withdraw[currency].(float64)
+		fee = decimal.NewFromFloat(accountFees.Withdraw[currency].(float64))
 	}
 
 	return fee, nil
 }
 
-func getInternationalBankDepositFee(amount float64) float64 {
-	return 0.001 * amount
+func getInternationalBankDepositFee(amount decimal.Decimal) decimal.Decimal {
+	return decimal.NewFromFloat(0.001).Mul(amount)
 }
 
-func getInternationalBankWithdrawalFee(amount float64) float64 {
-	return 0.001 * amount
+func getInternationalBankWithdrawalFee(amount decimal.Decimal) decimal.Decimal {
+	return decimal.NewFromFloat(0.001).Mul(amount)
 }
 
 // CalculateTradingFee returns an estimate of fee based on type of whether is maker or taker fee
-func (b *Bitfinex) CalculateTradingFee(accountInfos []AccountInfo, purchasePrice, amount float64, currency string, isMaker bool) (fee float64, err error) {
+func (b *Bitfinex) CalculateTradingFee(accountInfos []AccountInfo, purchasePrice, amount decimal.Decimal, currency string, isMaker bool) (fee decimal.Decimal, err error) {
+	var feePercent float64
 	for _, i := range accountInfos {
 		for _, j := range i.Fees {
 			if currency == j.Pairs {
 				if isMaker {
-					fee = j.MakerFees
+					feePercent = j.MakerFees
 				} else {
-					fee = j.TakerFees
+					feePercent = j.TakerFees
 				}
 				break
 			}
 		}
-		if fee > 0 {
+		if feePercent > 0 {
 			break
 		}
 	}
-	return (fee / 100) * purchasePrice * amount, err
+	return decimal.CalculateFee(purchasePrice.Mul(amount), decimal.NewFromFloat(feePercent)), err
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 )
 
@@ -354,7 +355,7 @@ func TestNewOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewOrder("BTCUSD", 1, 2, true, "market", false)
+	_, err := b.NewOrder("BTCUSD", decimal.NewFromInt(1), decimal.NewFromInt(2), true, "market", false)
 	if err == nil {
 		t.Error("Test Failed - NewOrder() error")
 	}
@@ -625,13 +626,13 @@ func TestCloseMarginFunding(t *testing.T) {
 
 func setFeeBuilder() exchange.FeeBuilder {
 	return exchange.FeeBuilder{
-		Amount:         1,
+		Amount:         decimal.NewFromFloat(1),
 		Delimiter:      "",
 		FeeType:        exchange.CryptocurrencyTradeFee,
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 		IsMaker:        false,
-		PurchasePrice:  1,
+		PurchasePrice:  decimal.NewFromFloat(1),
 	}
 }
 
@@ -643,41 +644,41 @@ func TestGetFee(t *testing.T) {
 
 	if testAPIKey != "" || testAPISecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.002)) || err != nil {
 			t.Error(err)
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.002), resp)
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.002), resp)
 		}
 
 		// CryptocurrencyTradeFee High quantity
 		feeBuilder = setFeeBuilder()
-		feeBuilder.Amount = 1000
-		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(2000) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(2000), resp)
+		feeBuilder.Amount = decimal.NewFromFloat(1000)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(1000)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(2000)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(2000), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.001)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.001), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
-		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(-1000)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyWithdrawalFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.0004) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0004), resp)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.0004)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.0004), resp)
 			t.Error(err)
 		}
 	}
@@ -685,8 +686,8 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -694,8 +695,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.001)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.001), resp)
 		t.Error(err)
 	}
 
@@ -703,8 +704,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.001)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.001), resp)
 		t.Error(err)
 	}
 }
@@ -744,7 +745,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, decimal.NewFromFloat(1), decimal.NewFromFloat(1), "clientId")
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -40,6 +41,33 @@ func (b *Bitfinex) Run() {
 			log.Printf("%s Failed to update available symbols.\n", b.GetName())
 		}
 	}
+
+	err = b.UpdateMarketRules()
+	if err != nil {
+		log.Printf("%s Failed to update market rules. Err: %s\n", b.GetName(), err)
+	}
+}
+
+// UpdateMarketRules updates the minimum and maximum order sizes of every
+// symbol. Bitfinex prices are limited to significant digits rather than
+// decimal places so no tick size is set
+func (b *Bitfinex) UpdateMarketRules() error {
+	details, err := b.GetSymbolsDetails()
+	if err != nil {
+		return err
+	}
+
+	var rules []exchange.MarketRules
+	for x := range details {
+		rules = append(rules, exchange.MarketRules{
+			Pair:      pair.NewCurrencyPairFromString(details[x].Pair),
+			MinAmount: decimal.NewFromFloat(details[x].MinimumOrderSize),
+			MaxAmount: decimal.NewFromFloat(details[x].MaximumOrderSize),
+		})
+	}
+
+	b.SetMarketRules(rules)
+	return nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -127,24 +155,25 @@ func (b *Bitfinex) GetAccountInfo() (exchange.AccountInfo, error) {
 	}
 
 	type bfxCoins struct {
-		OnHold    float64
-		Available float64
+		OnHold    decimal.Decimal
+		Available decimal.Decimal
 	}
 
 	accounts := make(map[string]bfxCoins)
 
 	for i := range accountBalance {
-		onHold := accountBalance[i].Amount - accountBalance[i].Available
+		available := decimal.NewFromFloat(accountBalance[i].Available)
+		onHold := decimal.NewFromFloat(accountBalance[i].Amount).Sub(available)
 		coins := bfxCoins{
 			OnHold:    onHold,
-			Available: accountBalance[i].Available,
+			Available: available,
 		}
 		result, ok := accounts[accountBalance[i].Currency]
 		if !ok {
 			accounts[accountBalance[i].Currency] = coins
 		} else {
-			result.Available += accountBalance[i].Available
-			result.OnHold += onHold
+			result.Available = result.Available.Add(available)
+			result.OnHold = result.OnHold.Add(onHold)
 			accounts[accountBalance[i].Currency] = result
 		}
 	}
@@ -152,7 +181,7 @@ func (b *Bitfinex) GetAccountInfo() (exchange.AccountInfo, error) {
 	for x, y := range accounts {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = common.StringToUpper(x)
-		exchangeCurrency.TotalValue = y.Available + y.OnHold
+		exchangeCurrency.TotalValue = y.Available.Add(y.OnHold)
 		exchangeCurrency.Hold = y.OnHold
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
@@ -175,8 +204,13 @@ func (b *Bitfinex) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]
 }
 
 // SubmitOrder submits a new order
-func (b *Bitfinex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitfinex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := b.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var isBuying bool
 
 	if side == exchange.Buy {
@@ -254,7 +288,7 @@ func (b *Bitfinex) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bitfinex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Bitfinex) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return b.GetFee(feeBuilder)
 }
 
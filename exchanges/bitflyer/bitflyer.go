@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -89,6 +90,11 @@ func (b *Bitflyer) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = false
 	b.SupportsRESTTickerBatching = false
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "SubmitOrder", "CancelOrder",
+		"GetWebsocket"}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Minute, bitflyerAuthRate),
 		request.NewRateLimit(time.Minute, bitflyerUnauthRate),
@@ -390,8 +396,8 @@ func (b *Bitflyer) SendAuthHTTPRequest(path string, params url.Values, result in
 
 // GetFee returns an estimate of fee based on type of transaction
 // TODO: Figure out the weird fee structure. Do we use Bitcoin Easy Exchange,Lightning Spot,Bitcoin Market,Lightning FX/Futures ???
-func (b *Bitflyer) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *Bitflyer) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
@@ -401,39 +407,39 @@ func (b *Bitflyer) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.BankTransactionType, feeBuilder.CurrencyItem, feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
 
 // calculateTradingFee returns fee when performing a trade
-func calculateTradingFee(purchasePrice float64, amount float64) float64 {
-	fee := 0.0015
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	fee := decimal.NewFromFloat(0.0015)
 	// bitflyer has fee tiers, but does not disclose them via API, so the largest has to be assumed
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getDepositFee(bankTransactionType exchange.InternationalBankTransactionType, currency string, amount float64) (fee float64) {
+func getDepositFee(bankTransactionType exchange.InternationalBankTransactionType, currency string, amount decimal.Decimal) (fee decimal.Decimal) {
 	switch bankTransactionType {
 	case exchange.WireTransfer:
 		switch currency {
 		case symbol.JPY:
-			fee = 324
+			fee = decimal.NewFromInt(324)
 		}
 	}
 	return fee
 }
 
-func getWithdrawalFee(bankTransactionType exchange.InternationalBankTransactionType, currency string, amount float64) (fee float64) {
+func getWithdrawalFee(bankTransactionType exchange.InternationalBankTransactionType, currency string, amount decimal.Decimal) (fee decimal.Decimal) {
 	switch bankTransactionType {
 	case exchange.WireTransfer:
 		switch currency {
 		case symbol.JPY:
-			if amount < 30000 {
-				fee = 540
+			if amount.LessThan(decimal.NewFromInt(30000)) {
+				fee = decimal.NewFromInt(540)
 			} else {
-				fee = 756
+				fee = decimal.NewFromInt(756)
 			}
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 
 	"github.com/thrasher-/gocryptotrader/config"
@@ -151,13 +152,13 @@ func TestGetTickerPrice(t *testing.T) {
 
 func setFeeBuilder() exchange.FeeBuilder {
 	return exchange.FeeBuilder{
-		Amount:              1,
+		Amount:              decimal.NewFromFloat(1),
 		Delimiter:           "",
 		FeeType:             exchange.CryptocurrencyTradeFee,
 		FirstCurrency:       symbol.BTC,
 		SecondCurrency:      symbol.LTC,
 		IsMaker:             false,
-		PurchasePrice:       1,
+		PurchasePrice:       decimal.NewFromFloat(1),
 		CurrencyItem:        symbol.JPY,
 		BankTransactionType: exchange.WireTransfer,
 	}
@@ -170,41 +171,41 @@ func TestGetFee(t *testing.T) {
 
 	if testAPIKey != "" || testAPISecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
 			t.Error(err)
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		}
 
 		// CryptocurrencyTradeFee High quantity
 		feeBuilder = setFeeBuilder()
-		feeBuilder.Amount = 1000
-		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+		feeBuilder.Amount = decimal.NewFromFloat(1000)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(1000)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.1), resp)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.1)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.1), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
-		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(-1000)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyWithdrawalFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+		if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 			t.Error(err)
 		}
 	}
@@ -212,8 +213,8 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -221,8 +222,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.JPY
-	if resp, err := b.GetFee(feeBuilder); resp != float64(324) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(324), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(324)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(324), resp)
 		t.Error(err)
 	}
 
@@ -230,8 +231,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.JPY
-	if resp, err := b.GetFee(feeBuilder); resp != float64(540) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(540), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(540)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(540), resp)
 		t.Error(err)
 	}
 }
@@ -271,7 +272,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, decimal.NewFromFloat(1), decimal.NewFromFloat(1), "clientId")
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -72,6 +73,12 @@ func (b *Bithumb) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = true
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.SupportedOrderTypes = []exchange.OrderType{exchange.Market}
+	b.AmountPrecision = 4
+	b.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second, bithumbAuthRate),
 		request.NewRateLimit(time.Second, bithumbUnauthRate),
@@ -495,12 +502,12 @@ func (b *Bithumb) RequestKRWWithdraw(bank, account string, price int64) (ActionS
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
 // units: Order quantity
-func (b *Bithumb) MarketBuyOrder(currency string, units float64) (MarketBuy, error) {
+func (b *Bithumb) MarketBuyOrder(currency string, units decimal.Decimal) (MarketBuy, error) {
 	response := MarketBuy{}
 
 	params := url.Values{}
 	params.Set("currency", common.StringToUpper(currency))
-	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
+	params.Set("units", b.FormatOrderAmount(units))
 
 	err := b.SendAuthenticatedHTTPRequest(privateMarketBuy, params, &response)
 	if err != nil {
@@ -518,12 +525,12 @@ func (b *Bithumb) MarketBuyOrder(currency string, units float64) (MarketBuy, err
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
 // units: Order quantity
-func (b *Bithumb) MarketSellOrder(currency string, units float64) (MarketSell, error) {
+func (b *Bithumb) MarketSellOrder(currency string, units decimal.Decimal) (MarketSell, error) {
 	response := MarketSell{}
 
 	params := url.Values{}
 	params.Set("currency", common.StringToUpper(currency))
-	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
+	params.Set("units", b.FormatOrderAmount(units))
 
 	err := b.SendAuthenticatedHTTPRequest(privateMarketSell, params, &response)
 	if err != nil {
@@ -573,8 +580,8 @@ func (b *Bithumb) SendAuthenticatedHTTPRequest(path string, params url.Values, r
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bithumb) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *Bithumb) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
@@ -586,47 +593,47 @@ func (b *Bithumb) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.CurrencyItem)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
 
 // calculateTradingFee returns fee when performing a trade
-func calculateTradingFee(purchasePrice float64, amount float64) float64 {
-	fee := 0.0015
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	fee := decimal.NewFromFloat(0.0015)
 
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
 // getDepositFee returns fee on a currency when depositing small amounts to bithumb
-func getDepositFee(currency string, amount float64) float64 {
-	var fee float64
+func getDepositFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
 	switch currency {
 	case symbol.BTC:
-		if amount <= 0.005 {
-			fee = 0.001
+		if amount.LessThanOrEqual(decimal.NewFromFloat(0.005)) {
+			fee = decimal.NewFromFloat(0.001)
 		}
 	case symbol.LTC:
-		if amount <= 0.3 {
-			fee = 0.01
+		if amount.LessThanOrEqual(decimal.NewFromFloat(0.3)) {
+			fee = decimal.NewFromFloat(0.01)
 		}
 	case symbol.DASH:
-		if amount <= 0.04 {
-			fee = 0.01
+		if amount.LessThanOrEqual(decimal.NewFromFloat(0.04)) {
+			fee = decimal.NewFromFloat(0.01)
 		}
 	case symbol.BCH:
-		if amount <= 0.03 {
-			fee = 0.001
+		if amount.LessThanOrEqual(decimal.NewFromFloat(0.03)) {
+			fee = decimal.NewFromFloat(0.001)
 		}
 	case symbol.ZEC:
-		if amount <= 0.02 {
-			fee = 0.001
+		if amount.LessThanOrEqual(decimal.NewFromFloat(0.02)) {
+			fee = decimal.NewFromFloat(0.001)
 		}
 	case symbol.BTG:
-		if amount <= 0.15 {
-			fee = 0.001
+		if amount.LessThanOrEqual(decimal.NewFromFloat(0.15)) {
+			fee = decimal.NewFromFloat(0.001)
 		}
 	}
 
@@ -634,6 +641,6 @@ func getDepositFee(currency string, amount float64) float64 {
 }
 
 // getWithdrawalFee returns fee on a currency when withdrawing out of bithumb
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 )
 
@@ -175,7 +176,7 @@ func TestRequestKRWWithdraw(t *testing.T) {
 
 func TestMarketBuyOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketBuyOrder("btc", 0)
+	_, err := b.MarketBuyOrder("btc", decimal.Zero)
 	if err == nil {
 		t.Error("test failed - Bithumb MarketBuyOrder() error", err)
 	}
@@ -183,7 +184,7 @@ func TestMarketBuyOrder(t *testing.T) {
 
 func TestMarketSellOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketSellOrder("btc", 0)
+	_, err := b.MarketSellOrder("btc", decimal.Zero)
 	if err == nil {
 		t.Error("test failed - Bithumb MarketSellOrder() error", err)
 	}
@@ -191,13 +192,13 @@ func TestMarketSellOrder(t *testing.T) {
 
 func setFeeBuilder() exchange.FeeBuilder {
 	return exchange.FeeBuilder{
-		Amount:         1,
+		Amount:         decimal.NewFromFloat(1),
 		Delimiter:      "",
 		FeeType:        exchange.CryptocurrencyTradeFee,
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 		IsMaker:        false,
-		PurchasePrice:  1,
+		PurchasePrice:  decimal.NewFromFloat(1),
 	}
 }
 
@@ -207,49 +208,49 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	// CryptocurrencyTradeFee Basic
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0015) || err != nil {
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.0015)) || err != nil {
 		t.Error(err)
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0015), resp)
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.0015), resp)
 	}
 
 	// CryptocurrencyTradeFee High quantity
 	feeBuilder = setFeeBuilder()
-	feeBuilder.Amount = 1000
-	feeBuilder.PurchasePrice = 1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(1500) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(1500), resp)
+	feeBuilder.Amount = decimal.NewFromFloat(1000)
+	feeBuilder.PurchasePrice = decimal.NewFromFloat(1000)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(1500)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(1500), resp)
 		t.Error(err)
 	}
 
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0015) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0015), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.0015)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.0015), resp)
 		t.Error(err)
 	}
 
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
-	feeBuilder.PurchasePrice = -1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	feeBuilder.PurchasePrice = decimal.NewFromFloat(-1000)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.001)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.001), resp)
 		t.Error(err)
 	}
 
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -257,8 +258,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -266,8 +267,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := b.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 }
@@ -308,7 +309,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, decimal.NewFromFloat(1), decimal.NewFromFloat(1), "clientId")
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
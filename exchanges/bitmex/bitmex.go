@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -127,6 +128,12 @@ func (b *Bitmex) SetDefaults() {
 	b.APIUrlDefault = bitmexAPIURL
 	b.APIUrl = b.APIUrlDefault
 	b.SupportsAutoPairUpdating = true
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	b.WebsocketChannels = []string{exchange.WebsocketOrderbookChannel, exchange.WebsocketTradeChannel}
+	b.UnsupportedFunctions = []string{"GetAccountInfo", "GetFundingHistory",
+		"GetExchangeHistory", "ModifyOrder", "CancelAllOrders", "GetOrderInfo",
+		"GetDepositAddress", "WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	b.WebsocketInit()
 }
 
@@ -915,27 +922,27 @@ func (b *Bitmex) CaptureError(resp, reType interface{}) error {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitmex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *Bitmex) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	var err error
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, err
 }
 
 // calculateTradingFee returns the fee for trading any currency on Bittrex
-func calculateTradingFee(purchasePrice float64, amount float64, isMaker bool) float64 {
-	var fee = 0.000750
+func calculateTradingFee(purchasePrice, amount decimal.Decimal, isMaker bool) decimal.Decimal {
+	var fee = decimal.NewFromFloat(0.000750)
 
 	if isMaker {
-		fee -= 0.000250
+		fee = fee.Sub(decimal.NewFromFloat(0.000250))
 	}
 
-	return fee * purchasePrice * amount
+	return fee.Mul(purchasePrice).Mul(amount)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -36,9 +37,17 @@ func (b *Bitmex) Run() {
 
 	} else {
 		var exchangeProducts []string
+		var rules []exchange.MarketRules
 		for _, info := range marketInfo {
 			exchangeProducts = append(exchangeProducts, info.Symbol)
+			rules = append(rules, exchange.MarketRules{
+				Pair:      pair.NewCurrencyPairFromString(info.Symbol),
+				TickSize:  decimal.NewFromFloat(info.TickSize),
+				StepSize:  decimal.NewFromInt(info.LotSize),
+				MaxAmount: decimal.NewFromInt(info.MaxOrderQty),
+			})
 		}
+		b.SetMarketRules(rules)
 
 		err = b.UpdateCurrencies(exchangeProducts, false, false)
 		if err != nil {
@@ -145,17 +154,22 @@ func (b *Bitmex) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]ex
 }
 
 // SubmitOrder submits a new order
-func (b *Bitmex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitmex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := b.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var orderNewParams = OrderNewParams{
 		OrdType:  side.ToString(),
 		Symbol:   p.Pair().String(),
-		OrderQty: amount,
+		OrderQty: amount.Float64(),
 		Side:     side.ToString(),
 	}
 
 	if orderType == exchange.Limit {
-		orderNewParams.Price = price
+		orderNewParams.Price = price.Float64()
 	}
 
 	response, err := b.CreateOrder(orderNewParams)
@@ -226,7 +240,7 @@ func (b *Bitmex) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bitmex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Bitmex) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return b.GetFee(feeBuilder)
 }
 
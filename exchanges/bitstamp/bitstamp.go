@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -75,6 +76,14 @@ func (b *Bitstamp) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = false
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret,
+		config.CredentialClientID}
+	b.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	b.AmountPrecision = exchange.SatoshiPrecision
+	b.WebsocketChannels = []string{exchange.WebsocketTradeChannel, exchange.WebsocketOrderbookChannel}
+	b.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Minute*10, bitstampAuthRate),
 		request.NewRateLimit(time.Minute*10, bitstampUnauthRate),
@@ -136,8 +145,8 @@ func (b *Bitstamp) Setup(exch config.ExchangeConfig) {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitstamp) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *Bitstamp) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
@@ -145,47 +154,47 @@ func (b *Bitstamp) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 
 		b.Balance, err = b.GetBalance()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee = b.CalculateTradingFee(feeBuilder.FirstCurrency+feeBuilder.SecondCurrency, feeBuilder.PurchasePrice, feeBuilder.Amount)
 	case exchange.CyptocurrencyDepositFee:
-		fee = 0
+		fee = decimal.Zero
 	case exchange.InternationalBankDepositFee:
 		fee = getInternationalBankDepositFee(feeBuilder.Amount)
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getInternationalBankWithdrawalFee(feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
 
 // getInternationalBankWithdrawalFee returns international withdrawal fee
-func getInternationalBankWithdrawalFee(amount float64) float64 {
-	fee := amount * 0.0009
+func getInternationalBankWithdrawalFee(amount decimal.Decimal) decimal.Decimal {
+	fee := amount.Mul(decimal.NewFromFloat(0.0009))
 
-	if fee < 15 {
-		return 15
+	if fee.LessThan(decimal.NewFromInt(15)) {
+		return decimal.NewFromInt(15)
 	}
 	return fee
 }
 
 // getInternationalBankDepositFee returns international deposit fee
-func getInternationalBankDepositFee(amount float64) float64 {
-	fee := amount * 0.0005
+func getInternationalBankDepositFee(amount decimal.Decimal) decimal.Decimal {
+	fee := amount.Mul(decimal.NewFromFloat(0.0005))
 
-	if fee < 7.5 {
-		return 7.5
+	if fee.LessThan(decimal.NewFromFloat(7.5)) {
+		return decimal.NewFromFloat(7.5)
 	}
-	if fee > 300 {
-		return 300
+	if fee.GreaterThan(decimal.NewFromInt(300)) {
+		return decimal.NewFromInt(300)
 	}
 	return fee
 }
 
 // CalculateTradingFee returns fee on a currency pair
-func (b *Bitstamp) CalculateTradingFee(currency string, purchasePrice float64, amount float64) float64 {
+func (b *Bitstamp) CalculateTradingFee(currency string, purchasePrice, amount decimal.Decimal) decimal.Decimal {
 	var fee float64
 
 	switch currency {
@@ -202,7 +211,7 @@ func (b *Bitstamp) CalculateTradingFee(currency string, purchasePrice float64, a
 	default:
 		fee = 0
 	}
-	return fee * purchasePrice * amount
+	return decimal.NewFromFloat(fee).Mul(purchasePrice).Mul(amount)
 }
 
 // GetTicker returns ticker information
@@ -436,10 +445,10 @@ func (b *Bitstamp) CancelAllExistingOrders() (bool, error) {
 }
 
 // PlaceOrder places an order on the exchange.
-func (b *Bitstamp) PlaceOrder(currencyPair string, price float64, amount float64, buy, market bool) (Order, error) {
+func (b *Bitstamp) PlaceOrder(currencyPair string, price, amount decimal.Decimal, buy, market bool) (Order, error) {
 	var req = url.Values{}
-	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
-	req.Add("price", strconv.FormatFloat(price, 'f', -1, 64))
+	req.Add("amount", b.FormatOrderAmount(amount))
+	req.Add("price", b.FormatOrderPrice(price))
 	response := Order{}
 	orderType := bitstampAPIBuy
 
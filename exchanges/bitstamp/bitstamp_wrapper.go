@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -79,7 +80,7 @@ func (b *Bitstamp) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bitstamp) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Bitstamp) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return b.GetFee(feeBuilder)
 
 }
@@ -127,26 +128,26 @@ func (b *Bitstamp) GetAccountInfo() (exchange.AccountInfo, error) {
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "BTC",
-		TotalValue:   accountBalance.BTCAvailable,
-		Hold:         accountBalance.BTCReserved,
+		TotalValue:   decimal.NewFromFloat(accountBalance.BTCAvailable),
+		Hold:         decimal.NewFromFloat(accountBalance.BTCReserved),
 	})
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "XRP",
-		TotalValue:   accountBalance.XRPAvailable,
-		Hold:         accountBalance.XRPReserved,
+		TotalValue:   decimal.NewFromFloat(accountBalance.XRPAvailable),
+		Hold:         decimal.NewFromFloat(accountBalance.XRPReserved),
 	})
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "USD",
-		TotalValue:   accountBalance.USDAvailable,
-		Hold:         accountBalance.USDReserved,
+		TotalValue:   decimal.NewFromFloat(accountBalance.USDAvailable),
+		Hold:         decimal.NewFromFloat(accountBalance.USDReserved),
 	})
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "EUR",
-		TotalValue:   accountBalance.EURAvailable,
-		Hold:         accountBalance.EURReserved,
+		TotalValue:   decimal.NewFromFloat(accountBalance.EURAvailable),
+		Hold:         decimal.NewFromFloat(accountBalance.EURReserved),
 	})
 	return response, nil
 }
@@ -166,8 +167,13 @@ func (b *Bitstamp) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]
 }
 
 // SubmitOrder submits a new order
-func (b *Bitstamp) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitstamp) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := b.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	buy := side == exchange.Buy
 	market := orderType == exchange.Market
 	response, err := b.PlaceOrder(p.Pair().String(), price, amount, buy, market)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -76,6 +77,13 @@ func (b *Bittrex) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = true
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	b.PricePrecision = exchange.SatoshiPrecision
+	b.AmountPrecision = exchange.SatoshiPrecision
+	b.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second, bittrexAuthRate),
 		request.NewRateLimit(time.Second, bittrexUnauthRate),
@@ -253,12 +261,12 @@ func (b *Bittrex) GetMarketHistory(currencyPair string) (MarketHistory, error) {
 // "Currency" ie "btc-ltc"
 // "Quantity" is the amount to purchase
 // "Rate" is the rate at which to purchase
-func (b *Bittrex) PlaceBuyLimit(currencyPair string, quantity, rate float64) (UUID, error) {
+func (b *Bittrex) PlaceBuyLimit(currencyPair string, quantity, rate decimal.Decimal) (UUID, error) {
 	var id UUID
 	values := url.Values{}
 	values.Set("market", currencyPair)
-	values.Set("quantity", strconv.FormatFloat(quantity, 'E', -1, 64))
-	values.Set("rate", strconv.FormatFloat(rate, 'E', -1, 64))
+	values.Set("quantity", b.FormatOrderAmount(quantity))
+	values.Set("rate", b.FormatOrderPrice(rate))
 	path := fmt.Sprintf("%s/%s", b.APIUrl, bittrexAPIBuyLimit)
 
 	if err := b.SendAuthenticatedHTTPRequest(path, values, &id); err != nil {
@@ -277,12 +285,12 @@ func (b *Bittrex) PlaceBuyLimit(currencyPair string, quantity, rate float64) (UU
 // "Currency" ie "btc-ltc"
 // "Quantity" is the amount to purchase
 // "Rate" is the rate at which to purchase
-func (b *Bittrex) PlaceSellLimit(currencyPair string, quantity, rate float64) (UUID, error) {
+func (b *Bittrex) PlaceSellLimit(currencyPair string, quantity, rate decimal.Decimal) (UUID, error) {
 	var id UUID
 	values := url.Values{}
 	values.Set("market", currencyPair)
-	values.Set("quantity", strconv.FormatFloat(quantity, 'E', -1, 64))
-	values.Set("rate", strconv.FormatFloat(rate, 'E', -1, 64))
+	values.Set("quantity", b.FormatOrderAmount(quantity))
+	values.Set("rate", b.FormatOrderPrice(rate))
 	path := fmt.Sprintf("%s/%s", b.APIUrl, bittrexAPISellLimit)
 
 	if err := b.SendAuthenticatedHTTPRequest(path, values, &id); err != nil {
@@ -514,8 +522,8 @@ func (b *Bittrex) SendAuthenticatedHTTPRequest(path string, values url.Values, r
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bittrex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *Bittrex) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	var err error
 
 	switch feeBuilder.FeeType {
@@ -524,31 +532,31 @@ func (b *Bittrex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee, err = b.GetWithdrawalFee(feeBuilder.FirstCurrency)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, err
 }
 
 // GetWithdrawalFee returns the fee for withdrawing from the exchange
-func (b *Bittrex) GetWithdrawalFee(currency string) (float64, error) {
-	var fee float64
+func (b *Bittrex) GetWithdrawalFee(currency string) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	currencies, err := b.GetCurrencies()
 	if err != nil {
-		return 0, err
+		return decimal.Zero, err
 	}
 	for _, result := range currencies.Result {
 		if result.Currency == currency {
-			fee = result.TxFee
+			fee = decimal.NewFromFloat(result.TxFee)
 		}
 	}
 	return fee, nil
 }
 
 // calculateTradingFee returns the fee for trading any currency on Bittrex
-func calculateTradingFee(purchasePrice float64, amount float64) float64 {
-	var fee = 0.0025
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	var fee = decimal.NewFromFloat(0.0025)
 
-	return fee * purchasePrice * amount
+	return fee.Mul(purchasePrice).Mul(amount)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -73,8 +74,8 @@ func (b *Bittrex) GetAccountInfo() (exchange.AccountInfo, error) {
 	for i := 0; i < len(accountBalance.Result); i++ {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = accountBalance.Result[i].Currency
-		exchangeCurrency.TotalValue = accountBalance.Result[i].Balance
-		exchangeCurrency.Hold = accountBalance.Result[i].Balance - accountBalance.Result[i].Available
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance.Result[i].Balance)
+		exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance.Result[i].Balance).Sub(decimal.NewFromFloat(accountBalance.Result[i].Available))
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
 	return response, nil
@@ -169,11 +170,15 @@ func (b *Bittrex) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]e
 }
 
 // SubmitOrder submits a new order
-func (b *Bittrex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bittrex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := b.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	buy := side == exchange.Buy
 	var response UUID
-	var err error
 
 	if orderType != exchange.Limit {
 		return submitOrderResponse, errors.New("not supported on exchange")
@@ -249,7 +254,7 @@ func (b *Bittrex) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bittrex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Bittrex) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return b.GetFee(feeBuilder)
 
 }
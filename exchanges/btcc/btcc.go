@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -40,6 +41,12 @@ func (b *BTCC) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = false
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.WebsocketChannels = []string{exchange.WebsocketOrderbookChannel, exchange.WebsocketTickerChannel}
+	b.UnsupportedFunctions = []string{"GetAccountInfo", "GetFundingHistory",
+		"GetExchangeHistory", "ModifyOrder", "CancelAllOrders", "GetOrderInfo",
+		"GetDepositAddress", "WithdrawCryptocurrencyFunds", "WithdrawFiatFunds",
+		"SubmitOrder", "CancelOrder"}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second, btccAuthRate),
 		request.NewRateLimit(time.Second, btccUnauthRate),
@@ -95,8 +102,8 @@ func (b *BTCC) Setup(exch config.ExchangeConfig) {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *BTCC) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *BTCC) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyWithdrawalFee:
@@ -104,19 +111,19 @@ func (b *BTCC) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getInternationalBankWithdrawalFee(feeBuilder.CurrencyItem, feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
 
-func getCryptocurrencyWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getCryptocurrencyWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
-	fee = WithdrawalFees[currency] * amount
+	fee = decimal.NewFromFloat(WithdrawalFees[currency]).Mul(amount)
 	return fee
 }
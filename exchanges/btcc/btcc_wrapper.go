@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -131,7 +132,7 @@ func (b *BTCC) GetAccountInfo() (exchange.AccountInfo, error) {
 	// var response exchange.AccountInfo
 	// response.ExchangeName = b.GetName()
 	// return response, nil
-	return exchange.AccountInfo{}, errors.New("REST NOT SUPPORTED")
+	return exchange.AccountInfo{}, common.ErrFunctionNotSupported
 }
 
 // GetFundingHistory returns funding history, deposits and
@@ -139,7 +140,7 @@ func (b *BTCC) GetAccountInfo() (exchange.AccountInfo, error) {
 func (b *BTCC) GetFundingHistory() ([]exchange.FundHistory, error) {
 	// var fundHistory []exchange.FundHistory
 	// return fundHistory, common.ErrFunctionNotSupported
-	return nil, errors.New("REST NOT SUPPORTED")
+	return nil, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
@@ -147,11 +148,11 @@ func (b *BTCC) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exch
 	// var resp []exchange.TradeHistory
 
 	// return resp, common.ErrNotYetImplemented
-	return nil, errors.New("REST NOT SUPPORTED")
+	return nil, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (b *BTCC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *BTCC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	return submitOrderResponse, common.ErrNotYetImplemented
@@ -208,7 +209,7 @@ func (b *BTCC) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *BTCC) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *BTCC) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return b.GetFee(feeBuilder)
 }
 
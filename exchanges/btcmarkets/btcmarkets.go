@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -66,6 +67,12 @@ func (b *BTCMarkets) SetDefaults() {
 	b.AssetTypes = []string{ticker.Spot}
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = false
+	b.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	b.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	b.PricePrecision = exchange.SatoshiPrecision
+	b.AmountPrecision = exchange.SatoshiPrecision
+	b.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "GetDepositAddress", "GetWebsocket"}
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second*10, btcmarketsAuthLimit),
 		request.NewRateLimit(time.Second*10, btcmarketsUnauthLimit),
@@ -179,9 +186,9 @@ func (b *BTCMarkets) GetTrades(firstPair, secondPair string, values url.Values)
 // orderside - example "Bid" or "Ask"
 // orderType - example "limit"
 // clientReq - example "abc-cdf-1000"
-func (b *BTCMarkets) NewOrder(currency, instrument string, price, amount float64, orderSide, orderType, clientReq string) (int64, error) {
-	newPrice := int64(price * float64(common.SatoshisPerBTC))
-	newVolume := int64(amount * float64(common.SatoshisPerBTC))
+func (b *BTCMarkets) NewOrder(currency, instrument string, price, amount decimal.Decimal, orderSide, orderType, clientReq string) (int64, error) {
+	newPrice := price.Mul(decimal.NewFromInt(common.SatoshisPerBTC)).IntPart()
+	newVolume := amount.Mul(decimal.NewFromInt(common.SatoshisPerBTC)).IntPart()
 
 	order := OrderToGo{
 		Currency:        common.StringToUpper(currency),
@@ -449,14 +456,14 @@ func (b *BTCMarkets) SendAuthenticatedRequest(reqType, path string, data interfa
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *BTCMarkets) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (b *BTCMarkets) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		tradingFee, err := b.GetTradingFee(feeBuilder.FirstCurrency, feeBuilder.SecondCurrency)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee = calculateTradingFee(feeBuilder.FirstCurrency+feeBuilder.Delimiter+feeBuilder.SecondCurrency, tradingFee, feeBuilder.PurchasePrice, feeBuilder.Amount)
 	case exchange.CryptocurrencyWithdrawalFee:
@@ -464,27 +471,27 @@ func (b *BTCMarkets) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getInternationalBankWithdrawalFee(feeBuilder.CurrencyItem, feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 	return fee, nil
 }
 
-func calculateTradingFee(curr string, tradingFee TradingFee, purchasePrice, amount float64) (fee float64) {
-	fee = tradingFee.TradingFeeRate / 100000000
+func calculateTradingFee(curr string, tradingFee TradingFee, purchasePrice, amount decimal.Decimal) (fee decimal.Decimal) {
+	fee = decimal.NewFromFloat(tradingFee.TradingFeeRate).Div(decimal.NewFromInt(100000000))
 
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getCryptocurrencyWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getCryptocurrencyWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
 	if currency == symbol.AUD {
-		fee = 0
+		fee = decimal.Zero
 	}
 	return fee
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -78,6 +79,14 @@ func (c *CoinbasePro) SetDefaults() {
 	c.AssetTypes = []string{ticker.Spot}
 	c.SupportsAutoPairUpdating = true
 	c.SupportsRESTTickerBatching = false
+	c.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret,
+		config.CredentialClientID}
+	c.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	c.AmountPrecision = exchange.SatoshiPrecision
+	c.WebsocketChannels = []string{exchange.WebsocketTickerChannel, exchange.WebsocketOrderbookChannel}
+	c.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	c.Requester = request.New(c.Name,
 		request.NewRateLimit(time.Second, coinbaseproAuthRate),
 		request.NewRateLimit(time.Second, coinbaseproUnauthRate),
@@ -364,12 +373,12 @@ func (c *CoinbasePro) GetHolds(accountID string) ([]AccountHolds, error) {
 // timeInforce - [optional] GTC, GTT, IOC, or FOK (default is GTC)
 // cancelAfter - [optional] min, hour, day * Requires time_in_force to be GTT
 // postOnly - [optional] Post only flag Invalid when time_in_force is IOC or FOK
-func (c *CoinbasePro) PlaceLimitOrder(clientRef string, price, amount float64, side, timeInforce, cancelAfter, productID, stp string, postOnly bool) (string, error) {
+func (c *CoinbasePro) PlaceLimitOrder(clientRef string, price, amount decimal.Decimal, side, timeInforce, cancelAfter, productID, stp string, postOnly bool) (string, error) {
 	resp := GeneralizedOrderResponse{}
 	request := make(map[string]interface{})
 	request["type"] = "limit"
-	request["price"] = strconv.FormatFloat(price, 'f', -1, 64)
-	request["size"] = strconv.FormatFloat(amount, 'f', -1, 64)
+	request["price"] = c.FormatOrderPrice(price)
+	request["size"] = c.FormatOrderAmount(amount)
 	request["side"] = side
 	request["product_id"] = productID
 
@@ -456,18 +465,18 @@ func (c *CoinbasePro) PlaceMarketOrder(clientRef string, size, funds float64, si
 // MARGIN ORDER PARAMS
 // size - [optional]* Desired amount in BTC
 // funds - [optional]* Desired amount of quote currency to use
-func (c *CoinbasePro) PlaceMarginOrder(clientRef string, size, funds float64, side string, productID, stp string) (string, error) {
+func (c *CoinbasePro) PlaceMarginOrder(clientRef string, size, funds decimal.Decimal, side string, productID, stp string) (string, error) {
 	resp := GeneralizedOrderResponse{}
 	request := make(map[string]interface{})
 	request["side"] = side
 	request["product_id"] = productID
 	request["type"] = "margin"
 
-	if size != 0 {
-		request["size"] = strconv.FormatFloat(size, 'f', -1, 64)
+	if !size.IsZero() {
+		request["size"] = c.FormatOrderAmount(size)
 	}
-	if funds != 0 {
-		request["funds"] = strconv.FormatFloat(funds, 'f', -1, 64)
+	if !funds.IsZero() {
+		request["funds"] = funds.String()
 	}
 	if clientRef != "" {
 		request["client_oid"] = clientRef
@@ -825,13 +834,13 @@ func (c *CoinbasePro) SendAuthenticatedHTTPRequest(method, path string, params m
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (c *CoinbasePro) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (c *CoinbasePro) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		trailingVolume, err := c.GetTrailingVolume()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee = c.calculateTradingFee(trailingVolume, feeBuilder.FirstCurrency, feeBuilder.Delimiter, feeBuilder.SecondCurrency, feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
 	case exchange.InternationalBankWithdrawalFee:
@@ -840,52 +849,52 @@ func (c *CoinbasePro) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 		fee = getInternationalBankDepositFee(feeBuilder.CurrencyItem, feeBuilder.Amount)
 	}
 
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func (c *CoinbasePro) calculateTradingFee(trailingVolume []Volume, firstCurrency, delimiter, secondCurrency string, purchasePrice, amount float64, isMaker bool) float64 {
-	var fee float64
+func (c *CoinbasePro) calculateTradingFee(trailingVolume []Volume, firstCurrency, delimiter, secondCurrency string, purchasePrice, amount decimal.Decimal, isMaker bool) decimal.Decimal {
+	var fee decimal.Decimal
 	for _, i := range trailingVolume {
 		if strings.EqualFold(i.ProductID, firstCurrency+delimiter+secondCurrency) {
 			if isMaker {
-				fee = 0
+				fee = decimal.Zero
 			} else if i.Volume <= 10000000 {
-				fee = 0.003
+				fee = decimal.NewFromFloat(0.003)
 			} else if i.Volume > 10000000 && i.Volume <= 100000000 {
-				fee = 0.002
+				fee = decimal.NewFromFloat(0.002)
 			} else if i.Volume > 100000000 {
-				fee = 0.001
+				fee = decimal.NewFromFloat(0.001)
 			}
 			break
 		}
 	}
 
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
 	if currency == symbol.USD {
-		fee = 25
+		fee = decimal.NewFromInt(25)
 	} else if currency == symbol.EUR {
-		fee = 0.15
+		fee = decimal.NewFromFloat(0.15)
 	}
 
 	return fee
 }
 
-func getInternationalBankDepositFee(currency string, amount float64) float64 {
-	var fee float64
+func getInternationalBankDepositFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
 	if currency == symbol.USD {
-		fee = 10
+		fee = decimal.NewFromInt(10)
 	} else if currency == symbol.EUR {
-		fee = 0.15
+		fee = decimal.NewFromFloat(0.15)
 	}
 
 	return fee
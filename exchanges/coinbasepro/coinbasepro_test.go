@@ -7,6 +7,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 )
 
@@ -111,7 +112,7 @@ func TestAuthRequests(t *testing.T) {
 			t.Error("Test failed - GetHolds() error", err)
 		}
 
-		_, err = c.PlaceLimitOrder("", 0, 0, "buy", "", "", "BTC-USD", "", false)
+		_, err = c.PlaceLimitOrder("", decimal.Zero, decimal.Zero, "buy", "", "", "BTC-USD", "", false)
 		if err == nil {
 			t.Error("Test failed - PlaceLimitOrder() error", err)
 		}
@@ -224,13 +225,13 @@ func TestAuthRequests(t *testing.T) {
 
 func setFeeBuilder() exchange.FeeBuilder {
 	return exchange.FeeBuilder{
-		Amount:         1,
+		Amount:         decimal.NewFromFloat(1),
 		Delimiter:      "",
 		FeeType:        exchange.CryptocurrencyTradeFee,
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 		IsMaker:        false,
-		PurchasePrice:  1,
+		PurchasePrice:  decimal.NewFromFloat(1),
 	}
 }
 
@@ -242,33 +243,33 @@ func TestGetFee(t *testing.T) {
 
 	if apiKey != "" || apiSecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0.003) || err != nil {
+		if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.003)) || err != nil {
 			t.Error(err)
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.003), resp)
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.003), resp)
 		}
 
 		// CryptocurrencyTradeFee High quantity
 		feeBuilder = setFeeBuilder()
-		feeBuilder.Amount = 1000
-		feeBuilder.PurchasePrice = 1000
-		if resp, err := c.GetFee(feeBuilder); resp != float64(3000) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(3000), resp)
+		feeBuilder.Amount = decimal.NewFromFloat(1000)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(1000)
+		if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(3000)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(3000), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.01), resp)
+		if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.01), resp)
 			t.Error(err)
 		}
 
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
-		feeBuilder.PurchasePrice = -1000
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+		feeBuilder.PurchasePrice = decimal.NewFromFloat(-1000)
+		if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 			t.Error(err)
 		}
 	}
@@ -276,16 +277,16 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -293,8 +294,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.EUR
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0.15) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(0.15)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 
@@ -302,8 +303,8 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := c.GetFee(feeBuilder); resp != float64(25) || err != nil {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp, err := c.GetFee(feeBuilder); !resp.Equal(decimal.NewFromFloat(25)) || err != nil {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 		t.Error(err)
 	}
 }
@@ -318,8 +319,8 @@ func TestCalculateTradingFee(t *testing.T) {
 		},
 	}
 
-	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", 1, 1, false); resp != float64(0.003) {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.003), resp)
+	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", decimal.NewFromFloat(1), decimal.NewFromFloat(1), false); !resp.Equal(decimal.NewFromFloat(0.003)) {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.003), resp)
 	}
 
 	// lowercase
@@ -330,8 +331,8 @@ func TestCalculateTradingFee(t *testing.T) {
 		},
 	}
 
-	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", 1, 1, false); resp != float64(0.003) {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.003), resp)
+	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", decimal.NewFromFloat(1), decimal.NewFromFloat(1), false); !resp.Equal(decimal.NewFromFloat(0.003)) {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.003), resp)
 	}
 
 	// mixedCase
@@ -342,8 +343,8 @@ func TestCalculateTradingFee(t *testing.T) {
 		},
 	}
 
-	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", 1, 1, false); resp != float64(0.003) {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.003), resp)
+	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", decimal.NewFromFloat(1), decimal.NewFromFloat(1), false); !resp.Equal(decimal.NewFromFloat(0.003)) {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.003), resp)
 	}
 
 	// medium volume
@@ -354,8 +355,8 @@ func TestCalculateTradingFee(t *testing.T) {
 		},
 	}
 
-	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", 1, 1, false); resp != float64(0.002) {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.002), resp)
+	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", decimal.NewFromFloat(1), decimal.NewFromFloat(1), false); !resp.Equal(decimal.NewFromFloat(0.002)) {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.002), resp)
 	}
 
 	// high volume
@@ -366,8 +367,8 @@ func TestCalculateTradingFee(t *testing.T) {
 		},
 	}
 
-	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", 1, 1, false); resp != float64(0.001) {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
+	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", decimal.NewFromFloat(1), decimal.NewFromFloat(1), false); !resp.Equal(decimal.NewFromFloat(0.001)) {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0.001), resp)
 	}
 
 	// no match
@@ -378,8 +379,8 @@ func TestCalculateTradingFee(t *testing.T) {
 		},
 	}
 
-	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", 1, 1, false); resp != float64(0) {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", decimal.NewFromFloat(1), decimal.NewFromFloat(1), false); !resp.Equal(decimal.NewFromFloat(0)) {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 	}
 
 	// taker
@@ -390,8 +391,8 @@ func TestCalculateTradingFee(t *testing.T) {
 		},
 	}
 
-	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", 1, 1, true); resp != float64(0) {
-		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
+	if resp := c.calculateTradingFee(volume, "btc", "_", "usd", decimal.NewFromFloat(1), decimal.NewFromFloat(1), true); !resp.Equal(decimal.NewFromFloat(0)) {
+		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %v", float64(0), resp)
 	}
 }
 
@@ -431,7 +432,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := c.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 1, "clientId")
+	response, err := c.SubmitOrder(p, exchange.Buy, exchange.Limit, decimal.NewFromFloat(1), decimal.NewFromFloat(1), "clientId")
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
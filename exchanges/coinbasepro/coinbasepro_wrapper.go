@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -58,8 +59,8 @@ func (c *CoinbasePro) GetAccountInfo() (exchange.AccountInfo, error) {
 	for i := 0; i < len(accountBalance); i++ {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = accountBalance[i].Currency
-		exchangeCurrency.TotalValue = accountBalance[i].Available
-		exchangeCurrency.Hold = accountBalance[i].Hold
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance[i].Available)
+		exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance[i].Hold)
 
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
@@ -144,10 +145,14 @@ func (c *CoinbasePro) GetExchangeHistory(p pair.CurrencyPair, assetType string)
 }
 
 // SubmitOrder submits a new order
-func (c *CoinbasePro) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (c *CoinbasePro) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := c.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var response string
-	var err error
 	if orderType == exchange.Market {
 		response, err = c.PlaceMarginOrder("", amount, amount, side.ToString(), p.Pair().String(), "")
 
@@ -213,7 +218,7 @@ func (c *CoinbasePro) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (c *CoinbasePro) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (c *CoinbasePro) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return c.GetFee(feeBuilder)
 }
 
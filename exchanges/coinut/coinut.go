@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -64,6 +65,13 @@ func (c *COINUT) SetDefaults() {
 	c.AssetTypes = []string{ticker.Spot}
 	c.SupportsAutoPairUpdating = true
 	c.SupportsRESTTickerBatching = false
+	c.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret,
+		config.CredentialClientID}
+	c.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	c.WebsocketChannels = []string{exchange.WebsocketTickerChannel, exchange.WebsocketOrderbookChannel}
+	c.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	c.Requester = request.New(c.Name,
 		request.NewRateLimit(time.Second, coinutAuthRate),
 		request.NewRateLimit(time.Second, coinutUnauthRate),
@@ -167,14 +175,14 @@ func (c *COINUT) GetUserBalance() (UserBalance, error) {
 }
 
 // NewOrder places a new order on the exchange
-func (c *COINUT) NewOrder(instrumentID int, quantity, price float64, buy bool, orderID uint32) (interface{}, error) {
+func (c *COINUT) NewOrder(instrumentID int, quantity, price decimal.Decimal, buy bool, orderID uint32) (interface{}, error) {
 	var result interface{}
 	params := make(map[string]interface{})
 	params["inst_id"] = instrumentID
-	if price > 0 {
-		params["price"] = fmt.Sprintf("%v", price)
+	if price.IsPositive() {
+		params["price"] = c.FormatOrderPrice(price)
 	}
-	params["qty"] = fmt.Sprintf("%v", quantity)
+	params["qty"] = c.FormatOrderAmount(quantity)
 	params["side"] = "BUY"
 	if !buy {
 		params["side"] = "SELL"
@@ -357,8 +365,8 @@ func (c *COINUT) SendHTTPRequest(apiRequest string, params map[string]interface{
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (c *COINUT) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (c *COINUT) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = c.calculateTradingFee(feeBuilder.FirstCurrency, feeBuilder.SecondCurrency, feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
@@ -368,67 +376,67 @@ func (c *COINUT) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 		fee = getInternationalBankDepositFee(feeBuilder.CurrencyItem, feeBuilder.Amount)
 	}
 
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func (c *COINUT) calculateTradingFee(firstCurrency, secondCurrency string, purchasePrice, amount float64, isMaker bool) float64 {
-	var fee float64
+func (c *COINUT) calculateTradingFee(firstCurrency, secondCurrency string, purchasePrice, amount decimal.Decimal, isMaker bool) decimal.Decimal {
+	var fee decimal.Decimal
 	if isMaker {
-		fee = 0
+		fee = decimal.Zero
 	} else if currency.IsCryptocurrency(firstCurrency) && !currency.IsCryptocurrency(secondCurrency) ||
 		!currency.IsCryptocurrency(firstCurrency) && currency.IsCryptocurrency(secondCurrency) {
-		fee = 0.002
+		fee = decimal.NewFromFloat(0.002)
 	} else {
-		fee = 0.001
+		fee = decimal.NewFromFloat(0.001)
 	}
 
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
 	if currency == symbol.USD {
-		if amount*0.001 < 10 {
-			fee = 10
+		if amount.Mul(decimal.NewFromFloat(0.001)).LessThan(decimal.NewFromInt(10)) {
+			fee = decimal.NewFromInt(10)
 		} else {
-			fee = amount * 0.001
+			fee = amount.Mul(decimal.NewFromFloat(0.001))
 		}
 	} else if currency == symbol.CAD {
-		if amount*0.005 < 10 {
-			fee = 2
+		if amount.Mul(decimal.NewFromFloat(0.005)).LessThan(decimal.NewFromInt(10)) {
+			fee = decimal.NewFromInt(2)
 		} else {
-			fee = amount * 0.005
+			fee = amount.Mul(decimal.NewFromFloat(0.005))
 		}
 	} else if currency == symbol.SGD {
-		if amount*0.001 < 10 {
-			fee = 10
+		if amount.Mul(decimal.NewFromFloat(0.001)).LessThan(decimal.NewFromInt(10)) {
+			fee = decimal.NewFromInt(10)
 		} else {
-			fee = amount * 0.001
+			fee = amount.Mul(decimal.NewFromFloat(0.001))
 		}
 	}
 
 	return fee
 }
 
-func getInternationalBankDepositFee(currency string, amount float64) float64 {
-	var fee float64
+func getInternationalBankDepositFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 
 	if currency == symbol.USD {
-		if amount*0.001 < 10 {
-			fee = 10
+		if amount.Mul(decimal.NewFromFloat(0.001)).LessThan(decimal.NewFromInt(10)) {
+			fee = decimal.NewFromInt(10)
 		} else {
-			fee = amount * 0.001
+			fee = amount.Mul(decimal.NewFromFloat(0.001))
 		}
 	} else if currency == symbol.CAD {
-		if amount*0.005 < 10 {
-			fee = 2
+		if amount.Mul(decimal.NewFromFloat(0.005)).LessThan(decimal.NewFromInt(10)) {
+			fee = decimal.NewFromInt(2)
 		} else {
-			fee = amount * 0.005
+			fee = amount.Mul(decimal.NewFromFloat(0.005))
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -63,8 +64,8 @@ func (c *COINUT) GetAccountInfo() (exchange.AccountInfo, error) {
 		for i := 0; i < len(accountBalance); i++ {
 			var exchangeCurrency exchange.AccountCurrencyInfo
 			exchangeCurrency.CurrencyName = accountBalance[i].Currency
-			exchangeCurrency.TotalValue = accountBalance[i].Available
-			exchangeCurrency.Hold = accountBalance[i].Hold
+			exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance[i].Available)
+			exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance[i].Hold)
 
 			response.Currencies = append(response.Currencies, exchangeCurrency)
 		}
@@ -144,9 +145,13 @@ func (c *COINUT) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]ex
 }
 
 // SubmitOrder submits a new order
-func (c *COINUT) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (c *COINUT) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	var err error
+	amount, price, err := c.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var APIresponse interface{}
 	isBuyOrder := side == exchange.Buy
 	clientIDInt, err := strconv.ParseUint(clientID, 0, 32)
@@ -167,7 +172,7 @@ func (c *COINUT) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 	if orderType == exchange.Limit {
 		APIresponse, err = c.NewOrder(currencyID, amount, price, isBuyOrder, clientIDUint)
 	} else if orderType == exchange.Market {
-		APIresponse, err = c.NewOrder(currencyID, amount, 0, isBuyOrder, clientIDUint)
+		APIresponse, err = c.NewOrder(currencyID, amount, decimal.Zero, isBuyOrder, clientIDUint)
 	} else {
 		return submitOrderResponse, errors.New("unsupported order type")
 	}
@@ -260,7 +265,7 @@ func (c *COINUT) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (c *COINUT) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (c *COINUT) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return c.GetFee(feeBuilder)
 }
 
@@ -13,6 +13,8 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/currency/registry"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
@@ -27,6 +29,9 @@ const (
 	ErrExchangeNotFound = "Exchange not found in dataset"
 	// DefaultHTTPTimeout is the default HTTP/HTTPS Timeout for exchange requests
 	DefaultHTTPTimeout = time.Second * 15
+	// SatoshiPrecision is the number of decimal places used for order prices
+	// and amounts by exchanges which accept satoshi precision for every pair
+	SatoshiPrecision = 8
 )
 
 // FeeType custom type for calculating fees based on method
@@ -85,8 +90,8 @@ type FeeBuilder struct {
 	CurrencyItem        string
 	BankTransactionType InternationalBankTransactionType
 	// Used to multiply for fee calculations
-	PurchasePrice float64
-	Amount        float64
+	PurchasePrice decimal.Decimal
+	Amount        decimal.Decimal
 }
 
 // OrderCancellation type requred when requesting to cancel an order
@@ -153,8 +158,8 @@ type AccountInfo struct {
 // AccountCurrencyInfo is a sub type to store currency name and value
 type AccountCurrencyInfo struct {
 	CurrencyName string
-	TotalValue   float64
-	Hold         float64
+	TotalValue   decimal.Decimal
+	Hold         decimal.Decimal
 }
 
 // TradeHistory holds exchange history data
@@ -219,6 +224,14 @@ type Base struct {
 	PairsLastUpdated                           int64
 	SupportsAutoPairUpdating                   bool
 	SupportsRESTTickerBatching                 bool
+	RequiredCredentials                        []string
+	SupportedOrderTypes                        []OrderType
+	WebsocketChannels                          []string
+	KlineIntervals                             []string
+	UnsupportedFunctions                       []string
+	PricePrecision, AmountPrecision            int32
+	marketRules                                map[string]MarketRules
+	marketRulesMtx                             sync.RWMutex
 	HTTPTimeout                                time.Duration
 	HTTPUserAgent                              string
 	WebsocketURL                               string
@@ -255,13 +268,16 @@ type IBotExchange interface {
 	SupportsAutoPairUpdates() bool
 	GetLastPairsUpdateTime() int64
 	SupportsRESTTickerBatchUpdates() bool
+	GetCapabilities() Capabilities
 
 	GetWithdrawPermissions() uint32
 	FormatWithdrawPermissions() string
 	SupportsWithdrawPermissions(permissions uint32) bool
 
 	GetFundingHistory() ([]FundHistory, error)
-	SubmitOrder(p pair.CurrencyPair, side OrderSide, orderType OrderType, amount, price float64, clientID string) (SubmitOrderResponse, error)
+	GetMarketRules(p pair.CurrencyPair) (MarketRules, bool)
+	ValidateOrder(p pair.CurrencyPair, orderType OrderType, amount, price decimal.Decimal) (decimal.Decimal, decimal.Decimal, error)
+	SubmitOrder(p pair.CurrencyPair, side OrderSide, orderType OrderType, amount, price decimal.Decimal, clientID string) (SubmitOrderResponse, error)
 	ModifyOrder(orderID int64, modify ModifyOrder) (int64, error)
 	CancelOrder(order OrderCancellation) error
 	CancelAllOrders() error
@@ -274,6 +290,45 @@ type IBotExchange interface {
 	GetWebsocket() (*Websocket, error)
 }
 
+// RoundOrderPrice rounds an order price to the decimal places the exchange
+// accepts, the price is returned unchanged when the precision isn't set
+func (e *Base) RoundOrderPrice(price decimal.Decimal) decimal.Decimal {
+	if e.PricePrecision <= 0 {
+		return price
+	}
+	return price.Round(e.PricePrecision)
+}
+
+// RoundOrderAmount rounds an order amount down to the decimal places the
+// exchange accepts, so the amount never exceeds the balance it was taken from.
+// The amount is returned unchanged when the precision isn't set
+func (e *Base) RoundOrderAmount(amount decimal.Decimal) decimal.Decimal {
+	if e.AmountPrecision <= 0 {
+		return amount
+	}
+	return amount.Truncate(e.AmountPrecision)
+}
+
+// FormatOrderPrice returns an order price as it is sent to the exchange, with
+// the decimal places the exchange accepts or without trailing zeros when the
+// precision isn't set
+func (e *Base) FormatOrderPrice(price decimal.Decimal) string {
+	if e.PricePrecision <= 0 {
+		return price.String()
+	}
+	return price.StringFixed(e.PricePrecision)
+}
+
+// FormatOrderAmount returns an order amount as it is sent to the exchange,
+// with the decimal places the exchange accepts or without trailing zeros when
+// the precision isn't set
+func (e *Base) FormatOrderAmount(amount decimal.Decimal) string {
+	if e.AmountPrecision <= 0 {
+		return amount.String()
+	}
+	return amount.Truncate(e.AmountPrecision).StringFixed(e.AmountPrecision)
+}
+
 // SupportsRESTTickerBatchUpdates returns whether or not the
 // exhange supports REST batch ticker fetching
 func (e *Base) SupportsRESTTickerBatchUpdates() bool {
@@ -590,11 +645,13 @@ func GetAndFormatExchangeCurrencies(exchName string, pairs []pair.CurrencyPair)
 }
 
 // FormatExchangeCurrency is a method that formats and returns a currency pair
-// based on the user currency display preferences
+// based on the user currency display preferences, currencies are converted to
+// the codes the exchange uses
 func FormatExchangeCurrency(exchName string, p pair.CurrencyPair) pair.CurrencyItem {
 	cfg := config.GetConfig()
 	exch, _ := cfg.GetExchangeConfig(exchName)
 
+	p = registry.ExchangePair(exchName, p)
 	return p.Display(exch.RequestCurrencyPairFormat.Delimiter,
 		exch.RequestCurrencyPairFormat.Uppercase)
 }
@@ -730,8 +787,8 @@ func (e *Base) UpdateCurrencies(exchangeProducts []string, enabled, force bool)
 type ModifyOrder struct {
 	OrderType
 	OrderSide
-	Price  float64
-	Amount float64
+	Price  decimal.Decimal
+	Amount decimal.Decimal
 }
 
 // Format holds exchange formatting
@@ -822,6 +879,16 @@ func (e *Base) SupportsWithdrawPermissions(permissions uint32) bool {
 
 // FormatWithdrawPermissions will return each of the exchange's compatible withdrawal methods in readable form
 func (e *Base) FormatWithdrawPermissions() string {
+	services := e.withdrawalMethods()
+	if len(services) > 0 {
+		return strings.Join(services, " & ")
+	}
+
+	return NoAPIWithdrawalMethodsText
+}
+
+// withdrawalMethods returns the text of each withdrawal permission
+func (e *Base) withdrawalMethods() []string {
 	services := []string{}
 	for i := 0; i < 32; i++ {
 		var check uint32 = 1 << uint32(i)
@@ -868,9 +935,5 @@ func (e *Base) FormatWithdrawPermissions() string {
 			}
 		}
 	}
-	if len(services) > 0 {
-		return strings.Join(services, " & ")
-	}
-
-	return NoAPIWithdrawalMethodsText
+	return services
 }
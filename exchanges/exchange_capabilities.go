@@ -0,0 +1,78 @@
+package exchange
+
+// Websocket channels an exchange subscribes to
+const (
+	WebsocketTickerChannel    = "ticker"
+	WebsocketOrderbookChannel = "orderbook"
+	WebsocketTradeChannel     = "trades"
+	WebsocketKlineChannel     = "kline"
+)
+
+// Capabilities describes what an exchange supports so callers can check for
+// support up front instead of receiving ErrNotYetImplemented or
+// ErrFunctionNotSupported from the wrapper
+type Capabilities struct {
+	Exchange             string      `json:"exchange"`
+	RequiredCredentials  []string    `json:"requiredCredentials"`
+	OrderTypes           []OrderType `json:"orderTypes"`
+	WebsocketChannels    []string    `json:"websocketChannels"`
+	WithdrawalMethods    []string    `json:"withdrawalMethods"`
+	RESTTickerBatching   bool        `json:"restTickerBatching"`
+	AutoPairUpdates      bool        `json:"autoPairUpdates"`
+	KlineIntervals       []string    `json:"klineIntervals"`
+	AssetTypes           []string    `json:"assetTypes"`
+	UnsupportedFunctions []string    `json:"unsupportedFunctions"`
+	PricePrecision       int32       `json:"pricePrecision"`
+	AmountPrecision      int32       `json:"amountPrecision"`
+}
+
+// GetCapabilities returns the capabilities declared by the exchange in
+// SetDefaults
+func (e *Base) GetCapabilities() Capabilities {
+	return Capabilities{
+		Exchange:             e.Name,
+		RequiredCredentials:  e.RequiredCredentials,
+		OrderTypes:           e.SupportedOrderTypes,
+		WebsocketChannels:    e.WebsocketChannels,
+		WithdrawalMethods:    e.withdrawalMethods(),
+		RESTTickerBatching:   e.SupportsRESTTickerBatching,
+		AutoPairUpdates:      e.SupportsAutoPairUpdating,
+		KlineIntervals:       e.KlineIntervals,
+		AssetTypes:           e.AssetTypes,
+		UnsupportedFunctions: e.UnsupportedFunctions,
+		PricePrecision:       e.PricePrecision,
+		AmountPrecision:      e.AmountPrecision,
+	}
+}
+
+// SupportsOrderType returns whether orders of the type can be submitted
+func (c *Capabilities) SupportsOrderType(orderType OrderType) bool {
+	for i := range c.OrderTypes {
+		if c.OrderTypes[i] == orderType {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsFunction returns whether the IBotExchange function is implemented
+// by the exchange, for example "CancelAllOrders"
+func (c *Capabilities) SupportsFunction(function string) bool {
+	for i := range c.UnsupportedFunctions {
+		if c.UnsupportedFunctions[i] == function {
+			return false
+		}
+	}
+	return true
+}
+
+// SupportsWebsocketChannel returns whether the exchange websocket subscribes
+// to the channel
+func (c *Capabilities) SupportsWebsocketChannel(channel string) bool {
+	for i := range c.WebsocketChannels {
+		if c.WebsocketChannels[i] == channel {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+func TestGetCapabilities(t *testing.T) {
+	b := Base{
+		Name:                       "TESTNAME",
+		APIWithdrawPermissions:     AutoWithdrawCrypto | WithdrawFiatViaWebsiteOnly,
+		AssetTypes:                 []string{"SPOT"},
+		SupportsRESTTickerBatching: true,
+		RequiredCredentials:        []string{config.CredentialAPIKey, config.CredentialAPISecret},
+		SupportedOrderTypes:        []OrderType{Limit},
+		WebsocketChannels:          []string{WebsocketTickerChannel},
+		KlineIntervals:             []string{"1m"},
+		UnsupportedFunctions:       []string{"CancelAllOrders"},
+	}
+
+	c := b.GetCapabilities()
+	if c.Exchange != "TESTNAME" || !c.RESTTickerBatching || c.AutoPairUpdates ||
+		len(c.AssetTypes) != 1 || len(c.RequiredCredentials) != 2 ||
+		len(c.KlineIntervals) != 1 {
+		t.Error("Test failed. GetCapabilities unexpected capabilities", c)
+	}
+
+	if len(c.WithdrawalMethods) != 2 ||
+		c.WithdrawalMethods[0] != AutoWithdrawCryptoText ||
+		c.WithdrawalMethods[1] != WithdrawFiatViaWebsiteOnlyText {
+		t.Error("Test failed. GetCapabilities unexpected withdrawal methods",
+			c.WithdrawalMethods)
+	}
+
+	if !c.SupportsOrderType(Limit) || c.SupportsOrderType(Market) {
+		t.Error("Test failed. SupportsOrderType unexpected result")
+	}
+
+	if c.SupportsFunction("CancelAllOrders") || !c.SupportsFunction("CancelOrder") {
+		t.Error("Test failed. SupportsFunction unexpected result")
+	}
+
+	if !c.SupportsWebsocketChannel(WebsocketTickerChannel) ||
+		c.SupportsWebsocketChannel(WebsocketKlineChannel) {
+		t.Error("Test failed. SupportsWebsocketChannel unexpected result")
+	}
+}
@@ -0,0 +1,101 @@
+package exchange
+
+import (
+	"errors"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
+)
+
+// Order validation errors returned when an order breaks the market rules of
+// its currency pair
+var (
+	ErrOrderAmountBelowMinimum   = errors.New("order amount is below the minimum for the currency pair")
+	ErrOrderAmountAboveMaximum   = errors.New("order amount is above the maximum for the currency pair")
+	ErrOrderNotionalBelowMinimum = errors.New("order value is below the minimum notional for the currency pair")
+)
+
+// MarketRules stores the trading rules an exchange publishes for a currency
+// pair, zero values are not enforced
+type MarketRules struct {
+	Pair pair.CurrencyPair `json:"pair"`
+	// TickSize is the smallest price increment
+	TickSize decimal.Decimal `json:"tickSize"`
+	// StepSize is the smallest amount increment
+	StepSize    decimal.Decimal `json:"stepSize"`
+	MinAmount   decimal.Decimal `json:"minAmount"`
+	MaxAmount   decimal.Decimal `json:"maxAmount"`
+	MinNotional decimal.Decimal `json:"minNotional"`
+}
+
+// PrecisionToIncrement returns the increment of a number of decimal places,
+// for example 3 returns 0.001
+func PrecisionToIncrement(places int) decimal.Decimal {
+	return decimal.New(1, int32(places))
+}
+
+// marketRulesKey returns the key market rules are stored by, it ignores the
+// pair delimiter and case
+func marketRulesKey(p pair.CurrencyPair) string {
+	return common.StringToUpper(p.FirstCurrency.String() + p.SecondCurrency.String())
+}
+
+// SetMarketRules replaces the stored market rules of the exchange
+func (e *Base) SetMarketRules(rules []MarketRules) {
+	m := make(map[string]MarketRules, len(rules))
+	for i := range rules {
+		m[marketRulesKey(rules[i].Pair)] = rules[i]
+	}
+
+	e.marketRulesMtx.Lock()
+	e.marketRules = m
+	e.marketRulesMtx.Unlock()
+}
+
+// GetMarketRules returns the market rules of a currency pair, false is
+// returned when the exchange hasn't published rules for the pair
+func (e *Base) GetMarketRules(p pair.CurrencyPair) (MarketRules, bool) {
+	e.marketRulesMtx.RLock()
+	defer e.marketRulesMtx.RUnlock()
+	rules, ok := e.marketRules[marketRulesKey(p)]
+	return rules, ok
+}
+
+// ValidateOrder rounds the order price to the tick size and the amount down to
+// the step size of the pair, then checks the order against the pair limits.
+// The exchange precision is used for pairs without market rules. Market
+// orders without a price skip the minimum notional check
+func (e *Base) ValidateOrder(p pair.CurrencyPair, orderType OrderType, amount, price decimal.Decimal) (decimal.Decimal, decimal.Decimal, error) {
+	rules, ok := e.GetMarketRules(p)
+	if !ok {
+		return e.RoundOrderAmount(amount), e.RoundOrderPrice(price), nil
+	}
+
+	if rules.StepSize.IsPositive() {
+		amount = amount.Div(rules.StepSize).Truncate(0).Mul(rules.StepSize)
+	} else {
+		amount = e.RoundOrderAmount(amount)
+	}
+
+	if rules.TickSize.IsPositive() {
+		price = price.Div(rules.TickSize).Round(0).Mul(rules.TickSize)
+	} else {
+		price = e.RoundOrderPrice(price)
+	}
+
+	if rules.MinAmount.IsPositive() && amount.LessThan(rules.MinAmount) {
+		return amount, price, ErrOrderAmountBelowMinimum
+	}
+
+	if rules.MaxAmount.IsPositive() && amount.GreaterThan(rules.MaxAmount) {
+		return amount, price, ErrOrderAmountAboveMaximum
+	}
+
+	if rules.MinNotional.IsPositive() && (orderType != Market || price.IsPositive()) &&
+		amount.Mul(price).LessThan(rules.MinNotional) {
+		return amount, price, ErrOrderNotionalBelowMinimum
+	}
+
+	return amount, price, nil
+}
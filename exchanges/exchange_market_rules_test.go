@@ -0,0 +1,112 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
+)
+
+func TestPrecisionToIncrement(t *testing.T) {
+	if r := PrecisionToIncrement(3); r.String() != "0.001" {
+		t.Errorf("test failed - unexpected increment %s", r)
+	}
+
+	if r := PrecisionToIncrement(0); r.String() != "1" {
+		t.Errorf("test failed - unexpected increment %s", r)
+	}
+}
+
+func TestGetMarketRules(t *testing.T) {
+	b := Base{}
+	if _, ok := b.GetMarketRules(pair.NewCurrencyPair("BTC", "USDT")); ok {
+		t.Error("test failed - rules returned before they were set")
+	}
+
+	b.SetMarketRules([]MarketRules{
+		{
+			Pair:     pair.NewCurrencyPairDelimiter("btc-usdt", "-"),
+			TickSize: decimal.RequireFromString("0.01"),
+		},
+	})
+
+	rules, ok := b.GetMarketRules(pair.NewCurrencyPairDelimiter("BTC_USDT", "_"))
+	if !ok {
+		t.Fatal("test failed - rules not found for pair with a different format")
+	}
+
+	if rules.TickSize.String() != "0.01" {
+		t.Errorf("test failed - unexpected tick size %s", rules.TickSize)
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	b := Base{PricePrecision: 2, AmountPrecision: 4}
+	p := pair.NewCurrencyPair("BTC", "USDT")
+
+	amount, price, err := b.ValidateOrder(p, Limit, decimal.RequireFromString("0.12345678"),
+		decimal.RequireFromString("100.125"))
+	if err != nil {
+		t.Error("test failed - ValidateOrder() error", err)
+	}
+
+	if amount.String() != "0.1234" || price.String() != "100.13" {
+		t.Errorf("test failed - unexpected amount %s and price %s without rules", amount, price)
+	}
+
+	var unset Base
+	amount, price, err = unset.ValidateOrder(p, Limit, decimal.RequireFromString("0.5"),
+		decimal.RequireFromString("1234.5678"))
+	if err != nil {
+		t.Error("test failed - ValidateOrder() error", err)
+	}
+
+	if amount.String() != "0.5" || price.String() != "1234.5678" {
+		t.Errorf("test failed - amount %s and price %s rounded without a precision", amount, price)
+	}
+
+	b.SetMarketRules([]MarketRules{
+		{
+			Pair:        p,
+			TickSize:    decimal.RequireFromString("0.5"),
+			StepSize:    decimal.RequireFromString("0.001"),
+			MinAmount:   decimal.RequireFromString("0.01"),
+			MaxAmount:   decimal.RequireFromString("100"),
+			MinNotional: decimal.RequireFromString("10"),
+		},
+	})
+
+	amount, price, err = b.ValidateOrder(p, Limit, decimal.RequireFromString("0.12345678"),
+		decimal.RequireFromString("100.8"))
+	if err != nil {
+		t.Error("test failed - ValidateOrder() error", err)
+	}
+
+	if !amount.Equal(decimal.RequireFromString("0.123")) || !price.Equal(decimal.RequireFromString("101")) {
+		t.Errorf("test failed - unexpected amount %s and price %s with rules", amount, price)
+	}
+
+	_, _, err = b.ValidateOrder(p, Limit, decimal.RequireFromString("0.0099"),
+		decimal.RequireFromString("5000"))
+	if err != ErrOrderAmountBelowMinimum {
+		t.Error("test failed - expected minimum amount error", err)
+	}
+
+	_, _, err = b.ValidateOrder(p, Limit, decimal.RequireFromString("101"),
+		decimal.RequireFromString("100"))
+	if err != ErrOrderAmountAboveMaximum {
+		t.Error("test failed - expected maximum amount error", err)
+	}
+
+	_, _, err = b.ValidateOrder(p, Limit, decimal.RequireFromString("0.05"),
+		decimal.RequireFromString("100"))
+	if err != ErrOrderNotionalBelowMinimum {
+		t.Error("test failed - expected minimum notional error", err)
+	}
+
+	_, _, err = b.ValidateOrder(p, Market, decimal.RequireFromString("0.05"),
+		decimal.Zero)
+	if err != nil {
+		t.Error("test failed - market order without a price checked notional", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
@@ -928,3 +929,49 @@ func TestOrderTypes(t *testing.T) {
 		t.Errorf("test failed - unexpected string %s", os.ToString())
 	}
 }
+
+func TestRoundOrderPriceAndAmount(t *testing.T) {
+	b := Base{PricePrecision: 2, AmountPrecision: 4}
+
+	price := b.RoundOrderPrice(decimal.RequireFromString("100.125"))
+	if price.String() != "100.13" {
+		t.Errorf("test failed - unexpected price %s", price)
+	}
+
+	amount := b.RoundOrderAmount(decimal.RequireFromString("0.12345678"))
+	if amount.String() != "0.1234" {
+		t.Errorf("test failed - unexpected amount %s", amount)
+	}
+
+	var unset Base
+	price = unset.RoundOrderPrice(decimal.RequireFromString("1234.5678"))
+	if price.String() != "1234.5678" {
+		t.Errorf("test failed - price %s rounded without a precision", price)
+	}
+
+	amount = unset.RoundOrderAmount(decimal.RequireFromString("0.5"))
+	if amount.String() != "0.5" {
+		t.Errorf("test failed - amount %s rounded without a precision", amount)
+	}
+}
+
+func TestFormatOrderPriceAndAmount(t *testing.T) {
+	b := Base{PricePrecision: 2, AmountPrecision: 4}
+
+	if s := b.FormatOrderPrice(decimal.RequireFromString("100.1")); s != "100.10" {
+		t.Errorf("test failed - unexpected price %s", s)
+	}
+
+	if s := b.FormatOrderAmount(decimal.RequireFromString("0.12345678")); s != "0.1234" {
+		t.Errorf("test failed - unexpected amount %s", s)
+	}
+
+	var unset Base
+	if s := unset.FormatOrderPrice(decimal.RequireFromString("0.00012300")); s != "0.000123" {
+		t.Errorf("test failed - unexpected price %s without a precision", s)
+	}
+
+	if s := unset.FormatOrderAmount(decimal.RequireFromString("1500")); s != "1500" {
+		t.Errorf("test failed - unexpected amount %s without a precision", s)
+	}
+}
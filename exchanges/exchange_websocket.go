@@ -75,6 +75,9 @@ type Websocket struct {
 	connected    bool
 	connector    func() error
 	m            sync.Mutex
+	// connectionMtx guards connected, which the traffic monitor updates
+	// while the websocket is in use
+	connectionMtx sync.RWMutex
 
 	// Connected denotes a channel switch for diversion of request flow
 	Connected chan struct{}
@@ -109,7 +112,7 @@ func (w *Websocket) trafficMonitor(wg *sync.WaitGroup) {
 	wg.Done() // Makes sure we are unlocking after we add to waitgroup
 
 	defer func() {
-		if w.connected {
+		if w.IsConnected() {
 			w.Disconnected <- struct{}{}
 		}
 		w.Wg.Done()
@@ -125,19 +128,19 @@ func (w *Websocket) trafficMonitor(wg *sync.WaitGroup) {
 			return
 
 		case <-w.TrafficAlert: // Resets timer on traffic
-			if !w.connected {
+			if !w.IsConnected() {
 				w.Connected <- struct{}{}
-				w.connected = true
+				w.setConnected(true)
 			}
 
 			trafficTimer.Reset(WebsocketTrafficLimitTime)
 
 		case <-trafficTimer.C: // Falls through when timer runs out
 			newtimer := time.NewTimer(10 * time.Second) // New secondary timer set
-			if w.connected {
+			if w.IsConnected() {
 				// If connected divert traffic to rest
 				w.Disconnected <- struct{}{}
-				w.connected = false
+				w.setConnected(false)
 			}
 
 			select {
@@ -150,10 +153,10 @@ func (w *Websocket) trafficMonitor(wg *sync.WaitGroup) {
 
 			case <-w.TrafficAlert: // If in this time response traffic comes through
 				trafficTimer.Reset(WebsocketTrafficLimitTime)
-				if !w.connected {
+				if !w.IsConnected() {
 					// If not connected divert traffic from REST to websocket
 					w.Connected <- struct{}{}
-					w.connected = true
+					w.setConnected(true)
 				}
 			}
 		}
@@ -171,7 +174,7 @@ func (w *Websocket) Connect() error {
 			w.GetName())
 	}
 
-	if w.connected {
+	if w.IsConnected() {
 		return errors.New("exchange_websocket.go error - already connected, cannot connect again")
 	}
 
@@ -190,7 +193,7 @@ func (w *Websocket) Connect() error {
 
 	// Divert for incoming websocket traffic
 	w.Connected <- struct{}{}
-	w.connected = true
+	w.setConnected(true)
 
 	return nil
 }
@@ -205,7 +208,7 @@ func (w *Websocket) Shutdown() error {
 		w.m.Unlock()
 	}()
 
-	if !w.connected {
+	if !w.IsConnected() {
 		return errors.New("exchange_websocket.go error - System not connected to shut down")
 	}
 
@@ -220,7 +223,7 @@ func (w *Websocket) Shutdown() error {
 
 	select {
 	case <-c:
-		w.connected = false
+		w.setConnected(false)
 		return nil
 	case <-timer.C:
 		return fmt.Errorf("%s - Websocket routines failed to shutdown",
@@ -256,13 +259,13 @@ func (w *Websocket) SetEnabled(enabled bool) error {
 
 	if !w.init {
 		if enabled {
-			if w.connected {
+			if w.IsConnected() {
 				return nil
 			}
 			return w.Connect()
 		}
 
-		if !w.connected {
+		if !w.IsConnected() {
 			return nil
 		}
 		return w.Shutdown()
@@ -275,6 +278,21 @@ func (w *Websocket) IsEnabled() bool {
 	return w.enabled
 }
 
+// IsConnected returns whether the websocket feed is connected and receiving
+// traffic
+func (w *Websocket) IsConnected() bool {
+	w.connectionMtx.RLock()
+	defer w.connectionMtx.RUnlock()
+	return w.connected
+}
+
+// setConnected sets whether the websocket feed is connected
+func (w *Websocket) setConnected(connected bool) {
+	w.connectionMtx.Lock()
+	w.connected = connected
+	w.connectionMtx.Unlock()
+}
+
 // SetProxyAddress sets websocket proxy address
 func (w *Websocket) SetProxyAddress(URL string) error {
 	if w.proxyAddr == URL {
@@ -284,7 +302,7 @@ func (w *Websocket) SetProxyAddress(URL string) error {
 	w.proxyAddr = URL
 
 	if !w.init && w.enabled {
-		if w.connected {
+		if w.IsConnected() {
 			err := w.Shutdown()
 			if err != nil {
 				return err
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -66,6 +67,13 @@ func (e *EXMO) SetDefaults() {
 	e.AssetTypes = []string{ticker.Spot}
 	e.SupportsAutoPairUpdating = true
 	e.SupportsRESTTickerBatching = true
+	e.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	e.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	e.PricePrecision = exchange.SatoshiPrecision
+	e.AmountPrecision = exchange.SatoshiPrecision
+	e.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	e.Requester = request.New(e.Name,
 		request.NewRateLimit(time.Minute, exmoAuthRate),
 		request.NewRateLimit(time.Minute, exmoUnauthRate),
@@ -169,7 +177,7 @@ func (e *EXMO) GetUserInfo() (UserInfo, error) {
 // CreateOrder creates an order
 // Params: pair, quantity, price and type
 // Type can be buy, sell, market_buy, market_sell, market_buy_total and market_sell_total
-func (e *EXMO) CreateOrder(pair, orderType string, price, amount float64) (int64, error) {
+func (e *EXMO) CreateOrder(pair, orderType string, price, amount decimal.Decimal) (int64, error) {
 	type response struct {
 		OrderID int64 `json:"order_id"`
 	}
@@ -177,8 +185,8 @@ func (e *EXMO) CreateOrder(pair, orderType string, price, amount float64) (int64
 	v := url.Values{}
 	v.Set("pair", pair)
 	v.Set("type", orderType)
-	v.Set("price", strconv.FormatFloat(price, 'f', -1, 64))
-	v.Set("quantity", strconv.FormatFloat(amount, 'f', -1, 64))
+	v.Set("price", e.FormatOrderPrice(price))
+	v.Set("quantity", e.FormatOrderAmount(amount))
 
 	var result response
 	err := e.SendAuthenticatedHTTPRequest("POST", exmoOrderCreate, v, &result)
@@ -367,8 +375,8 @@ func (e *EXMO) SendAuthenticatedHTTPRequest(method, endpoint string, vals url.Va
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (e *EXMO) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (e *EXMO) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = e.calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount)
@@ -380,144 +388,144 @@ func (e *EXMO) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 		fee = getInternationalBankDepositFee(feeBuilder.CurrencyItem, feeBuilder.Amount, feeBuilder.BankTransactionType)
 	}
 
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func getCryptocurrencyWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getCryptocurrencyWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func (e *EXMO) calculateTradingFee(purchasePrice, amount float64) float64 {
-	fee := 0.002
-	return fee * amount * purchasePrice
+func (e *EXMO) calculateTradingFee(purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	fee := decimal.NewFromFloat(0.002)
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func calculateTradingFee(purchasePrice, amount float64) float64 {
-	fee := 0.002
-	return fee * amount * purchasePrice
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	fee := decimal.NewFromFloat(0.002)
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64, bankTransactionType exchange.InternationalBankTransactionType) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal, bankTransactionType exchange.InternationalBankTransactionType) decimal.Decimal {
+	var fee decimal.Decimal
 
 	switch bankTransactionType {
 	case exchange.WireTransfer:
 		if currency == symbol.RUB {
-			fee = 3200
+			fee = decimal.NewFromInt(3200)
 		} else if currency == symbol.PLN {
-			fee = 125
+			fee = decimal.NewFromInt(125)
 		} else if currency == symbol.TRY {
-			fee = 0
+			fee = decimal.Zero
 		}
 	case exchange.PerfectMoney:
 		switch currency {
 		case symbol.USD:
-			fee = 0.01 * amount
+			fee = decimal.NewFromFloat(0.01).Mul(amount)
 		case symbol.EUR:
-			fee = 0.0195 * amount
+			fee = decimal.NewFromFloat(0.0195).Mul(amount)
 		}
 	case exchange.Neteller:
 		switch currency {
 		case symbol.USD:
-			fee = 0.0195 * amount
+			fee = decimal.NewFromFloat(0.0195).Mul(amount)
 		case symbol.EUR:
-			fee = 0.0195 * amount
+			fee = decimal.NewFromFloat(0.0195).Mul(amount)
 		}
 	case exchange.AdvCash:
 		switch currency {
 		case symbol.USD:
-			fee = 0.0295 * amount
+			fee = decimal.NewFromFloat(0.0295).Mul(amount)
 		case symbol.EUR:
-			fee = 0.03 * amount
+			fee = decimal.NewFromFloat(0.03).Mul(amount)
 		case symbol.RUB:
-			fee = 0.0195 * amount
+			fee = decimal.NewFromFloat(0.0195).Mul(amount)
 		case symbol.UAH:
-			fee = 0.0495 * amount
+			fee = decimal.NewFromFloat(0.0495).Mul(amount)
 		}
 	case exchange.Payeer:
 		switch currency {
 		case symbol.USD:
-			fee = 0.0395 * amount
+			fee = decimal.NewFromFloat(0.0395).Mul(amount)
 		case symbol.EUR:
-			fee = 0.01 * amount
+			fee = decimal.NewFromFloat(0.01).Mul(amount)
 		case symbol.RUB:
-			fee = 0.0595 * amount
+			fee = decimal.NewFromFloat(0.0595).Mul(amount)
 		}
 	case exchange.Skrill:
 		switch currency {
 		case symbol.USD:
-			fee = 0.0145 * amount
+			fee = decimal.NewFromFloat(0.0145).Mul(amount)
 		case symbol.EUR:
-			fee = 0.03 * amount
+			fee = decimal.NewFromFloat(0.03).Mul(amount)
 		case symbol.TRY:
-			fee = 0
+			fee = decimal.Zero
 		}
 	case exchange.VisaMastercard:
 		switch currency {
 		case symbol.USD:
-			fee = 0.06 * amount
+			fee = decimal.NewFromFloat(0.06).Mul(amount)
 		case symbol.EUR:
-			fee = 0.06 * amount
+			fee = decimal.NewFromFloat(0.06).Mul(amount)
 		case symbol.PLN:
-			fee = 0.06 * amount
+			fee = decimal.NewFromFloat(0.06).Mul(amount)
 		}
 	}
 
 	return fee
 }
 
-func getInternationalBankDepositFee(currency string, amount float64, bankTransactionType exchange.InternationalBankTransactionType) float64 {
-	var fee float64
+func getInternationalBankDepositFee(currency string, amount decimal.Decimal, bankTransactionType exchange.InternationalBankTransactionType) decimal.Decimal {
+	var fee decimal.Decimal
 	switch bankTransactionType {
 	case exchange.WireTransfer:
 		if currency == symbol.RUB {
-			fee = 1600
+			fee = decimal.NewFromInt(1600)
 		} else if currency == symbol.PLN {
-			fee = 30
+			fee = decimal.NewFromInt(30)
 		} else if currency == symbol.TRY {
-			fee = 0
+			fee = decimal.Zero
 		}
 	case exchange.Neteller:
 		switch currency {
 		case symbol.USD:
-			fee = (0.035 * amount) + 0.29
+			fee = decimal.NewFromFloat(0.035).Mul(amount).Add(decimal.NewFromFloat(0.29))
 		case symbol.EUR:
-			fee = (0.035 * amount) + 0.25
+			fee = decimal.NewFromFloat(0.035).Mul(amount).Add(decimal.NewFromFloat(0.25))
 		}
 	case exchange.AdvCash:
 		switch currency {
 		case symbol.USD:
-			fee = 0.0295 * amount
+			fee = decimal.NewFromFloat(0.0295).Mul(amount)
 		case symbol.EUR:
-			fee = 0.01 * amount
+			fee = decimal.NewFromFloat(0.01).Mul(amount)
 		case symbol.RUB:
-			fee = 0.0495 * amount
+			fee = decimal.NewFromFloat(0.0495).Mul(amount)
 		case symbol.UAH:
-			fee = 0.01 * amount
+			fee = decimal.NewFromFloat(0.01).Mul(amount)
 		}
 	case exchange.Payeer:
 		switch currency {
 		case symbol.USD:
-			fee = 0.0195 * amount
+			fee = decimal.NewFromFloat(0.0195).Mul(amount)
 		case symbol.EUR:
-			fee = 0.0295 * amount
+			fee = decimal.NewFromFloat(0.0295).Mul(amount)
 		case symbol.RUB:
-			fee = 0.0345 * amount
+			fee = decimal.NewFromFloat(0.0345).Mul(amount)
 		}
 	case exchange.Skrill:
 		switch currency {
 		case symbol.USD:
-			fee = (0.0495 * amount) + 0.36
+			fee = decimal.NewFromFloat(0.0495).Mul(amount).Add(decimal.NewFromFloat(0.36))
 		case symbol.EUR:
-			fee = (0.0295 * amount) + 0.29
+			fee = decimal.NewFromFloat(0.0295).Mul(amount).Add(decimal.NewFromFloat(0.29))
 		case symbol.PLN:
-			fee = (0.035 * amount) + 1.21
+			fee = decimal.NewFromFloat(0.035).Mul(amount).Add(decimal.NewFromFloat(1.21))
 		case symbol.TRY:
-			fee = 0
+			fee = decimal.Zero
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -153,8 +154,8 @@ func (e *EXMO) GetAccountInfo() (exchange.AccountInfo, error) {
 			if z == x {
 				avail, _ := strconv.ParseFloat(y, 64)
 				reserved, _ := strconv.ParseFloat(w, 64)
-				exchangeCurrency.TotalValue = avail + reserved
-				exchangeCurrency.Hold = reserved
+				exchangeCurrency.TotalValue = decimal.NewFromFloat(avail).Add(decimal.NewFromFloat(reserved))
+				exchangeCurrency.Hold = decimal.NewFromFloat(reserved)
 			}
 		}
 		response.Currencies = append(response.Currencies, exchangeCurrency)
@@ -177,8 +178,13 @@ func (e *EXMO) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exch
 }
 
 // SubmitOrder submits a new order
-func (e *EXMO) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (e *EXMO) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := e.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var oT string
 	if orderType == exchange.Limit {
 		return submitOrderResponse, errors.New("Unsupported order type")
@@ -262,7 +268,7 @@ func (e *EXMO) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (e *EXMO) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (e *EXMO) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return e.GetFee(feeBuilder)
 }
 
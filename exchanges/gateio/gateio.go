@@ -11,6 +11,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -54,6 +55,12 @@ func (g *Gateio) SetDefaults() {
 	g.AssetTypes = []string{ticker.Spot}
 	g.SupportsAutoPairUpdating = true
 	g.SupportsRESTTickerBatching = true
+	g.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	g.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	g.KlineIntervals = []string{"1m", "3m", "5m", "15m", "30m", "1h", "2h", "4h", "6h", "1d"}
+	g.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	g.Requester = request.New(g.Name,
 		request.NewRateLimit(time.Second*10, gateioAuthRate),
 		request.NewRateLimit(time.Second*10, gateioUnauthRate),
@@ -340,8 +347,8 @@ func (g *Gateio) SpotNewOrder(arg SpotNewOrderRequestParams) (SpotNewOrderRespon
 	// Be sure to use the correct price precision before calling this
 	params := fmt.Sprintf("currencyPair=%s&rate=%s&amount=%s",
 		arg.Symbol,
-		strconv.FormatFloat(arg.Price, 'f', -1, 64),
-		strconv.FormatFloat(arg.Amount, 'f', -1, 64),
+		g.FormatOrderPrice(arg.Price),
+		g.FormatOrderAmount(arg.Amount),
 	)
 
 	strRequestURL := fmt.Sprintf("%s/%s", gateioOrder, arg.Type)
@@ -406,12 +413,12 @@ func (g *Gateio) SendAuthenticatedHTTPRequest(method, endpoint, param string, re
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (g *Gateio) GetFee(feeBuilder exchange.FeeBuilder) (fee float64, err error) {
+func (g *Gateio) GetFee(feeBuilder exchange.FeeBuilder) (fee decimal.Decimal, err error) {
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		feePairs, err := g.GetMarketInfo()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		currencyPair := feeBuilder.FirstCurrency + feeBuilder.Delimiter + feeBuilder.SecondCurrency
 		var feeForPair float64
@@ -421,24 +428,24 @@ func (g *Gateio) GetFee(feeBuilder exchange.FeeBuilder) (fee float64, err error)
 			}
 		}
 		if feeForPair == 0 {
-			return 0, fmt.Errorf("Currency: '%s' failed to find fee data", currencyPair)
+			return decimal.Zero, fmt.Errorf("Currency: '%s' failed to find fee data", currencyPair)
 		}
 		fee = calculateTradingFee(feeForPair, feeBuilder.PurchasePrice, feeBuilder.Amount)
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getCryptocurrencyWithdrawalFee(feeBuilder.FirstCurrency)
 	}
 
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(feeForPair, purchasePrice, amount float64) float64 {
-	return (feeForPair / 100) * purchasePrice * amount
+func calculateTradingFee(feeForPair float64, purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	return decimal.CalculateFee(purchasePrice.Mul(amount), decimal.NewFromFloat(feeForPair))
 }
 
-func getCryptocurrencyWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getCryptocurrencyWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
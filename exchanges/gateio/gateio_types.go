@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 )
 
 // SpotNewOrderRequestParamsType order type (buy or sell)
@@ -115,8 +116,8 @@ type Orderbook struct {
 
 // SpotNewOrderRequestParams Order params
 type SpotNewOrderRequestParams struct {
-	Amount float64                       `json:"amount"` // Order quantity
-	Price  float64                       `json:"price"`  // Order price
+	Amount decimal.Decimal               `json:"amount"` // Order quantity
+	Price  decimal.Decimal               `json:"price"`  // Order price
 	Symbol string                        `json:"symbol"` // Trading pair; btc_usdt, eth_btc......
 	Type   SpotNewOrderRequestParamsType `json:"type"`   // Order type (buy or sell),
 }
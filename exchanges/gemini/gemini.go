@@ -11,6 +11,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -110,6 +111,11 @@ func (g *Gemini) SetDefaults() {
 	g.AssetTypes = []string{ticker.Spot}
 	g.SupportsAutoPairUpdating = true
 	g.SupportsRESTTickerBatching = false
+	g.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	g.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	g.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	g.Requester = request.New(g.Name,
 		request.NewRateLimit(time.Minute, geminiAuthRate),
 		request.NewRateLimit(time.Minute, geminiUnauthRate),
@@ -280,15 +286,15 @@ func (g *Gemini) isCorrectSession(role string) error {
 
 // NewOrder Only limit orders are supported through the API at present.
 // returns order ID if successful
-func (g *Gemini) NewOrder(symbol string, amount, price float64, side, orderType string) (int64, error) {
+func (g *Gemini) NewOrder(symbol string, amount, price decimal.Decimal, side, orderType string) (int64, error) {
 	if err := g.isCorrectSession(geminiRoleTrader); err != nil {
 		return 0, err
 	}
 
 	request := make(map[string]interface{})
 	request["symbol"] = symbol
-	request["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
-	request["price"] = strconv.FormatFloat(price, 'f', -1, 64)
+	request["amount"] = g.FormatOrderAmount(amount)
+	request["price"] = g.FormatOrderPrice(price)
 	request["side"] = side
 	request["type"] = orderType
 
@@ -508,35 +514,35 @@ func (g *Gemini) SendAuthenticatedHTTPRequest(method, path string, params map[st
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (g *Gemini) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (g *Gemini) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		notionVolume, err := g.GetNotionalVolume()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee = calculateTradingFee(notionVolume, feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
 	case exchange.CryptocurrencyWithdrawalFee:
 		// TODO: no free transactions after 10; Need database to know how many trades have been done
 		// Could do via trade history, but would require analysis of response and dates to determine level of fee
 	case exchange.InternationalBankWithdrawalFee:
-		fee = 0
+		fee = decimal.Zero
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(notionVolume NotionalVolume, purchasePrice, amount float64, isMaker bool) float64 {
-	var volumeFee float64
+func calculateTradingFee(notionVolume NotionalVolume, purchasePrice, amount decimal.Decimal, isMaker bool) decimal.Decimal {
+	var volumeFee decimal.Decimal
 	if isMaker {
-		volumeFee = (float64(notionVolume.MakerFee) / 100)
+		volumeFee = decimal.NewFromInt(notionVolume.MakerFee)
 	} else {
-		volumeFee = (float64(notionVolume.TakerFee) / 100)
+		volumeFee = decimal.NewFromInt(notionVolume.TakerFee)
 	}
 
-	return volumeFee * amount * purchasePrice
+	return decimal.CalculateFee(amount.Mul(purchasePrice), volumeFee)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -53,8 +54,8 @@ func (g *Gemini) GetAccountInfo() (exchange.AccountInfo, error) {
 	for i := 0; i < len(accountBalance); i++ {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = accountBalance[i].Currency
-		exchangeCurrency.TotalValue = accountBalance[i].Amount
-		exchangeCurrency.Hold = accountBalance[i].Available
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance[i].Amount)
+		exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance[i].Available)
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
 	return response, nil
@@ -129,8 +130,13 @@ func (g *Gemini) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]ex
 }
 
 // SubmitOrder submits a new order
-func (g *Gemini) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (g *Gemini) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := g.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	response, err := g.NewOrder(p.Pair().String(), amount, price, side.ToString(), orderType.ToString())
 
 	if response > 0 {
@@ -203,7 +209,7 @@ func (g *Gemini) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (g *Gemini) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (g *Gemini) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return g.GetFee(feeBuilder)
 }
 
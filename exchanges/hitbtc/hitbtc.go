@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -68,6 +69,14 @@ func (h *HitBTC) SetDefaults() {
 	h.AssetTypes = []string{ticker.Spot}
 	h.SupportsAutoPairUpdating = true
 	h.SupportsRESTTickerBatching = true
+	h.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	h.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	h.WebsocketChannels = []string{exchange.WebsocketTickerChannel,
+		exchange.WebsocketOrderbookChannel, exchange.WebsocketTradeChannel}
+	h.KlineIntervals = []string{"1m", "3m", "5m", "15m", "30m", "1h", "4h", "1d", "1w", "1M"}
+	h.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	h.Requester = request.New(h.Name,
 		request.NewRateLimit(time.Second, hitbtcAuthRate),
 		request.NewRateLimit(time.Second, hitbtcUnauthRate),
@@ -412,15 +421,15 @@ func (h *HitBTC) GetAuthenticatedTradeHistory(currency, start, end string) (inte
 }
 
 // PlaceOrder places an order on the exchange
-func (h *HitBTC) PlaceOrder(currency string, rate, amount float64, orderType, side string) (OrderResponse, error) {
+func (h *HitBTC) PlaceOrder(currency string, rate, amount decimal.Decimal, orderType, side string) (OrderResponse, error) {
 	result := OrderResponse{}
 	values := url.Values{}
 
 	values.Set("symbol", currency)
-	values.Set("rate", strconv.FormatFloat(rate, 'f', -1, 64))
-	values.Set("quantity", strconv.FormatFloat(amount, 'f', -1, 64))
+	values.Set("rate", h.FormatOrderPrice(rate))
+	values.Set("quantity", h.FormatOrderAmount(amount))
 	values.Set("side", side)
-	values.Set("price", strconv.FormatFloat(rate, 'f', -1, 64))
+	values.Set("price", h.FormatOrderPrice(rate))
 
 	err := h.SendAuthenticatedHTTPRequest("POST", orderBuy, values, &result)
 
@@ -570,23 +579,23 @@ func (h *HitBTC) SendAuthenticatedHTTPRequest(method, endpoint string, values ur
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (h *HitBTC) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (h *HitBTC) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		feeInfo, err := h.GetFeeInfo(feeBuilder.FirstCurrency + feeBuilder.Delimiter + feeBuilder.SecondCurrency)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee = calculateTradingFee(feeInfo, feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
 	case exchange.CryptocurrencyWithdrawalFee:
 		currencyInfo, err := h.GetCurrency(feeBuilder.FirstCurrency)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
-		fee, err = strconv.ParseFloat(currencyInfo.PayoutFee, 64)
+		fee, err = decimal.NewFromString(currencyInfo.PayoutFee)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 	case exchange.CyptocurrencyDepositFee:
 		fee = calculateCryptocurrencyDepositFee(feeBuilder.FirstCurrency, feeBuilder.Amount)
@@ -595,15 +604,15 @@ func (h *HitBTC) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	return fee, nil
 }
 
-func calculateCryptocurrencyDepositFee(currency string, amount float64) float64 {
-	var fee float64
+func calculateCryptocurrencyDepositFee(currency string, amount decimal.Decimal) decimal.Decimal {
+	var fee decimal.Decimal
 	if currency == symbol.BTC {
-		fee = 0.0006
+		fee = decimal.NewFromFloat(0.0006)
 	}
-	return fee * amount
+	return fee.Mul(amount)
 }
 
-func calculateTradingFee(feeInfo Fee, purchasePrice, amount float64, isMaker bool) float64 {
+func calculateTradingFee(feeInfo Fee, purchasePrice, amount decimal.Decimal, isMaker bool) decimal.Decimal {
 	var volumeFee float64
 	if isMaker {
 		volumeFee = feeInfo.ProvideLiquidityRate
@@ -611,5 +620,5 @@ func calculateTradingFee(feeInfo Fee, purchasePrice, amount float64, isMaker boo
 		volumeFee = feeInfo.TakeLiquidityRate
 	}
 
-	return volumeFee * amount * purchasePrice
+	return decimal.NewFromFloat(volumeFee).Mul(amount).Mul(purchasePrice)
 }
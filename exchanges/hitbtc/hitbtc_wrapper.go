@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -134,8 +135,8 @@ func (h *HitBTC) GetAccountInfo() (exchange.AccountInfo, error) {
 	for _, item := range accountBalance {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = item.Currency
-		exchangeCurrency.TotalValue = item.Available
-		exchangeCurrency.Hold = item.Reserved
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(item.Available)
+		exchangeCurrency.Hold = decimal.NewFromFloat(item.Reserved)
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
 	return response, nil
@@ -156,8 +157,13 @@ func (h *HitBTC) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]ex
 }
 
 // SubmitOrder submits a new order
-func (h *HitBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (h *HitBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := h.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	response, err := h.PlaceOrder(p.Pair().String(), price, amount, common.StringToLower(orderType.ToString()), common.StringToLower(side.ToString()))
 
 	if response.OrderNumber > 0 {
@@ -230,7 +236,7 @@ func (h *HitBTC) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (h *HitBTC) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (h *HitBTC) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return h.GetFee(feeBuilder)
 }
 
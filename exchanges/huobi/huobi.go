@@ -20,6 +20,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -81,6 +82,14 @@ func (h *HUOBI) SetDefaults() {
 	h.AssetTypes = []string{ticker.Spot}
 	h.SupportsAutoPairUpdating = true
 	h.SupportsRESTTickerBatching = false
+	h.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	h.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	h.WebsocketChannels = []string{exchange.WebsocketOrderbookChannel,
+		exchange.WebsocketKlineChannel, exchange.WebsocketTradeChannel}
+	h.KlineIntervals = []string{"1m", "5m", "15m", "30m", "1h", "1d", "1w", "1M", "1y"}
+	h.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	h.Requester = request.New(h.Name,
 		request.NewRateLimit(time.Second*10, huobiAuthRate),
 		request.NewRateLimit(time.Second*10, huobiUnauthRate),
@@ -386,14 +395,14 @@ func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 		Type      string `json:"type"`
 	}{
 		AccountID: arg.AccountID,
-		Amount:    strconv.FormatFloat(arg.Amount, 'f', -1, 64),
+		Amount:    h.FormatOrderAmount(arg.Amount),
 		Symbol:    arg.Symbol,
 		Type:      string(arg.Type),
 	}
 
 	// Only set price if order type is not equal to buy-market or sell-market
 	if arg.Type != SpotNewOrderRequestTypeBuyMarket && arg.Type != SpotNewOrderRequestTypeSellMarket {
-		data.Price = strconv.FormatFloat(arg.Price, 'f', -1, 64)
+		data.Price = h.FormatOrderPrice(arg.Price)
 	}
 
 	if arg.Source != "" {
@@ -851,20 +860,20 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (h *HUOBI) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (h *HUOBI) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64) float64 {
-	feePercent := 0.002
-	return feePercent * purchasePrice * amount
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	feePercent := decimal.NewFromFloat(0.002)
+	return feePercent.Mul(purchasePrice).Mul(amount)
 }
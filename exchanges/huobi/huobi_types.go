@@ -1,5 +1,7 @@
 package huobi
 
+import "github.com/thrasher-/gocryptotrader/decimal"
+
 // Response stores the Huobi response information
 type Response struct {
 	Status       string `json:"status"`
@@ -197,8 +199,8 @@ type MarginAccountBalance struct {
 // an order
 type SpotNewOrderRequestParams struct {
 	AccountID int                           `json:"account-id,string"` // Account ID, obtained using the accounts method. Curency trades use the accountid of the ‘spot’ account; for loan asset transactions, please use the accountid of the ‘margin’ account.
-	Amount    float64                       `json:"amount"`            // The limit price indicates the quantity of the order, the market price indicates how much to buy when the order is paid, and the market price indicates how much the coin is sold when the order is sold.
-	Price     float64                       `json:"price"`             // Order price, market price does not use  this parameter
+	Amount    decimal.Decimal               `json:"amount"`            // The limit price indicates the quantity of the order, the market price indicates how much to buy when the order is paid, and the market price indicates how much the coin is sold when the order is sold.
+	Price     decimal.Decimal               `json:"price"`             // Order price, market price does not use  this parameter
 	Source    string                        `json:"source"`            // Order source, api: API call, margin-api: loan asset transaction
 	Symbol    string                        `json:"symbol"`            // The symbol to use; example btcusdt, bccbtc......
 	Type      SpotNewOrderRequestParamsType `json:"type"`              // 订单类型, buy-market: 市价买, sell-market: 市价卖, buy-limit: 限价买, sell-limit: 限价卖
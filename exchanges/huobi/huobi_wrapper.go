@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -59,10 +60,17 @@ func (h *HUOBI) Run() {
 		}
 
 		var currencies []string
+		var rules []exchange.MarketRules
 		for x := range exchangeProducts {
 			newCurrency := exchangeProducts[x].BaseCurrency + "-" + exchangeProducts[x].QuoteCurrency
 			currencies = append(currencies, newCurrency)
+			rules = append(rules, exchange.MarketRules{
+				Pair:     pair.NewCurrencyPairDelimiter(newCurrency, "-"),
+				TickSize: exchange.PrecisionToIncrement(exchangeProducts[x].PricePrecision),
+				StepSize: exchange.PrecisionToIncrement(exchangeProducts[x].AmountPrecision),
+			})
 		}
+		h.SetMarketRules(rules)
 
 		if forceUpgrade {
 			enabledPairs := []string{"btc-usdt"}
@@ -172,8 +180,13 @@ func (h *HUOBI) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exc
 }
 
 // SubmitOrder submits a new order
-func (h *HUOBI) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (h *HUOBI) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := h.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	accountID, err := strconv.ParseInt(clientID, 10, 64)
 	var formattedType SpotNewOrderRequestParamsType
 	var params = SpotNewOrderRequestParams{
@@ -271,7 +284,7 @@ func (h *HUOBI) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (h *HUOBI) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (h *HUOBI) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return h.GetFee(feeBuilder)
 }
 
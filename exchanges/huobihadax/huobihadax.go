@@ -12,6 +12,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -73,6 +74,12 @@ func (h *HUOBIHADAX) SetDefaults() {
 	h.AssetTypes = []string{ticker.Spot}
 	h.SupportsAutoPairUpdating = true
 	h.SupportsRESTTickerBatching = false
+	h.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	h.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	h.KlineIntervals = []string{"1m", "5m", "15m", "30m", "1h", "1d", "1w", "1M", "1y"}
+	h.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	h.Requester = request.New(h.Name,
 		request.NewRateLimit(time.Second*10, huobihadaxAuthRate),
 		request.NewRateLimit(time.Second*10, huobihadaxUnauthRate),
@@ -362,11 +369,11 @@ func (h *HUOBIHADAX) GetAccountBalance(accountID string) ([]AccountBalanceDetail
 func (h *HUOBIHADAX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	vals := make(map[string]string)
 	vals["account-id"] = fmt.Sprintf("%d", arg.AccountID)
-	vals["amount"] = strconv.FormatFloat(arg.Amount, 'f', -1, 64)
+	vals["amount"] = h.FormatOrderAmount(arg.Amount)
 
 	// Only set price if order type is not equal to buy-market or sell-market
 	if arg.Type != SpotNewOrderRequestTypeBuyMarket && arg.Type != SpotNewOrderRequestTypeSellMarket {
-		vals["price"] = strconv.FormatFloat(arg.Price, 'f', -1, 64)
+		vals["price"] = h.FormatOrderPrice(arg.Price)
 	}
 
 	if arg.Source != "" {
@@ -807,20 +814,20 @@ func (h *HUOBIHADAX) SendAuthenticatedHTTPRequest(method, endpoint string, value
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (h *HUOBIHADAX) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (h *HUOBIHADAX) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64) float64 {
-	feePercent := 0.002
-	return feePercent * purchasePrice * amount
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	feePercent := decimal.NewFromFloat(0.002)
+	return feePercent.Mul(purchasePrice).Mul(amount)
 }
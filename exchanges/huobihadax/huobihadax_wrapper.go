@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -36,10 +37,17 @@ func (h *HUOBIHADAX) Run() {
 		log.Printf("%s Failed to get available symbols.\n", h.GetName())
 	} else {
 		var currencies []string
+		var rules []exchange.MarketRules
 		for x := range exchangeProducts {
 			newCurrency := exchangeProducts[x].BaseCurrency + "-" + exchangeProducts[x].QuoteCurrency
 			currencies = append(currencies, newCurrency)
+			rules = append(rules, exchange.MarketRules{
+				Pair:     pair.NewCurrencyPairDelimiter(newCurrency, "-"),
+				TickSize: exchange.PrecisionToIncrement(exchangeProducts[x].PricePrecision),
+				StepSize: exchange.PrecisionToIncrement(exchangeProducts[x].AmountPrecision),
+			})
 		}
+		h.SetMarketRules(rules)
 
 		err = h.UpdateCurrencies(currencies, false, false)
 		if err != nil {
@@ -137,8 +145,13 @@ func (h *HUOBIHADAX) GetExchangeHistory(p pair.CurrencyPair, assetType string) (
 }
 
 // SubmitOrder submits a new order
-func (h *HUOBIHADAX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (h *HUOBIHADAX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := h.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	accountID, err := strconv.ParseInt(clientID, 0, 64)
 	var formattedType SpotNewOrderRequestParamsType
 	var params = SpotNewOrderRequestParams{
@@ -236,7 +249,7 @@ func (h *HUOBIHADAX) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (h *HUOBIHADAX) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (h *HUOBIHADAX) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return h.GetFee(feeBuilder)
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -56,6 +57,14 @@ func (i *ItBit) SetDefaults() {
 	i.AssetTypes = []string{ticker.Spot}
 	i.SupportsAutoPairUpdating = false
 	i.SupportsRESTTickerBatching = false
+	i.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret,
+		config.CredentialClientID}
+	i.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	i.PricePrecision = 2
+	i.AmountPrecision = 4
+	i.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	i.Requester = request.New(i.Name,
 		request.NewRateLimit(time.Second, itbitAuthRate),
 		request.NewRateLimit(time.Second, itbitUnauthRate),
@@ -227,7 +236,7 @@ func (i *ItBit) GetFundingHistoryForWallet(walletID string, params url.Values) (
 }
 
 // PlaceOrder places a new order
-func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, price float64, instrument, clientRef string) (Order, error) {
+func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, price decimal.Decimal, instrument, clientRef string) (Order, error) {
 	resp := Order{}
 	path := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitOrders)
 
@@ -235,8 +244,8 @@ func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, p
 	params["side"] = side
 	params["type"] = orderType
 	params["currency"] = currency
-	params["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
-	params["price"] = strconv.FormatFloat(price, 'f', -1, 64)
+	params["amount"] = i.FormatOrderAmount(amount)
+	params["price"] = i.FormatOrderPrice(price)
 	params["instrument"] = instrument
 
 	if clientRef != "" {
@@ -372,8 +381,8 @@ func (i *ItBit) SendAuthenticatedHTTPRequest(method string, path string, params
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (i *ItBit) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (i *ItBit) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
@@ -381,29 +390,29 @@ func (i *ItBit) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 		fee = getInternationalBankWithdrawalFee(feeBuilder.CurrencyItem, feeBuilder.Amount, feeBuilder.BankTransactionType)
 	}
 
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64, isMaker bool) float64 {
+func calculateTradingFee(purchasePrice, amount decimal.Decimal, isMaker bool) decimal.Decimal {
 	// TODO: Itbit has volume discounts, but not API endpoint to get the exact volume numbers
 	// When support is added, this needs to be updated to calculate the accurate volume fee
-	feePercent := 0.0025
+	feePercent := decimal.NewFromFloat(0.0025)
 	if isMaker {
-		feePercent = 0
+		feePercent = decimal.Zero
 	}
-	return feePercent * purchasePrice * amount
+	return feePercent.Mul(purchasePrice).Mul(amount)
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64, bankTransactionType exchange.InternationalBankTransactionType) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal, bankTransactionType exchange.InternationalBankTransactionType) decimal.Decimal {
+	var fee decimal.Decimal
 	if (bankTransactionType == exchange.Swift || bankTransactionType == exchange.WireTransfer) && currency == symbol.USD {
-		fee = 40
+		fee = decimal.NewFromInt(40)
 	} else if (bankTransactionType == exchange.SEPA || bankTransactionType == exchange.WireTransfer) && currency == symbol.EUR {
-		fee = 1
+		fee = decimal.NewFromInt(1)
 	}
 	return fee
 }
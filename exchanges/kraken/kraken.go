@@ -10,6 +10,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -69,6 +70,11 @@ func (k *Kraken) SetDefaults() {
 	k.AssetTypes = []string{ticker.Spot}
 	k.SupportsAutoPairUpdating = true
 	k.SupportsRESTTickerBatching = true
+	k.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	k.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	k.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	k.Requester = request.New(k.Name,
 		request.NewRateLimit(time.Second, krakenAuthRate),
 		request.NewRateLimit(time.Second, krakenUnauthRate),
@@ -785,16 +791,16 @@ func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResp
 }
 
 // AddOrder adds a new order for Kraken exchange
-func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2, leverage float64, args AddOrderOptions) (AddOrderResponse, error) {
+func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price decimal.Decimal, price2, leverage float64, args AddOrderOptions) (AddOrderResponse, error) {
 	params := url.Values{
 		"pair":      {symbol},
 		"type":      {common.StringToLower(side)},
 		"ordertype": {common.StringToLower(orderType)},
-		"volume":    {strconv.FormatFloat(volume, 'f', -1, 64)},
+		"volume":    {k.FormatOrderAmount(volume)},
 	}
 
-	if orderType == "limit" || price > 0 {
-		params.Set("price", strconv.FormatFloat(price, 'f', -1, 64))
+	if orderType == "limit" || price.IsPositive() {
+		params.Set("price", k.FormatOrderPrice(price))
 	}
 
 	if price2 != 0 {
@@ -923,15 +929,15 @@ func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values,
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (k *Kraken) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (k *Kraken) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	currency := feeBuilder.FirstCurrency + feeBuilder.Delimiter + feeBuilder.SecondCurrency
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		feePair, err := k.GetTradeVolume(true, currency)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		if feeBuilder.IsMaker {
 			fee = calculateTradingFee(currency, feePair.FeesMaker, feeBuilder.PurchasePrice, feeBuilder.Amount)
@@ -943,14 +949,14 @@ func (k *Kraken) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankDepositFee:
 		depositMethods, err := k.GetDepositMethods(feeBuilder.CurrencyItem)
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 
 		for _, i := range depositMethods {
 			switch feeBuilder.BankTransactionType {
 			case exchange.WireTransfer:
 				if i.Method == "SynapsePay (US Wire)" {
-					fee = i.Fee
+					fee = decimal.NewFromFloat(i.Fee)
 					return fee, nil
 				}
 			}
@@ -961,21 +967,21 @@ func (k *Kraken) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.CurrencyItem)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func getCryptocurrencyDepositFee(currency string) float64 {
-	return DepositFees[currency]
+func getCryptocurrencyDepositFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(DepositFees[currency])
 }
 
-func calculateTradingFee(currency string, feePair map[string]TradeVolumeFee, purchasePrice, amount float64) float64 {
-	return (feePair[currency].Fee / 100) * purchasePrice * amount
+func calculateTradingFee(currency string, feePair map[string]TradeVolumeFee, purchasePrice, amount decimal.Decimal) decimal.Decimal {
+	return decimal.CalculateFee(purchasePrice.Mul(amount), decimal.NewFromFloat(feePair[currency].Fee))
 }
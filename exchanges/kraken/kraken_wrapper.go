@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -38,6 +39,7 @@ func (k *Kraken) Run() {
 		}
 
 		var exchangeProducts []string
+		var rules []exchange.MarketRules
 		for _, v := range assetPairs {
 			if common.StringContains(v.Altname, ".d") {
 				continue
@@ -51,7 +53,13 @@ func (k *Kraken) Run() {
 				v.Quote = v.Quote[1:]
 			}
 			exchangeProducts = append(exchangeProducts, v.Base+"-"+v.Quote)
+			rules = append(rules, exchange.MarketRules{
+				Pair:     pair.NewCurrencyPair(v.Base, v.Quote),
+				TickSize: exchange.PrecisionToIncrement(v.PairDecimals),
+				StepSize: exchange.PrecisionToIncrement(v.LotDecimals),
+			})
 		}
+		k.SetMarketRules(rules)
 
 		if forceUpgrade {
 			enabledPairs := []string{"XBT-USD"}
@@ -161,8 +169,13 @@ func (k *Kraken) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]ex
 }
 
 // SubmitOrder submits a new order
-func (k *Kraken) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (k *Kraken) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := k.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var args = AddOrderOptions{}
 
 	response, err := k.AddOrder(p.Pair().String(), side.ToString(), orderType.ToString(), amount, price, 0, 0, args)
@@ -231,7 +244,7 @@ func (k *Kraken) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (k *Kraken) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (k *Kraken) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return k.GetFee(feeBuilder)
 }
 
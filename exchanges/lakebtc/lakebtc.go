@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -57,6 +58,11 @@ func (l *LakeBTC) SetDefaults() {
 	l.AssetTypes = []string{ticker.Spot}
 	l.SupportsAutoPairUpdating = true
 	l.SupportsRESTTickerBatching = true
+	l.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	l.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	l.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	l.Requester = request.New(l.Name,
 		request.NewRateLimit(time.Second, lakeBTCAuthRate),
 		request.NewRateLimit(time.Second, lakeBTCUnauth),
@@ -219,9 +225,9 @@ func (l *LakeBTC) GetAccountInformation() (AccountInfo, error) {
 
 // Trade executes an order on the exchange and returns trade inforamtion or an
 // error
-func (l *LakeBTC) Trade(isBuyOrder bool, amount, price float64, currency string) (Trade, error) {
+func (l *LakeBTC) Trade(isBuyOrder bool, amount, price decimal.Decimal, currency string) (Trade, error) {
 	resp := Trade{}
-	params := strconv.FormatFloat(price, 'f', -1, 64) + "," + strconv.FormatFloat(amount, 'f', -1, 64) + "," + currency
+	params := l.FormatOrderPrice(price) + "," + l.FormatOrderAmount(amount) + "," + currency
 
 	if isBuyOrder {
 		if err := l.SendAuthenticatedHTTPRequest(lakeBTCBuyOrder, params, &resp); err != nil {
@@ -348,8 +354,8 @@ func (l *LakeBTC) SendAuthenticatedHTTPRequest(method, params string, result int
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (l *LakeBTC) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (l *LakeBTC) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
@@ -360,27 +366,27 @@ func (l *LakeBTC) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 		// As they are manually performed via the website, it can only be determined when submitting the request
 	}
 
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64, isMaker bool) (fee float64) {
+func calculateTradingFee(purchasePrice, amount decimal.Decimal, isMaker bool) (fee decimal.Decimal) {
 	if isMaker {
 		// TODO: Volume based fee calculation
-		fee = 0.0015
+		fee = decimal.NewFromFloat(0.0015)
 	} else {
-		fee = 0.002
+		fee = decimal.NewFromFloat(0.002)
 	}
 
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getCryptocurrencyWithdrawalFee(currency string) (fee float64) {
+func getCryptocurrencyWithdrawalFee(currency string) (fee decimal.Decimal) {
 	if currency == symbol.BTC {
-		fee = 0.001
+		fee = decimal.NewFromFloat(0.001)
 	}
 	return fee
 }
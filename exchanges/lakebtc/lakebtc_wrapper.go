@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -115,8 +116,8 @@ func (l *LakeBTC) GetAccountInfo() (exchange.AccountInfo, error) {
 			if z == x {
 				var exchangeCurrency exchange.AccountCurrencyInfo
 				exchangeCurrency.CurrencyName = common.StringToUpper(x)
-				exchangeCurrency.TotalValue, _ = strconv.ParseFloat(y, 64)
-				exchangeCurrency.Hold, _ = strconv.ParseFloat(w, 64)
+				exchangeCurrency.TotalValue, _ = decimal.NewFromString(y)
+				exchangeCurrency.Hold, _ = decimal.NewFromString(w)
 				response.Currencies = append(response.Currencies, exchangeCurrency)
 			}
 		}
@@ -139,8 +140,13 @@ func (l *LakeBTC) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]e
 }
 
 // SubmitOrder submits a new order
-func (l *LakeBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (l *LakeBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := l.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	isBuyOrder := side == exchange.Buy
 	response, err := l.Trade(isBuyOrder, amount, price, common.StringToLower(p.Pair().String()))
 
@@ -212,7 +218,7 @@ func (l *LakeBTC) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (l *LakeBTC) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (l *LakeBTC) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return l.GetFee(feeBuilder)
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -60,6 +61,13 @@ func (l *Liqui) SetDefaults() {
 	l.AssetTypes = []string{ticker.Spot}
 	l.SupportsAutoPairUpdating = true
 	l.SupportsRESTTickerBatching = true
+	l.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	l.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	l.PricePrecision = exchange.SatoshiPrecision
+	l.AmountPrecision = exchange.SatoshiPrecision
+	l.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	l.Requester = request.New(l.Name,
 		request.NewRateLimit(time.Second, liquiAuthRate),
 		request.NewRateLimit(time.Second, liquiUnauthRate),
@@ -195,12 +203,12 @@ func (l *Liqui) GetAccountInformation() (AccountInfo, error) {
 
 // Trade creates orders on the exchange.
 // to-do: convert orderid to int64
-func (l *Liqui) Trade(pair, orderType string, amount, price float64) (float64, error) {
+func (l *Liqui) Trade(pair, orderType string, amount, price decimal.Decimal) (float64, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 	req.Add("type", orderType)
-	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
-	req.Add("rate", strconv.FormatFloat(price, 'f', -1, 64))
+	req.Add("amount", l.FormatOrderAmount(amount))
+	req.Add("rate", l.FormatOrderPrice(price))
 
 	var result Trade
 
@@ -307,8 +315,8 @@ func (l *Liqui) SendAuthenticatedHTTPRequest(method string, values url.Values, r
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (l *Liqui) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (l *Liqui) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
@@ -316,22 +324,22 @@ func (l *Liqui) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 		fee = getCryptocurrencyWithdrawalFee(feeBuilder.FirstCurrency)
 	}
 
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func getCryptocurrencyWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getCryptocurrencyWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func calculateTradingFee(purchasePrice, amount float64, isMaker bool) (fee float64) {
+func calculateTradingFee(purchasePrice, amount decimal.Decimal, isMaker bool) (fee decimal.Decimal) {
 	if isMaker {
-		fee = 0.001
+		fee = decimal.NewFromFloat(0.001)
 	} else {
-		fee = 0.0025
+		fee = decimal.NewFromFloat(0.0025)
 	}
-	return fee * purchasePrice * amount
+	return fee.Mul(purchasePrice).Mul(amount)
 }
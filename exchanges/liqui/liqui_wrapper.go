@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -126,8 +127,8 @@ func (l *Liqui) GetAccountInfo() (exchange.AccountInfo, error) {
 	for x, y := range accountBalance.Funds {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = common.StringToUpper(x)
-		exchangeCurrency.TotalValue = y
-		exchangeCurrency.Hold = 0
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(y)
+		exchangeCurrency.Hold = decimal.Zero
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
 
@@ -149,8 +150,13 @@ func (l *Liqui) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exc
 }
 
 // SubmitOrder submits a new order
-func (l *Liqui) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (l *Liqui) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := l.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	response, err := l.Trade(p.Pair().String(), fmt.Sprintf("%s", orderType), amount, price)
 
 	if response > 0 {
@@ -223,7 +229,7 @@ func (l *Liqui) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (l *Liqui) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (l *Liqui) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return l.GetFee(feeBuilder)
 }
 
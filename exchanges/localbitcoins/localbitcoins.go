@@ -11,6 +11,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
@@ -124,6 +125,13 @@ func (l *LocalBitcoins) SetDefaults() {
 	l.ConfigCurrencyPairFormat.Uppercase = true
 	l.SupportsAutoPairUpdating = true
 	l.SupportsRESTTickerBatching = true
+	l.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	l.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	l.PricePrecision = 2
+	l.AmountPrecision = 2
+	l.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	l.Requester = request.New(l.Name,
 		request.NewRateLimit(time.Millisecond*500, localbitcoinsAuthRate),
 		request.NewRateLimit(time.Millisecond*500, localbitcoinsUnauthRate),
@@ -745,7 +753,7 @@ func (l *LocalBitcoins) SendAuthenticatedHTTPRequest(method, path string, params
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (l *LocalBitcoins) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (l *LocalBitcoins) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	// No fees will be used
-	return 0, nil
+	return decimal.Zero, nil
 }
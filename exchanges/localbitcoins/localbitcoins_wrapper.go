@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -119,7 +120,7 @@ func (l *LocalBitcoins) GetAccountInfo() (exchange.AccountInfo, error) {
 	}
 	var exchangeCurrency exchange.AccountCurrencyInfo
 	exchangeCurrency.CurrencyName = "BTC"
-	exchangeCurrency.TotalValue = accountBalance.Total.Balance
+	exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance.Total.Balance)
 
 	response.Currencies = append(response.Currencies, exchangeCurrency)
 	return response, nil
@@ -140,8 +141,13 @@ func (l *LocalBitcoins) GetExchangeHistory(p pair.CurrencyPair, assetType string
 }
 
 // SubmitOrder submits a new order
-func (l *LocalBitcoins) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (l *LocalBitcoins) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := l.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	// These are placeholder details
 	// TODO store a user's localbitcoin details to use here
 	var params = AdCreate{
@@ -161,11 +167,11 @@ func (l *LocalBitcoins) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide
 		RequireIdentification:      true,
 		OnlineProvider:             "",
 		TradeType:                  "",
-		MinAmount:                  int(math.Round(amount)),
+		MinAmount:                  int(math.Round(amount.Float64())),
 	}
 
 	// Does not return any orderID, so create the add, then get the order
-	err := l.CreateAd(params)
+	err = l.CreateAd(params)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -257,7 +263,7 @@ func (l *LocalBitcoins) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (l *LocalBitcoins) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (l *LocalBitcoins) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return l.GetFee(feeBuilder)
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -104,6 +105,16 @@ func (o *OKCoin) SetDefaults() {
 	o.APIWithdrawPermissions = exchange.AutoWithdrawCrypto | exchange.WithdrawFiatViaWebsiteOnly
 	o.SupportsAutoPairUpdating = false
 	o.SupportsRESTTickerBatching = false
+	o.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	o.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	o.WebsocketChannels = []string{exchange.WebsocketOrderbookChannel,
+		exchange.WebsocketKlineChannel, exchange.WebsocketTickerChannel,
+		exchange.WebsocketTradeChannel}
+	o.KlineIntervals = []string{"1m", "3m", "5m", "15m", "30m", "1h", "2h", "4h", "6h",
+		"12h", "1d", "3d", "1w"}
+	o.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	o.WebsocketInit()
 }
 
@@ -395,14 +406,14 @@ func (o *OKCoin) GetUserInfo() (UserInfo, error) {
 }
 
 // Trade initiates a new trade
-func (o *OKCoin) Trade(amount, price float64, symbol, orderType string) (int64, error) {
+func (o *OKCoin) Trade(amount, price decimal.Decimal, symbol, orderType string) (int64, error) {
 	type Response struct {
 		Result  bool  `json:"result"`
 		OrderID int64 `json:"order_id"`
 	}
 	v := url.Values{}
-	v.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
-	v.Set("price", strconv.FormatFloat(price, 'f', -1, 64))
+	v.Set("amount", o.FormatOrderAmount(amount))
+	v.Set("price", o.FormatOrderPrice(price))
 	v.Set("symbol", symbol)
 	v.Set("type", orderType)
 
@@ -1037,8 +1048,8 @@ func (o *OKCoin) SetErrorDefaults() {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (o *OKCoin) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (o *OKCoin) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
@@ -1047,34 +1058,33 @@ func (o *OKCoin) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.FirstCurrency)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64, isMaker bool) (fee float64) {
+func calculateTradingFee(purchasePrice, amount decimal.Decimal, isMaker bool) (fee decimal.Decimal) {
 	// TODO volume based fees
 	if isMaker {
-		fee = 0.0005
+		fee = decimal.NewFromFloat(0.0005)
 	} else {
-		fee = 0.0015
+		fee = decimal.NewFromFloat(0.0015)
 	}
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func calculateInternationalBankWithdrawalFee(currency string, purchasePrice, amount float64) (fee float64) {
+func calculateInternationalBankWithdrawalFee(currency string, purchasePrice, amount decimal.Decimal) (fee decimal.Decimal) {
 	if currency == symbol.USD {
-		if purchasePrice*amount*0.001 < 15 {
-			fee = 15
-		} else {
-			fee = purchasePrice * amount * 0.001
+		fee = purchasePrice.Mul(amount).Mul(decimal.NewFromFloat(0.001))
+		if fee.LessThan(decimal.NewFromInt(15)) {
+			fee = decimal.NewFromInt(15)
 		}
 	}
 	return fee
 }
 
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -153,26 +154,26 @@ func (o *OKCoin) GetAccountInfo() (exchange.AccountInfo, error) {
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "BTC",
-		TotalValue:   assets.Info.Funds.Free.BTC,
-		Hold:         assets.Info.Funds.Freezed.BTC,
+		TotalValue:   decimal.NewFromFloat(assets.Info.Funds.Free.BTC),
+		Hold:         decimal.NewFromFloat(assets.Info.Funds.Freezed.BTC),
 	})
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "LTC",
-		TotalValue:   assets.Info.Funds.Free.LTC,
-		Hold:         assets.Info.Funds.Freezed.LTC,
+		TotalValue:   decimal.NewFromFloat(assets.Info.Funds.Free.LTC),
+		Hold:         decimal.NewFromFloat(assets.Info.Funds.Freezed.LTC),
 	})
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "USD",
-		TotalValue:   assets.Info.Funds.Free.USD,
-		Hold:         assets.Info.Funds.Freezed.USD,
+		TotalValue:   decimal.NewFromFloat(assets.Info.Funds.Free.USD),
+		Hold:         decimal.NewFromFloat(assets.Info.Funds.Freezed.USD),
 	})
 
 	response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
 		CurrencyName: "CNY",
-		TotalValue:   assets.Info.Funds.Free.CNY,
-		Hold:         assets.Info.Funds.Freezed.CNY,
+		TotalValue:   decimal.NewFromFloat(assets.Info.Funds.Free.CNY),
+		Hold:         decimal.NewFromFloat(assets.Info.Funds.Freezed.CNY),
 	})
 
 	return response, nil
@@ -193,8 +194,13 @@ func (o *OKCoin) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]ex
 }
 
 // SubmitOrder submits a new order
-func (o *OKCoin) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (o *OKCoin) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := o.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var oT string
 	if orderType == exchange.Limit {
 		if side == exchange.Buy {
@@ -285,7 +291,7 @@ func (o *OKCoin) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (o *OKCoin) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (o *OKCoin) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return o.GetFee(feeBuilder)
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -110,6 +111,16 @@ func (o *OKEX) SetDefaults() {
 	o.ConfigCurrencyPairFormat.Uppercase = true
 	o.SupportsAutoPairUpdating = true
 	o.SupportsRESTTickerBatching = false
+	o.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	o.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	o.WebsocketChannels = []string{exchange.WebsocketTickerChannel,
+		exchange.WebsocketOrderbookChannel, exchange.WebsocketTradeChannel,
+		exchange.WebsocketKlineChannel}
+	o.KlineIntervals = []string{"1m", "3m", "5m", "15m", "30m", "1h", "4h", "6h", "12h",
+		"1d", "3d", "1w"}
+	o.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	o.Requester = request.New(o.Name,
 		request.NewRateLimit(time.Second, okexAuthRate),
 		request.NewRateLimit(time.Second, okexUnauthRate),
@@ -668,8 +679,8 @@ func (o *OKEX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	params := url.Values{}
 	params.Set("symbol", arg.Symbol)
 	params.Set("type", string(arg.Type))
-	params.Set("price", strconv.FormatFloat(arg.Price, 'f', -1, 64))
-	params.Set("amount", strconv.FormatFloat(arg.Amount, 'f', -1, 64))
+	params.Set("price", o.FormatOrderPrice(arg.Price))
+	params.Set("amount", o.FormatOrderAmount(arg.Amount))
 
 	err := o.SendAuthenticatedHTTPRequest(spotTrade, params, &res)
 	if err != nil {
@@ -1125,31 +1136,31 @@ func (o *OKEX) CheckType(typeInput string) error {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (o *OKEX) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (o *OKEX) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.FirstCurrency)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64, isMaker bool) (fee float64) {
+func calculateTradingFee(purchasePrice, amount decimal.Decimal, isMaker bool) (fee decimal.Decimal) {
 	// TODO volume based fees
 	if isMaker {
-		fee = 0.001
+		fee = decimal.NewFromFloat(0.001)
 	} else {
-		fee = 0.0015
+		fee = decimal.NewFromFloat(0.0015)
 	}
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
@@ -2,6 +2,7 @@ package okex
 
 import "encoding/json"
 import "github.com/thrasher-/gocryptotrader/currency/symbol"
+import "github.com/thrasher-/gocryptotrader/decimal"
 
 // SpotInstrument stores the spot instrument info
 type SpotInstrument struct {
@@ -227,8 +228,8 @@ type SpotUserInfo struct {
 
 // SpotNewOrderRequestParams holds the params for making a new spot order
 type SpotNewOrderRequestParams struct {
-	Amount float64                 `json:"amount"` // Order quantity
-	Price  float64                 `json:"price"`  // Order price
+	Amount decimal.Decimal         `json:"amount"` // Order quantity
+	Price  decimal.Decimal         `json:"price"`  // Order price
 	Symbol string                  `json:"symbol"` // Symbol; example btc_usdt, eth_btc......
 	Type   SpotNewOrderRequestType `json:"type"`   // Order type (see below)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -38,9 +39,17 @@ func (o *OKEX) Run() {
 	}
 
 	var pairs []string
+	var rules []exchange.MarketRules
 	for x := range prods {
 		pairs = append(pairs, prods[x].BaseCurrency+"_"+prods[x].QuoteCurrency)
+		rules = append(rules, exchange.MarketRules{
+			Pair:      pair.NewCurrencyPair(prods[x].BaseCurrency, prods[x].QuoteCurrency),
+			TickSize:  decimal.NewFromFloat(prods[x].TickSize),
+			StepSize:  decimal.NewFromFloat(prods[x].SizeIncrement),
+			MinAmount: decimal.NewFromFloat(prods[x].MinSize),
+		})
 	}
+	o.SetMarketRules(rules)
 
 	err = o.UpdateCurrencies(pairs, false, false)
 	if err != nil {
@@ -170,8 +179,13 @@ func (o *OKEX) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exch
 }
 
 // SubmitOrder submits a new order
-func (o *OKEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (o *OKEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := o.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	var oT SpotNewOrderRequestType
 
 	if orderType == exchange.Limit {
@@ -269,7 +283,7 @@ func (o *OKEX) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (o *OKEX) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (o *OKEX) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return o.GetFee(feeBuilder)
 }
 
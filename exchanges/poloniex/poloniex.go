@@ -12,6 +12,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -74,6 +75,15 @@ func (p *Poloniex) SetDefaults() {
 	p.AssetTypes = []string{ticker.Spot}
 	p.SupportsAutoPairUpdating = true
 	p.SupportsRESTTickerBatching = true
+	p.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	p.SupportedOrderTypes = []exchange.OrderType{exchange.Limit, exchange.Market}
+	p.PricePrecision = exchange.SatoshiPrecision
+	p.AmountPrecision = exchange.SatoshiPrecision
+	p.WebsocketChannels = []string{exchange.WebsocketTickerChannel,
+		exchange.WebsocketOrderbookChannel, exchange.WebsocketTradeChannel}
+	p.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds"}
 	p.Requester = request.New(p.Name,
 		request.NewRateLimit(time.Second, poloniexAuthRate),
 		request.NewRateLimit(time.Second, poloniexUnauthRate),
@@ -489,7 +499,7 @@ func (p *Poloniex) GetAuthenticatedTradeHistory(currency, start, end, limit stri
 }
 
 // PlaceOrder places a new order on the exchange
-func (p *Poloniex) PlaceOrder(currency string, rate, amount float64, immediate, fillOrKill, buy bool) (OrderResponse, error) {
+func (p *Poloniex) PlaceOrder(currency string, rate, amount decimal.Decimal, immediate, fillOrKill, buy bool) (OrderResponse, error) {
 	result := OrderResponse{}
 	values := url.Values{}
 
@@ -501,8 +511,8 @@ func (p *Poloniex) PlaceOrder(currency string, rate, amount float64, immediate,
 	}
 
 	values.Set("currencyPair", currency)
-	values.Set("rate", strconv.FormatFloat(rate, 'f', -1, 64))
-	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	values.Set("rate", p.FormatOrderPrice(rate))
+	values.Set("amount", p.FormatOrderAmount(amount))
 
 	if immediate {
 		values.Set("immediateOrCancel", "1")
@@ -889,34 +899,34 @@ func (p *Poloniex) SendAuthenticatedHTTPRequest(method, endpoint string, values
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (p *Poloniex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (p *Poloniex) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		feeInfo, err := p.GetFeeInfo()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		fee = calculateTradingFee(feeInfo, feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.FirstCurrency)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(feeInfo Fee, purchasePrice, amount float64, isMaker bool) (fee float64) {
+func calculateTradingFee(feeInfo Fee, purchasePrice, amount decimal.Decimal, isMaker bool) (fee decimal.Decimal) {
 	if isMaker {
-		fee = feeInfo.MakerFee
+		fee = decimal.NewFromFloat(feeInfo.MakerFee)
 	} else {
-		fee = feeInfo.TakerFee
+		fee = decimal.NewFromFloat(feeInfo.TakerFee)
 	}
-	return fee * amount * purchasePrice
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -135,7 +136,7 @@ func (p *Poloniex) GetAccountInfo() (exchange.AccountInfo, error) {
 	for x, y := range accountBalance.Currency {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = x
-		exchangeCurrency.TotalValue = y
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(y)
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
 	return response, nil
@@ -155,9 +156,15 @@ func (p *Poloniex) GetExchangeHistory(currencyPair pair.CurrencyPair, assetType
 	return resp, common.ErrNotYetImplemented
 }
 
-// SubmitOrder submits a new order
-func (p *Poloniex) SubmitOrder(currencyPair pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+// SubmitOrder submits a new order. Poloniex doesn't publish per pair market
+// rules, so orders are only rounded to its precision before they are sent
+func (p *Poloniex) SubmitOrder(currencyPair pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := p.ValidateOrder(currencyPair, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	fillOrKill := orderType == exchange.Market
 	isBuyOrder := side == exchange.Buy
 	response, err := p.PlaceOrder(currencyPair.Pair().String(), price, amount, false, fillOrKill, isBuyOrder)
@@ -232,7 +239,7 @@ func (p *Poloniex) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (p *Poloniex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (p *Poloniex) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return p.GetFee(feeBuilder)
 }
 
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/metrics"
 )
 
 var supportedMethods = []string{"GET", "POST", "HEAD", "PUT", "DELETE", "OPTIONS", "CONNECT"}
@@ -260,10 +261,17 @@ func (r *Requester) DoRequest(req *http.Request, method, path string, headers ma
 		log.Printf("%s exchange request path: %s requires rate limiter: %v", r.Name, path, r.RequiresRateLimiter())
 	}
 
+	endpoint := metrics.Endpoint(req.URL.String())
 	var timeoutError error
 	for i := 0; i < r.timeoutRetryAttempts+1; i++ {
+		if i > 0 {
+			metrics.AddRequestRetry(r.Name, req.Method, endpoint)
+		}
+
+		start := time.Now()
 		resp, err := r.HTTPClient.Do(req)
 		if err != nil {
+			metrics.ObserveRequest(r.Name, req.Method, endpoint, 0, time.Since(start))
 			if timeoutErr, ok := err.(net.Error); ok && timeoutErr.Timeout() {
 				if verbose {
 					log.Printf("%s request has timed-out retrying request, count %d",
@@ -287,6 +295,7 @@ func (r *Requester) DoRequest(req *http.Request, method, path string, headers ma
 		}
 
 		contents, err := ioutil.ReadAll(resp.Body)
+		metrics.ObserveRequest(r.Name, req.Method, endpoint, resp.StatusCode, time.Since(start))
 		if err != nil {
 			return err
 		}
@@ -321,6 +330,7 @@ func (r *Requester) DoRequest(req *http.Request, method, path string, headers ma
 func (r *Requester) worker() {
 	for {
 		for x := range r.Jobs {
+			metrics.SetRequestQueueDepth(r.Name, len(r.Jobs))
 			if !r.IsRateLimited(x.AuthRequest) {
 				r.IncrementRequests(x.AuthRequest)
 
@@ -335,12 +345,14 @@ func (r *Requester) worker() {
 				if x.Verbose {
 					log.Printf("%s request. Rate limited! Sleeping for %v", r.Name, diff)
 				}
+				waitStart := time.Now()
 				time.Sleep(diff)
 
 				for {
 					if !r.IsRateLimited(x.AuthRequest) {
 						r.IncrementRequests(x.AuthRequest)
 
+						metrics.ObserveRateLimitWait(r.Name, time.Since(waitStart))
 						if x.Verbose {
 							log.Printf("%s request. No longer rate limited! Doing request", r.Name)
 						}
@@ -411,6 +423,7 @@ func (r *Requester) SendPayload(method, path string, headers map[string]string,
 		log.Printf("%s request. Attaching new job.", r.Name)
 	}
 	r.Jobs <- newJob
+	metrics.SetRequestQueueDepth(r.Name, len(r.Jobs))
 
 	if verbose {
 		log.Printf("%s request. Waiting for job to complete.", r.Name)
@@ -2,8 +2,10 @@ package stats
 
 import (
 	"sort"
+	"sync"
 
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/currency/registry"
 )
 
 // Item holds various fields for storing currency pair stats
@@ -18,6 +20,10 @@ type Item struct {
 // Items var array
 var Items []Item
 
+// itemsMtx guards Items, which are updated by the ticker routines and read by
+// currency conversion and the APIs
+var itemsMtx sync.RWMutex
+
 // ByPrice allows sorting by price
 type ByPrice []Item
 
@@ -54,13 +60,15 @@ func Add(exchange string, p pair.CurrencyPair, assetType string, price, volume f
 		return
 	}
 
-	if p.FirstCurrency == "XBT" {
-		newPair := pair.NewCurrencyPair("BTC", p.SecondCurrency.String())
-		Append(exchange, newPair, assetType, price, volume)
+	// Aggregate exchange specific codes such as XBT under their canonical
+	// code and stablecoin pairs under the fiat currency they track
+	canonicalPair := registry.CanonicalPair(exchange, p)
+	if !canonicalPair.Equal(p, true) {
+		Append(exchange, canonicalPair, assetType, price, volume)
 	}
 
-	if p.SecondCurrency == "USDT" {
-		newPair := pair.NewCurrencyPair(p.FirstCurrency.String(), "USD")
+	if peg, ok := registry.GetPeg(canonicalPair.SecondCurrency.String()); ok {
+		newPair := pair.NewCurrencyPair(canonicalPair.FirstCurrency.String(), peg)
 		Append(exchange, newPair, assetType, price, volume)
 	}
 
@@ -70,7 +78,10 @@ func Add(exchange string, p pair.CurrencyPair, assetType string, price, volume f
 // Append adds or updates the item stats for a specific
 // currency pair and asset type
 func Append(exchange string, p pair.CurrencyPair, assetType string, price, volume float64) {
-	if AlreadyExists(exchange, p, assetType, price, volume) {
+	itemsMtx.Lock()
+	defer itemsMtx.Unlock()
+
+	if alreadyExists(exchange, p, assetType, price, volume) {
 		return
 	}
 
@@ -88,6 +99,14 @@ func Append(exchange string, p pair.CurrencyPair, assetType string, price, volum
 // AlreadyExists checks to see if item info already exists
 // for a specific currency pair and asset type
 func AlreadyExists(exchange string, p pair.CurrencyPair, assetType string, price, volume float64) bool {
+	itemsMtx.Lock()
+	defer itemsMtx.Unlock()
+	return alreadyExists(exchange, p, assetType, price, volume)
+}
+
+// alreadyExists updates the item info of a currency pair and asset type if it
+// exists, it must be called with the items locked
+func alreadyExists(exchange string, p pair.CurrencyPair, assetType string, price, volume float64) bool {
 	for i := range Items {
 		if Items[i].Exchange == exchange && Items[i].Pair.Equal(p, false) && Items[i].AssetType == assetType {
 			Items[i].Price, Items[i].Volume = price, volume
@@ -102,11 +121,13 @@ func AlreadyExists(exchange string, p pair.CurrencyPair, assetType string, price
 // highest
 func SortExchangesByVolume(p pair.CurrencyPair, assetType string, reverse bool) []Item {
 	var result []Item
+	itemsMtx.RLock()
 	for x := range Items {
 		if Items[x].Pair.Equal(p, false) && Items[x].AssetType == assetType {
 			result = append(result, Items[x])
 		}
 	}
+	itemsMtx.RUnlock()
 
 	if reverse {
 		sort.Sort(sort.Reverse(ByVolume(result)))
@@ -121,11 +142,13 @@ func SortExchangesByVolume(p pair.CurrencyPair, assetType string, reverse bool)
 // highest
 func SortExchangesByPrice(p pair.CurrencyPair, assetType string, reverse bool) []Item {
 	var result []Item
+	itemsMtx.RLock()
 	for x := range Items {
 		if Items[x].Pair.Equal(p, false) && Items[x].AssetType == assetType {
 			result = append(result, Items[x])
 		}
 	}
+	itemsMtx.RUnlock()
 
 	if reverse {
 		sort.Sort(sort.Reverse(ByPrice(result)))
@@ -134,3 +157,10 @@ func SortExchangesByPrice(p pair.CurrencyPair, assetType string, reverse bool) [
 	}
 	return result
 }
+
+// GetItems returns a copy of the item stats
+func GetItems() []Item {
+	itemsMtx.RLock()
+	defer itemsMtx.RUnlock()
+	return append([]Item(nil), Items...)
+}
@@ -179,3 +179,15 @@ func TestSortExchangesByPrice(t *testing.T) {
 		t.Error("Test Failed - stats SortExchangesByPrice incorrectly sorted values.")
 	}
 }
+
+func TestGetItems(t *testing.T) {
+	items := GetItems()
+	if len(items) != len(Items) {
+		t.Fatal("Test Failed - stats GetItems returned an incorrect number of items.")
+	}
+
+	items[0].Exchange = "modified"
+	if Items[0].Exchange == "modified" {
+		t.Error("Test Failed - stats GetItems did not return a copy.")
+	}
+}
@@ -0,0 +1,159 @@
+package supported
+
+import (
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/anx"
+	"github.com/thrasher-/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-/gocryptotrader/exchanges/bitfinex"
+	"github.com/thrasher-/gocryptotrader/exchanges/bitflyer"
+	"github.com/thrasher-/gocryptotrader/exchanges/bithumb"
+	"github.com/thrasher-/gocryptotrader/exchanges/bitmex"
+	"github.com/thrasher-/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-/gocryptotrader/exchanges/bittrex"
+	"github.com/thrasher-/gocryptotrader/exchanges/btcc"
+	"github.com/thrasher-/gocryptotrader/exchanges/btcmarkets"
+	"github.com/thrasher-/gocryptotrader/exchanges/coinbasepro"
+	"github.com/thrasher-/gocryptotrader/exchanges/coinut"
+	"github.com/thrasher-/gocryptotrader/exchanges/exmo"
+	"github.com/thrasher-/gocryptotrader/exchanges/gateio"
+	"github.com/thrasher-/gocryptotrader/exchanges/gemini"
+	"github.com/thrasher-/gocryptotrader/exchanges/hitbtc"
+	"github.com/thrasher-/gocryptotrader/exchanges/huobi"
+	"github.com/thrasher-/gocryptotrader/exchanges/huobihadax"
+	"github.com/thrasher-/gocryptotrader/exchanges/itbit"
+	"github.com/thrasher-/gocryptotrader/exchanges/kraken"
+	"github.com/thrasher-/gocryptotrader/exchanges/lakebtc"
+	"github.com/thrasher-/gocryptotrader/exchanges/liqui"
+	"github.com/thrasher-/gocryptotrader/exchanges/localbitcoins"
+	"github.com/thrasher-/gocryptotrader/exchanges/okcoin"
+	"github.com/thrasher-/gocryptotrader/exchanges/okex"
+	"github.com/thrasher-/gocryptotrader/exchanges/poloniex"
+	"github.com/thrasher-/gocryptotrader/exchanges/wex"
+	"github.com/thrasher-/gocryptotrader/exchanges/yobit"
+	"github.com/thrasher-/gocryptotrader/exchanges/zb"
+)
+
+// Exchanges holds the lower case config name of each exchange the bot
+// supports
+var Exchanges = []string{
+	"anx",
+	"binance",
+	"bitfinex",
+	"bitflyer",
+	"bithumb",
+	"bitmex",
+	"bitstamp",
+	"bittrex",
+	"btcc",
+	"btc markets",
+	"coinut",
+	"exmo",
+	"coinbasepro",
+	"gateio",
+	"gemini",
+	"hitbtc",
+	"huobi",
+	"huobihadax",
+	"itbit",
+	"kraken",
+	"lakebtc",
+	"liqui",
+	"localbitcoins",
+	"okcoin china",
+	"okcoin international",
+	"okex",
+	"poloniex",
+	"wex",
+	"yobit",
+	"zb",
+}
+
+// New returns a new exchange by its case insensitive config name, nil is
+// returned if the exchange isn't supported
+func New(name string) exchange.IBotExchange {
+	switch common.StringToLower(name) {
+	case "anx":
+		return new(anx.ANX)
+	case "binance":
+		return new(binance.Binance)
+	case "bitfinex":
+		return new(bitfinex.Bitfinex)
+	case "bitflyer":
+		return new(bitflyer.Bitflyer)
+	case "bithumb":
+		return new(bithumb.Bithumb)
+	case "bitmex":
+		return new(bitmex.Bitmex)
+	case "bitstamp":
+		return new(bitstamp.Bitstamp)
+	case "bittrex":
+		return new(bittrex.Bittrex)
+	case "btcc":
+		return new(btcc.BTCC)
+	case "btc markets":
+		return new(btcmarkets.BTCMarkets)
+	case "coinut":
+		return new(coinut.COINUT)
+	case "exmo":
+		return new(exmo.EXMO)
+	case "coinbasepro":
+		return new(coinbasepro.CoinbasePro)
+	case "gateio":
+		return new(gateio.Gateio)
+	case "gemini":
+		return new(gemini.Gemini)
+	case "hitbtc":
+		return new(hitbtc.HitBTC)
+	case "huobi":
+		return new(huobi.HUOBI)
+	case "huobihadax":
+		return new(huobihadax.HUOBIHADAX)
+	case "itbit":
+		return new(itbit.ItBit)
+	case "kraken":
+		return new(kraken.Kraken)
+	case "lakebtc":
+		return new(lakebtc.LakeBTC)
+	case "liqui":
+		return new(liqui.Liqui)
+	case "localbitcoins":
+		return new(localbitcoins.LocalBitcoins)
+	case "okcoin china", "okcoin international":
+		return new(okcoin.OKCoin)
+	case "okex":
+		return new(okex.OKEX)
+	case "poloniex":
+		return new(poloniex.Poloniex)
+	case "wex":
+		return new(wex.WEX)
+	case "yobit":
+		return new(yobit.Yobit)
+	case "zb":
+		return new(zb.ZB)
+	}
+	return nil
+}
+
+// Capabilities returns the capabilities of a supported exchange by its case
+// insensitive config name
+func Capabilities(name string) (exchange.Capabilities, bool) {
+	exch := New(name)
+	if exch == nil {
+		return exchange.Capabilities{}, false
+	}
+	exch.SetDefaults()
+	return exch.GetCapabilities(), true
+}
+
+// init registers the credentials each supported exchange requires for
+// authenticated API support with the config package, so importing the
+// package is enough for config checks and validation to use the exchange
+// capabilities
+func init() {
+	for _, name := range Exchanges {
+		capabilities, _ := Capabilities(name)
+		config.RegisterExchangeCredentials(name, capabilities.RequiredCredentials)
+	}
+}
@@ -0,0 +1,125 @@
+package supported
+
+import (
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+)
+
+func TestNew(t *testing.T) {
+	for _, name := range Exchanges {
+		if New(name) == nil {
+			t.Errorf("Test failed. New returned nil for %s", name)
+		}
+	}
+
+	if New("BTC Markets") == nil {
+		t.Error("Test failed. New isn't case insensitive")
+	}
+
+	if New("asdsad") != nil {
+		t.Error("Test failed. New returned an unsupported exchange")
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	for _, name := range Exchanges {
+		c, ok := Capabilities(name)
+		if !ok {
+			t.Errorf("Test failed. Capabilities not found for %s", name)
+			continue
+		}
+
+		if len(c.RequiredCredentials) == 0 {
+			t.Errorf("Test failed. %s capabilities missing required credentials", name)
+		}
+
+		if c.SupportsFunction("SubmitOrder") != (len(c.OrderTypes) > 0) {
+			t.Errorf("Test failed. %s order types don't match SubmitOrder support", name)
+		}
+
+		if !c.SupportsFunction("GetWebsocket") && len(c.WebsocketChannels) > 0 {
+			t.Errorf("Test failed. %s declares channels without websocket support", name)
+		}
+	}
+
+	_, ok := Capabilities("asdsad")
+	if ok {
+		t.Error("Test failed. Capabilities found for an unsupported exchange")
+	}
+}
+
+// callUnsupported calls an IBotExchange function by name with placeholder
+// arguments, unsupported functions return before using them
+func callUnsupported(exch exchange.IBotExchange, function string) (error, bool) {
+	p := pair.NewCurrencyPair("BTC", "USD")
+	var err error
+	switch function {
+	case "GetAccountInfo":
+		_, err = exch.GetAccountInfo()
+	case "GetExchangeHistory":
+		_, err = exch.GetExchangeHistory(p, "SPOT")
+	case "GetFundingHistory":
+		_, err = exch.GetFundingHistory()
+	case "SubmitOrder":
+		_, err = exch.SubmitOrder(p, exchange.Buy, exchange.Limit, decimal.New(1, 0),
+			decimal.New(1, 0), "")
+	case "ModifyOrder":
+		_, err = exch.ModifyOrder(1, exchange.ModifyOrder{})
+	case "CancelOrder":
+		err = exch.CancelOrder(exchange.OrderCancellation{})
+	case "CancelAllOrders":
+		err = exch.CancelAllOrders()
+	case "GetOrderInfo":
+		_, err = exch.GetOrderInfo(1)
+	case "GetDepositAddress":
+		_, err = exch.GetDepositAddress("BTC")
+	case "WithdrawCryptocurrencyFunds":
+		_, err = exch.WithdrawCryptocurrencyFunds("", "BTC", 1)
+	case "WithdrawFiatFunds":
+		_, err = exch.WithdrawFiatFunds("USD", 1)
+	case "GetWebsocket":
+		_, err = exch.GetWebsocket()
+	default:
+		return nil, false
+	}
+	return err, true
+}
+
+func TestUnsupportedFunctions(t *testing.T) {
+	for _, name := range Exchanges {
+		exch := New(name)
+		exch.SetDefaults()
+		for _, function := range exch.GetCapabilities().UnsupportedFunctions {
+			err, ok := callUnsupported(exch, function)
+			if !ok {
+				t.Errorf("Test failed. %s lists unknown unsupported function %s",
+					name, function)
+				continue
+			}
+
+			if err != common.ErrFunctionNotSupported && err != common.ErrNotYetImplemented {
+				t.Errorf("Test failed. %s %s is listed as unsupported but returned %v",
+					name, function, err)
+			}
+		}
+	}
+}
+
+func TestRegisteredCredentials(t *testing.T) {
+	for _, name := range []string{"Bitstamp", "ITBIT", "COINUT", "CoinbasePro"} {
+		credentials := config.ExchangeCredentials(name)
+		if len(credentials) != 3 || credentials[2] != config.CredentialClientID {
+			t.Errorf("Test failed. %s credentials should include the client ID %v",
+				name, credentials)
+		}
+	}
+
+	if len(config.ExchangeCredentials("Bitfinex")) != 2 {
+		t.Error("Test failed. Bitfinex should only require an API key and secret")
+	}
+}
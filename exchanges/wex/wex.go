@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -65,6 +66,12 @@ func (w *WEX) SetDefaults() {
 	w.AssetTypes = []string{ticker.Spot}
 	w.SupportsAutoPairUpdating = true
 	w.SupportsRESTTickerBatching = true
+	w.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	w.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	w.AmountPrecision = exchange.SatoshiPrecision
+	w.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	w.Requester = request.New(w.Name,
 		request.NewRateLimit(time.Second, wexAuthRate),
 		request.NewRateLimit(time.Second, wexUnauthRate),
@@ -227,12 +234,12 @@ func (w *WEX) CancelExistingOrder(OrderID int64) (bool, error) {
 }
 
 // Trade places an order and returns the order ID if successful or an error
-func (w *WEX) Trade(pair, orderType string, amount, price float64) (int64, error) {
+func (w *WEX) Trade(pair, orderType string, amount, price decimal.Decimal) (int64, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 	req.Add("type", orderType)
-	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
-	req.Add("rate", strconv.FormatFloat(price, 'f', -1, 64))
+	req.Add("amount", w.FormatOrderAmount(amount))
+	req.Add("rate", w.FormatOrderPrice(price))
 
 	var result Trade
 
@@ -398,13 +405,13 @@ func (w *WEX) SendAuthenticatedHTTPRequest(method string, values url.Values, res
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (w *WEX) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (w *WEX) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		info, err := w.GetInfo()
 		if err != nil {
-			return 0, err
+			return decimal.Zero, err
 		}
 		currency := feeBuilder.FirstCurrency + feeBuilder.Delimiter + feeBuilder.SecondCurrency
 		fee = calculateTradingFee(info, currency, feeBuilder.PurchasePrice, feeBuilder.Amount)
@@ -413,24 +420,24 @@ func (w *WEX) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankDepositFee:
 		fee = getInternationalBankDepositFee(feeBuilder.CurrencyItem, feeBuilder.Amount, feeBuilder.BankTransactionType)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(info Info, currency string, purchasePrice, amount float64) (fee float64) {
-	fee = info.Pairs[common.StringToLower(currency)].Fee
-	return (fee / 100) * amount * purchasePrice
+func calculateTradingFee(info Info, currency string, purchasePrice, amount decimal.Decimal) (fee decimal.Decimal) {
+	feePercent := decimal.NewFromFloat(info.Pairs[common.StringToLower(currency)].Fee)
+	return decimal.CalculateFee(amount.Mul(purchasePrice), feePercent)
 }
 
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func getInternationalBankDepositFee(currency string, amount float64, bankTransactionType exchange.InternationalBankTransactionType) float64 {
-	var fee float64
+func getInternationalBankDepositFee(currency string, amount decimal.Decimal, bankTransactionType exchange.InternationalBankTransactionType) decimal.Decimal {
+	var fee decimal.Decimal
 
 	switch bankTransactionType {
 	case exchange.WireTransfer:
@@ -438,17 +445,17 @@ func getInternationalBankDepositFee(currency string, amount float64, bankTransac
 	case exchange.WesternUnion:
 		switch currency {
 		case symbol.USD:
-			fee = 0.065 * amount
+			fee = decimal.NewFromFloat(0.065).Mul(amount)
 		}
 	case exchange.MoneyGram:
 		switch currency {
 		case symbol.USD:
-			fee = 0.065 * amount
+			fee = decimal.NewFromFloat(0.065).Mul(amount)
 		}
 	case exchange.Contact:
 		switch currency {
 		case symbol.USD:
-			fee = 0.065 * amount
+			fee = decimal.NewFromFloat(0.065).Mul(amount)
 		}
 	}
 	return fee
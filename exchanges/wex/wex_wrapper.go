@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -136,8 +137,8 @@ func (w *WEX) GetAccountInfo() (exchange.AccountInfo, error) {
 	for x, y := range accountBalance.Funds {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = common.StringToUpper(x)
-		exchangeCurrency.TotalValue = y
-		exchangeCurrency.Hold = 0
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(y)
+		exchangeCurrency.Hold = decimal.Zero
 		response.Currencies = append(response.Currencies, exchangeCurrency)
 	}
 
@@ -159,8 +160,13 @@ func (w *WEX) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]excha
 }
 
 // SubmitOrder submits a new order
-func (w *WEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (w *WEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := w.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	response, err := w.Trade(common.StringToLower(p.Pair().String()), common.StringToLower(side.ToString()), amount, price)
 
 	if response > 0 {
@@ -233,7 +239,7 @@ func (w *WEX) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (w *WEX) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (w *WEX) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return w.GetFee(feeBuilder)
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -64,6 +65,13 @@ func (y *Yobit) SetDefaults() {
 	y.AssetTypes = []string{ticker.Spot}
 	y.SupportsAutoPairUpdating = false
 	y.SupportsRESTTickerBatching = true
+	y.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	y.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	y.PricePrecision = exchange.SatoshiPrecision
+	y.AmountPrecision = exchange.SatoshiPrecision
+	y.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	y.Requester = request.New(y.Name,
 		request.NewRateLimit(time.Second, yobitAuthRate),
 		request.NewRateLimit(time.Second, yobitUnauthRate),
@@ -174,12 +182,12 @@ func (y *Yobit) GetAccountInformation() (AccountInfo, error) {
 }
 
 // Trade places an order and returns the order ID if successful or an error
-func (y *Yobit) Trade(pair, orderType string, amount, price float64) (int64, error) {
+func (y *Yobit) Trade(pair, orderType string, amount, price decimal.Decimal) (int64, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 	req.Add("type", orderType)
-	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
-	req.Add("rate", strconv.FormatFloat(price, 'f', -1, 64))
+	req.Add("amount", y.FormatOrderAmount(amount))
+	req.Add("rate", y.FormatOrderPrice(price))
 
 	result := Trade{}
 
@@ -357,8 +365,8 @@ func (y *Yobit) SendAuthenticatedHTTPRequest(path string, params url.Values, res
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (y *Yobit) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (y *Yobit) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount)
@@ -369,54 +377,54 @@ func (y *Yobit) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.InternationalBankWithdrawalFee:
 		fee = getInternationalBankWithdrawalFee(feeBuilder.CurrencyItem, feeBuilder.Amount, feeBuilder.BankTransactionType)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64) (fee float64) {
-	fee = 0.002
-	return fee * amount * purchasePrice
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) (fee decimal.Decimal) {
+	fee = decimal.NewFromFloat(0.002)
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
 
-func getInternationalBankWithdrawalFee(currency string, amount float64, bankTransactionType exchange.InternationalBankTransactionType) float64 {
-	var fee float64
+func getInternationalBankWithdrawalFee(currency string, amount decimal.Decimal, bankTransactionType exchange.InternationalBankTransactionType) decimal.Decimal {
+	var fee decimal.Decimal
 
 	switch bankTransactionType {
 	case exchange.PerfectMoney:
 		switch currency {
 		case symbol.USD:
-			fee = 0.02 * amount
+			fee = decimal.NewFromFloat(0.02).Mul(amount)
 		}
 	case exchange.Payeer:
 		switch currency {
 		case symbol.USD:
-			fee = 0.03 * amount
+			fee = decimal.NewFromFloat(0.03).Mul(amount)
 		case symbol.RUR:
-			fee = 0.006 * amount
+			fee = decimal.NewFromFloat(0.006).Mul(amount)
 		}
 	case exchange.AdvCash:
 		switch currency {
 		case symbol.USD:
-			fee = 0.04 * amount
+			fee = decimal.NewFromFloat(0.04).Mul(amount)
 		case symbol.RUR:
-			fee = 0.03 * amount
+			fee = decimal.NewFromFloat(0.03).Mul(amount)
 		}
 	case exchange.Qiwi:
 		switch currency {
 		case symbol.RUR:
-			fee = 0.04 * amount
+			fee = decimal.NewFromFloat(0.04).Mul(amount)
 		}
 	case exchange.Capitalist:
 		switch currency {
 		case symbol.USD:
-			fee = 0.06 * amount
+			fee = decimal.NewFromFloat(0.06).Mul(amount)
 		}
 	}
 
@@ -424,39 +432,39 @@ func getInternationalBankWithdrawalFee(currency string, amount float64, bankTran
 }
 
 // getInternationalBankDepositFee; No real fees for yobit deposits, but want to be explicit on what each payment type supports
-func getInternationalBankDepositFee(currency string, amount float64, bankTransactionType exchange.InternationalBankTransactionType) float64 {
-	var fee float64
+func getInternationalBankDepositFee(currency string, amount decimal.Decimal, bankTransactionType exchange.InternationalBankTransactionType) decimal.Decimal {
+	var fee decimal.Decimal
 	switch bankTransactionType {
 	case exchange.PerfectMoney:
 		switch currency {
 		case symbol.USD:
-			fee = 0
+			fee = decimal.Zero
 		}
 	case exchange.Payeer:
 		switch currency {
 		case symbol.USD:
-			fee = 0
+			fee = decimal.Zero
 		case symbol.RUR:
-			fee = 0
+			fee = decimal.Zero
 		}
 	case exchange.AdvCash:
 		switch currency {
 		case symbol.USD:
-			fee = 0
+			fee = decimal.Zero
 		case symbol.RUR:
-			fee = 0
+			fee = decimal.Zero
 		}
 	case exchange.Qiwi:
 		switch currency {
 		case symbol.RUR:
-			fee = 0
+			fee = decimal.Zero
 		}
 	case exchange.Capitalist:
 		switch currency {
 		case symbol.USD:
-			fee = 0
+			fee = decimal.Zero
 		case symbol.RUR:
-			fee = 0
+			fee = decimal.Zero
 		}
 	}
 
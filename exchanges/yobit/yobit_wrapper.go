@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -112,11 +113,11 @@ func (y *Yobit) GetAccountInfo() (exchange.AccountInfo, error) {
 	for x, y := range accountBalance.FundsInclOrders {
 		var exchangeCurrency exchange.AccountCurrencyInfo
 		exchangeCurrency.CurrencyName = common.StringToUpper(x)
-		exchangeCurrency.TotalValue = y
-		exchangeCurrency.Hold = 0
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(y)
+		exchangeCurrency.Hold = decimal.Zero
 		for z, w := range accountBalance.Funds {
 			if z == x {
-				exchangeCurrency.Hold = y - w
+				exchangeCurrency.Hold = decimal.NewFromFloat(y).Sub(decimal.NewFromFloat(w))
 			}
 		}
 
@@ -141,8 +142,13 @@ func (y *Yobit) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exc
 }
 
 // SubmitOrder submits a new order
-func (y *Yobit) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (y *Yobit) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price decimal.Decimal, clientID string) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	amount, price, err := y.ValidateOrder(p, orderType, amount, price)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
 	response, err := y.Trade(p.Pair().String(), orderType.ToString(), amount, price)
 
 	if response > 0 {
@@ -215,7 +221,7 @@ func (y *Yobit) GetWebsocket() (*exchange.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (y *Yobit) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (y *Yobit) GetFeeByType(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
 	return y.GetFee(feeBuilder)
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/request"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -57,6 +58,13 @@ func (z *ZB) SetDefaults() {
 	z.AssetTypes = []string{ticker.Spot}
 	z.SupportsAutoPairUpdating = true
 	z.SupportsRESTTickerBatching = true
+	z.RequiredCredentials = []string{config.CredentialAPIKey, config.CredentialAPISecret}
+	z.SupportedOrderTypes = []exchange.OrderType{exchange.Limit}
+	z.KlineIntervals = []string{"1m", "3m", "5m", "15m", "30m", "1h", "2h", "4h", "6h",
+		"12h", "1d", "3d", "1w"}
+	z.UnsupportedFunctions = []string{"GetFundingHistory", "GetExchangeHistory",
+		"ModifyOrder", "CancelAllOrders", "GetOrderInfo", "GetDepositAddress",
+		"WithdrawCryptocurrencyFunds", "WithdrawFiatFunds", "GetWebsocket"}
 	z.Requester = request.New(z.Name,
 		request.NewRateLimit(time.Second*10, zbAuthRate),
 		request.NewRateLimit(time.Second*10, zbUnauthRate),
@@ -115,9 +123,9 @@ func (z *ZB) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	vals := url.Values{}
 	vals.Set("accesskey", z.APIKey)
 	vals.Set("method", "order")
-	vals.Set("amount", strconv.FormatFloat(arg.Amount, 'f', -1, 64))
+	vals.Set("amount", z.FormatOrderAmount(arg.Amount))
 	vals.Set("currency", arg.Symbol)
-	vals.Set("price", strconv.FormatFloat(arg.Price, 'f', -1, 64))
+	vals.Set("price", z.FormatOrderPrice(arg.Price))
 	vals.Set("tradeType", string(arg.Type))
 
 	err := z.SendAuthenticatedHTTPRequest("GET", zbOrder, vals, &result)
@@ -346,26 +354,26 @@ func (z *ZB) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Va
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (z *ZB) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
-	var fee float64
+func (z *ZB) GetFee(feeBuilder exchange.FeeBuilder) (decimal.Decimal, error) {
+	var fee decimal.Decimal
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount)
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.FirstCurrency)
 	}
-	if fee < 0 {
-		fee = 0
+	if fee.IsNegative() {
+		fee = decimal.Zero
 	}
 
 	return fee, nil
 }
 
-func calculateTradingFee(purchasePrice, amount float64) (fee float64) {
-	fee = 0.002
-	return fee * amount * purchasePrice
+func calculateTradingFee(purchasePrice, amount decimal.Decimal) (fee decimal.Decimal) {
+	fee = decimal.NewFromFloat(0.002)
+	return fee.Mul(amount).Mul(purchasePrice)
 }
 
-func getWithdrawalFee(currency string) float64 {
-	return WithdrawalFees[currency]
+func getWithdrawalFee(currency string) decimal.Decimal {
+	return decimal.NewFromFloat(WithdrawalFees[currency])
 }
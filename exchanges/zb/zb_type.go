@@ -2,6 +2,7 @@ package zb
 
 import "time"
 import "github.com/thrasher-/gocryptotrader/currency/symbol"
+import "github.com/thrasher-/gocryptotrader/decimal"
 
 // OrderbookResponse holds the orderbook data for a symbol
 type OrderbookResponse struct {
@@ -75,8 +76,8 @@ var (
 
 // SpotNewOrderRequestParams is the params used for placing an order
 type SpotNewOrderRequestParams struct {
-	Amount float64                       `json:"amount"`    // 交易数量
-	Price  float64                       `json:"price"`     // 下单价格,
+	Amount decimal.Decimal               `json:"amount"`    // 交易数量
+	Price  decimal.Decimal               `json:"price"`     // 下单价格,
 	Symbol string                        `json:"currency"`  // 交易对, btcusdt, bccbtc......
 	Type   SpotNewOrderRequestParamsType `json:"tradeType"` // 订单类型, buy-market: 市价买, sell-market: 市价卖, buy-limit: 限价买, sell-limit: 限价卖
 }
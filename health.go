@@ -0,0 +1,315 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency"
+	"github.com/thrasher-/gocryptotrader/portfolio"
+)
+
+// Health statuses reported for the bot and each subsystem
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+	HealthStatusDown     = "down"
+	HealthStatusDisabled = "disabled"
+
+	defaultTickerStaleness    = time.Minute * 5
+	defaultPortfolioStaleness = time.Minute * 30
+	// forex rates are only fetched at startup and on reload, so their
+	// staleness isn't checked unless a threshold is configured
+	defaultForexStaleness = 0
+)
+
+// readinessSubsystems are the subsystems which must be ok before the bot
+// reports itself as ready, the remaining subsystems only affect its health
+var readinessSubsystems = []string{"exchanges", "tickers", "forex"}
+
+// HealthReport is the response of the health and readiness endpoints
+type HealthReport struct {
+	Status     string                     `json:"status"`
+	Ready      bool                       `json:"ready"`
+	Uptime     string                     `json:"uptime"`
+	Subsystems map[string]SubsystemHealth `json:"subsystems"`
+}
+
+// SubsystemHealth holds the status of a subsystem and its components
+type SubsystemHealth struct {
+	Status      string                     `json:"status"`
+	Message     string                     `json:"message,omitempty"`
+	LastUpdated *time.Time                 `json:"lastUpdated,omitempty"`
+	Components  map[string]SubsystemHealth `json:"components,omitempty"`
+}
+
+// healthTracker stores the state used by the health checks which is not held
+// by the subsystems themselves
+type healthTracker struct {
+	m       sync.Mutex
+	started bool
+	tickers map[string]time.Time
+}
+
+var botHealth = healthTracker{tickers: make(map[string]time.Time)}
+
+// setStarted marks the bot startup as complete
+func (h *healthTracker) setStarted() {
+	h.m.Lock()
+	h.started = true
+	h.m.Unlock()
+}
+
+func (h *healthTracker) isStarted() bool {
+	h.m.Lock()
+	defer h.m.Unlock()
+	return h.started
+}
+
+// tickerUpdated records a successful ticker update for an exchange
+func (h *healthTracker) tickerUpdated(exchangeName string) {
+	h.m.Lock()
+	h.tickers[exchangeName] = time.Now()
+	h.m.Unlock()
+}
+
+func (h *healthTracker) lastTicker(exchangeName string) time.Time {
+	h.m.Lock()
+	defer h.m.Unlock()
+	return h.tickers[exchangeName]
+}
+
+// stalenessThreshold returns the configured threshold or the default if it is
+// zero, a negative result disables the check
+func stalenessThreshold(configured, defaultThreshold time.Duration) time.Duration {
+	if configured == 0 {
+		return defaultThreshold
+	}
+	return configured
+}
+
+// worstStatus returns the most severe of two health statuses
+func worstStatus(a, b string) string {
+	rank := map[string]int{
+		HealthStatusDisabled: 0,
+		HealthStatusOK:       1,
+		HealthStatusDegraded: 2,
+		HealthStatusDown:     3,
+	}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// GetHealthReport checks each subsystem and returns the bot health
+func GetHealthReport(now time.Time) HealthReport {
+	report := HealthReport{
+		Uptime: now.Sub(bot.startTime).Round(time.Second).String(),
+		Subsystems: map[string]SubsystemHealth{
+			"exchanges":      checkExchangesHealth(),
+			"websocket":      checkWebsocketHealth(),
+			"forex":          checkForexHealth(now),
+			"portfolio":      checkPortfolioHealth(now),
+			"communications": checkCommsHealth(),
+			"tickers":        checkTickersHealth(now),
+		},
+	}
+
+	report.Status = HealthStatusOK
+	for _, s := range report.Subsystems {
+		report.Status = worstStatus(report.Status, s.Status)
+	}
+
+	report.Ready = botHealth.isStarted()
+	for _, name := range readinessSubsystems {
+		if report.Subsystems[name].Status != HealthStatusOK {
+			report.Ready = false
+		}
+	}
+	return report
+}
+
+func checkExchangesHealth() SubsystemHealth {
+	s := SubsystemHealth{
+		Status:     HealthStatusOK,
+		Components: make(map[string]SubsystemHealth),
+	}
+	for x := range bot.exchanges {
+		if bot.exchanges[x] == nil {
+			continue
+		}
+		s.Components[bot.exchanges[x].GetName()] = SubsystemHealth{Status: HealthStatusOK}
+	}
+
+	if len(s.Components) == 0 {
+		return SubsystemHealth{Status: HealthStatusDown, Message: "no exchanges loaded"}
+	}
+	return s
+}
+
+func checkWebsocketHealth() SubsystemHealth {
+	s := SubsystemHealth{
+		Status:     HealthStatusDisabled,
+		Components: make(map[string]SubsystemHealth),
+	}
+	for x := range bot.exchanges {
+		if bot.exchanges[x] == nil {
+			continue
+		}
+		ws, err := bot.exchanges[x].GetWebsocket()
+		if err != nil || !ws.IsEnabled() {
+			continue
+		}
+
+		c := SubsystemHealth{Status: HealthStatusOK}
+		if !ws.IsConnected() {
+			c = SubsystemHealth{Status: HealthStatusDegraded, Message: "disconnected"}
+		}
+		s.Status = worstStatus(s.Status, c.Status)
+		s.Components[bot.exchanges[x].GetName()] = c
+	}
+	return s
+}
+
+func checkForexHealth(now time.Time) SubsystemHealth {
+	lastUpdated := currency.GetFXLastUpdated()
+	if lastUpdated.IsZero() {
+		return SubsystemHealth{Status: HealthStatusDegraded,
+			Message: "forex rates have not been fetched"}
+	}
+
+	s := SubsystemHealth{Status: HealthStatusOK, LastUpdated: &lastUpdated}
+	threshold := stalenessThreshold(getWebserverConfig().Health.ForexStaleness,
+		defaultForexStaleness)
+	if threshold > 0 && now.Sub(lastUpdated) > threshold {
+		s.Status = HealthStatusDegraded
+		s.Message = "forex rates are stale"
+	}
+	return s
+}
+
+func checkPortfolioHealth(now time.Time) SubsystemHealth {
+	lastRun := portfolio.GetWatcherLastRun()
+	if lastRun.IsZero() {
+		return SubsystemHealth{Status: HealthStatusDegraded,
+			Message: "portfolio watcher has not completed an update"}
+	}
+
+	s := SubsystemHealth{Status: HealthStatusOK, LastUpdated: &lastRun}
+	threshold := stalenessThreshold(getWebserverConfig().Health.PortfolioStaleness,
+		defaultPortfolioStaleness)
+	if threshold > 0 && now.Sub(lastRun) > threshold {
+		s.Status = HealthStatusDegraded
+		s.Message = "portfolio watcher update is stale"
+	}
+	return s
+}
+
+func checkCommsHealth() SubsystemHealth {
+	s := SubsystemHealth{
+		Status:     HealthStatusDisabled,
+		Components: make(map[string]SubsystemHealth),
+	}
+	if bot.comms == nil {
+		return s
+	}
+
+	for _, medium := range bot.comms.IComm {
+		if !medium.IsEnabled() {
+			continue
+		}
+
+		c := SubsystemHealth{Status: HealthStatusOK}
+		if !medium.IsConnected() {
+			c = SubsystemHealth{Status: HealthStatusDegraded, Message: "not connected"}
+		}
+		s.Status = worstStatus(s.Status, c.Status)
+		s.Components[medium.GetName()] = c
+	}
+	return s
+}
+
+// checkTickersHealth reports the last successful ticker update for each
+// exchange, the subsystem is down when no exchange has had a fresh ticker
+// within the staleness threshold since startup
+func checkTickersHealth(now time.Time) SubsystemHealth {
+	s := SubsystemHealth{
+		Status:     HealthStatusDown,
+		Message:    "no exchange has a fresh ticker",
+		Components: make(map[string]SubsystemHealth),
+	}
+	threshold := stalenessThreshold(getWebserverConfig().Health.TickerStaleness,
+		defaultTickerStaleness)
+
+	var fresh, stale int
+	for x := range bot.exchanges {
+		if bot.exchanges[x] == nil {
+			continue
+		}
+		exchangeName := bot.exchanges[x].GetName()
+		lastUpdated := botHealth.lastTicker(exchangeName)
+
+		var c SubsystemHealth
+		switch {
+		case lastUpdated.IsZero():
+			c = SubsystemHealth{Status: HealthStatusDegraded,
+				Message: "no ticker received"}
+		case threshold > 0 && now.Sub(lastUpdated) > threshold:
+			c = SubsystemHealth{Status: HealthStatusDegraded,
+				Message: "ticker is stale", LastUpdated: &lastUpdated}
+		default:
+			c = SubsystemHealth{Status: HealthStatusOK, LastUpdated: &lastUpdated}
+		}
+
+		if c.Status == HealthStatusOK {
+			fresh++
+		} else {
+			stale++
+		}
+		s.Components[exchangeName] = c
+	}
+
+	switch {
+	case fresh > 0 && stale > 0:
+		s.Status = HealthStatusDegraded
+		s.Message = ""
+	case fresh > 0:
+		s.Status = HealthStatusOK
+		s.Message = ""
+	case threshold <= 0 || now.Sub(bot.startTime) <= threshold:
+		s.Status = HealthStatusDegraded
+		s.Message = "waiting for the first tickers"
+	}
+	return s
+}
+
+// restHealthResponse outputs a health report with the status code
+func restHealthResponse(w http.ResponseWriter, r *http.Request, report HealthReport, ok bool) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	err := json.NewEncoder(w).Encode(report)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetHealth reports the health of each subsystem, it responds with service
+// unavailable when a subsystem is down
+func RESTGetHealth(w http.ResponseWriter, r *http.Request) {
+	report := GetHealthReport(time.Now())
+	restHealthResponse(w, r, report, report.Status != HealthStatusDown)
+}
+
+// RESTGetReady reports whether the bot has started and its exchanges, tickers
+// and forex rates are ok, it responds with service unavailable otherwise
+func RESTGetReady(w http.ResponseWriter, r *http.Request) {
+	report := GetHealthReport(time.Now())
+	restHealthResponse(w, r, report, report.Ready)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency"
+)
+
+func setupHealthTest(t *testing.T) {
+	SetupTest(t)
+	bot.startTime = time.Now()
+	bot.config.Webserver.Health.TickerStaleness = 0
+	botHealth = healthTracker{tickers: make(map[string]time.Time)}
+	currency.SetFXLastUpdated(time.Now())
+}
+
+func TestGetHealthReport(t *testing.T) {
+	setupHealthTest(t)
+
+	report := GetHealthReport(time.Now())
+	if report.Ready {
+		t.Error("Test failed. GetHealthReport ready before startup completed")
+	}
+	if report.Subsystems["exchanges"].Status != HealthStatusOK {
+		t.Error("Test failed. GetHealthReport exchanges not ok",
+			report.Subsystems["exchanges"])
+	}
+	if report.Subsystems["tickers"].Status != HealthStatusDegraded {
+		t.Error("Test failed. GetHealthReport tickers not waiting for the first update",
+			report.Subsystems["tickers"])
+	}
+
+	report = GetHealthReport(time.Now().Add(defaultTickerStaleness * 2))
+	if report.Subsystems["tickers"].Status != HealthStatusDown ||
+		report.Status != HealthStatusDown {
+		t.Error("Test failed. GetHealthReport tickers not down after staleness threshold",
+			report.Subsystems["tickers"])
+	}
+
+	botHealth.setStarted()
+	for x := range bot.exchanges {
+		botHealth.tickerUpdated(bot.exchanges[x].GetName())
+	}
+	report = GetHealthReport(time.Now())
+	if !report.Ready || report.Subsystems["tickers"].Status != HealthStatusOK {
+		t.Error("Test failed. GetHealthReport not ready after tickers updated",
+			report.Subsystems["tickers"])
+	}
+
+	bot.config.Webserver.Health.TickerStaleness = time.Second
+	report = GetHealthReport(time.Now().Add(time.Minute))
+	if report.Ready {
+		t.Error("Test failed. GetHealthReport ready with stale tickers")
+	}
+
+	bot.config.Webserver.Health.TickerStaleness = -1
+	report = GetHealthReport(time.Now().Add(time.Hour))
+	if report.Subsystems["tickers"].Status != HealthStatusOK {
+		t.Error("Test failed. GetHealthReport disabled ticker staleness check used",
+			report.Subsystems["tickers"])
+	}
+	bot.config.Webserver.Health.TickerStaleness = 0
+}
+
+func TestRESTHealthEndpoints(t *testing.T) {
+	setupHealthTest(t)
+	router := NewRouter(nil)
+
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{"/health", http.StatusOK},
+		{"/ready", http.StatusServiceUnavailable},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", test.path, nil))
+		if w.Code != test.expected {
+			t.Errorf("Test failed. %s expected status %d got %d", test.path,
+				test.expected, w.Code)
+		}
+
+		var report HealthReport
+		err := json.NewDecoder(w.Body).Decode(&report)
+		if err != nil || len(report.Subsystems) == 0 {
+			t.Errorf("Test failed. %s unexpected response %v %v", test.path,
+				err, report)
+		}
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
-	"github.com/thrasher-/gocryptotrader/currency/translation"
+	"github.com/thrasher-/gocryptotrader/currency/registry"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/stats"
@@ -51,6 +51,9 @@ func GetLogFile(dir string) string {
 // GetAllAvailablePairs returns a list of all available pairs on either enabled
 // or disabled exchanges
 func GetAllAvailablePairs(enabledExchangesOnly bool) []pair.CurrencyPair {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+
 	var pairList []pair.CurrencyPair
 	for x := range bot.config.Exchanges {
 		if enabledExchangesOnly && !bot.config.Exchanges[x].Enabled {
@@ -122,6 +125,9 @@ func IsRelatablePairs(p1, p2 pair.CurrencyPair, includeUSDT bool) bool {
 // MapCurrenciesByExchange returns a list of currency pairs mapped to an
 // exchange
 func MapCurrenciesByExchange(p []pair.CurrencyPair, enabledExchangesOnly bool) map[string][]pair.CurrencyPair {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+
 	currencyExchange := make(map[string][]pair.CurrencyPair)
 	for x := range p {
 		for y := range bot.config.Exchanges {
@@ -154,6 +160,9 @@ func MapCurrenciesByExchange(p []pair.CurrencyPair, enabledExchangesOnly bool) m
 // GetExchangeNamesByCurrency returns a list of exchanges supporting
 // a currency pair based on whether the exchange is enabled or not
 func GetExchangeNamesByCurrency(p pair.CurrencyPair, enabled bool) []string {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+
 	var exchanges []string
 	for x := range bot.config.Exchanges {
 		if enabled != bot.config.Exchanges[x].Enabled {
@@ -209,7 +218,8 @@ func GetRelatableFiatCurrencies(p pair.CurrencyPair) []pair.CurrencyPair {
 
 // GetRelatableCurrencies returns a list of currency pairs if it can find
 // any relatable currencies (e.g BTCUSD -> BTC USDT -> XBT USDT -> XBT USD)
-// incOrig includes the supplied pair if desired
+// incOrig includes the supplied pair if desired, incUSDT includes pairs
+// containing stablecoins such as USDT
 func GetRelatableCurrencies(p pair.CurrencyPair, incOrig, incUSDT bool) []pair.CurrencyPair {
 	var pairs []pair.CurrencyPair
 
@@ -225,30 +235,28 @@ func GetRelatableCurrencies(p pair.CurrencyPair, incOrig, incUSDT bool) []pair.C
 			addPair(p)
 		}
 
-		first, err := translation.GetTranslation(p.FirstCurrency)
-		if err == nil {
-			addPair(pair.NewCurrencyPair(first.String(),
-				p.SecondCurrency.String()))
-
-			second, err := translation.GetTranslation(p.SecondCurrency)
-			if err == nil {
-				addPair(pair.NewCurrencyPair(first.String(),
-					second.String()))
+		first := registry.Equivalents(p.FirstCurrency.String())
+		second := registry.Equivalents(p.SecondCurrency.String())
+		for x := range first {
+			for y := range second {
+				addPair(pair.NewCurrencyPair(first[x], second[y]))
 			}
 		}
-
-		second, err := translation.GetTranslation(p.SecondCurrency)
-		if err == nil {
-			addPair(pair.NewCurrencyPair(p.FirstCurrency.String(),
-				second.String()))
-		}
 	}
 
 	buildPairs(p, incOrig)
 	buildPairs(p.Swap(), incOrig)
 
 	if !incUSDT {
-		pairs = pair.RemovePairsByFilter(pairs, "USDT")
+		var filtered []pair.CurrencyPair
+		for x := range pairs {
+			if registry.IsStablecoin(pairs[x].FirstCurrency.String()) ||
+				registry.IsStablecoin(pairs[x].SecondCurrency.String()) {
+				continue
+			}
+			filtered = append(filtered, pairs[x])
+		}
+		pairs = filtered
 	}
 
 	return pairs
@@ -308,8 +316,8 @@ func GetCollatedExchangeAccountInfoByCoin(accounts []exchange.AccountInfo) map[s
 				accountInfo := exchange.AccountCurrencyInfo{CurrencyName: currencyName, Hold: onHold, TotalValue: avail}
 				result[currencyName] = accountInfo
 			} else {
-				info.Hold += onHold
-				info.TotalValue += avail
+				info.Hold = info.Hold.Add(onHold)
+				info.TotalValue = info.TotalValue.Add(avail)
 				result[currencyName] = info
 			}
 		}
@@ -363,7 +371,7 @@ func SeedExchangeAccountInfo(data []exchange.AccountInfo) {
 			currencyName := data[i].Currencies[j].CurrencyName
 			onHold := data[i].Currencies[j].Hold
 			avail := data[i].Currencies[j].TotalValue
-			total := onHold + avail
+			total := onHold.Add(avail).Float64()
 
 			if !port.ExchangeAddressExists(exchangeName, currencyName) {
 				if total <= 0 {
@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/decimal"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/stats"
@@ -284,11 +285,11 @@ func TestGetCollatedExchangeAccountInfoByCoin(t *testing.T) {
 
 	info.ExchangeName = "Bitfinex"
 	info.Currencies = append(info.Currencies,
-		exchange.AccountCurrencyInfo{CurrencyName: "BTC", TotalValue: 100, Hold: 0})
+		exchange.AccountCurrencyInfo{CurrencyName: "BTC", TotalValue: decimal.NewFromFloat(100), Hold: decimal.Zero})
 	exchangeInfo = append(exchangeInfo, info)
 
 	info.ExchangeName = "Bitstamp"
-	info.Currencies = append(info.Currencies, exchange.AccountCurrencyInfo{CurrencyName: "LTC", TotalValue: 100, Hold: 0})
+	info.Currencies = append(info.Currencies, exchange.AccountCurrencyInfo{CurrencyName: "LTC", TotalValue: decimal.NewFromFloat(100), Hold: decimal.Zero})
 	exchangeInfo = append(exchangeInfo, info)
 
 	result := GetCollatedExchangeAccountInfoByCoin(exchangeInfo)
@@ -301,7 +302,7 @@ func TestGetCollatedExchangeAccountInfoByCoin(t *testing.T) {
 		t.Fatal("Expected currency was not found in result map")
 	}
 
-	if amount.TotalValue != 200 {
+	if !amount.TotalValue.Equal(decimal.NewFromFloat(200)) {
 		t.Fatal("Unexpected result")
 	}
 
@@ -318,7 +319,7 @@ func TestGetAccountCurrencyInfoByExchangeName(t *testing.T) {
 	var info exchange.AccountInfo
 	info.ExchangeName = "Bitfinex"
 	info.Currencies = append(info.Currencies,
-		exchange.AccountCurrencyInfo{CurrencyName: "BTC", TotalValue: 100, Hold: 0})
+		exchange.AccountCurrencyInfo{CurrencyName: "BTC", TotalValue: decimal.NewFromFloat(100), Hold: decimal.Zero})
 	exchangeInfo = append(exchangeInfo, info)
 
 	result, err := GetAccountCurrencyInfoByExchangeName(exchangeInfo, "Bitfinex")
@@ -7,15 +7,20 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strconv"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/communications"
+	"github.com/thrasher-/gocryptotrader/communications/base"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/forexprovider"
+	"github.com/thrasher-/gocryptotrader/events"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/portfolio"
 )
@@ -27,11 +32,17 @@ type Bot struct {
 	portfolio  *portfolio.Base
 	exchanges  []exchange.IBotExchange
 	comms      *communications.Communications
+	control    *BotControl
 	shutdown   chan bool
 	dryRun     bool
 	configFile string
 	dataDir    string
 	logFile    string
+	startTime  time.Time
+
+	// configMtx guards the config against being replaced by a reload while
+	// routines and API handlers read it
+	configMtx sync.RWMutex
 }
 
 const banner = `
@@ -47,6 +58,7 @@ var bot Bot
 
 func main() {
 	bot.shutdown = make(chan bool)
+	bot.startTime = time.Now()
 	HandleInterrupt()
 
 	defaultPath, err := config.GetFilePath("")
@@ -60,6 +72,11 @@ func main() {
 	dryrun := flag.Bool("dryrun", false, "dry runs bot, doesn't save config file")
 	version := flag.Bool("version", false, "retrieves current GoCryptoTrader version")
 	verbosity := flag.Bool("verbose", false, "increases logging verbosity for GoCryptoTrader")
+	watchConfig := flag.Bool("watchconfig", true, "reloads the config file when it changes or SIGHUP is received")
+	flag.StringVar(&config.EncryptionKeySource, "configkey", "", "reads the config encryption key from env:NAME or file:/path instead of prompting for it")
+	validate := flag.Bool("validate", false, "validates the config file, reports every problem found and exits")
+	validateFormat := flag.String("validateformat", config.ValidationFormatText, "validation report format, text or json")
+	flag.StringVar(&config.ProfileName, "profile", "", "applies the named config profile, overriding the profile set in the config file")
 
 	flag.Parse()
 
@@ -68,6 +85,10 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *validate {
+		os.Exit(ValidateConfig(bot.configFile, *validateFormat))
+	}
+
 	if *dryrun {
 		bot.dryRun = true
 	}
@@ -77,11 +98,23 @@ func main() {
 
 	bot.config = &config.Cfg
 	log.Printf("Loading config file %s..\n", bot.configFile)
+	config.MigrationBackupDir = filepath.Join(bot.dataDir, "config_backups")
 	err = bot.config.LoadConfig(bot.configFile)
 	if err != nil {
 		log.Fatalf("Failed to load config. Err: %s", err)
 	}
 
+	for _, include := range bot.config.IncludedFiles() {
+		log.Printf("Included config file %s.\n", include)
+	}
+
+	if profile, ok := bot.config.ActiveProfile(); ok {
+		log.Printf("Using config profile %s.\n", profile.Name)
+		if profile.DryRun {
+			bot.dryRun = true
+		}
+	}
+
 	err = common.CheckDir(bot.dataDir, true)
 	if err != nil {
 		log.Fatalf("Failed to open/create data directory: %s. Err: %s", bot.dataDir, err)
@@ -113,12 +146,16 @@ func main() {
 	}
 
 	log.Println("Starting communication mediums..")
+	bot.control = &BotControl{}
+	base.SetBotControl(bot.control)
 	bot.comms = communications.NewComm(bot.config.GetCommunicationsConfig())
 	bot.comms.GetEnabledCommunicationMediums()
+	events.SetComms(bot.comms)
+	events.SetTriggerHandler(relayEventTrigger)
 
 	log.Printf("Fiat display currency: %s.", bot.config.Currency.FiatDisplayCurrency)
-	currency.BaseCurrency = bot.config.Currency.FiatDisplayCurrency
-	currency.FXProviders = forexprovider.StartFXService(bot.config.GetCurrencyConfig().ForexProviders)
+	currency.SetBaseCurrency(bot.config.Currency.FiatDisplayCurrency)
+	currency.SetForexProviders(forexprovider.StartFXService(bot.config.GetCurrencyConfig().ForexProviders))
 	log.Printf("Primary forex conversion provider: %s.\n", bot.config.GetPrimaryForexProvider())
 	err = bot.config.RetrieveConfigCurrencyPairs(true)
 	if err != nil {
@@ -137,14 +174,33 @@ func main() {
 
 	if bot.config.Webserver.Enabled {
 		listenAddr := bot.config.Webserver.ListenAddress
+		server := &http.Server{
+			Addr:    listenAddr,
+			Handler: NewRouter(bot.exchanges),
+		}
+
+		scheme := "http"
+		if bot.config.Webserver.TLS.Enabled {
+			scheme = "https"
+			server.TLSConfig, err = GetWebserverTLSConfig(bot.config.Webserver.TLS,
+				bot.dataDir, listenAddr)
+			if err != nil {
+				log.Fatalf("Failed to setup webserver TLS. Err: %s", err)
+			}
+		}
+
 		log.Printf(
-			"HTTP Webserver support enabled. Listen URL: http://%s:%d/\n",
-			common.ExtractHost(listenAddr), common.ExtractPort(listenAddr),
+			"HTTP Webserver support enabled. Listen URL: %s://%s:%d/\n",
+			scheme, common.ExtractHost(listenAddr), common.ExtractPort(listenAddr),
 		)
 
-		router := NewRouter(bot.exchanges)
 		go func() {
-			err = http.ListenAndServe(listenAddr, router)
+			var err error
+			if server.TLSConfig != nil {
+				err = server.ListenAndServeTLS("", "")
+			} else {
+				err = server.ListenAndServe()
+			}
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -157,11 +213,27 @@ func main() {
 		log.Println("HTTP RESTful Webserver support disabled.")
 	}
 
+	if bot.config.Webserver.GRPC.Enabled {
+		err = StartRPCServer()
+		if err != nil {
+			log.Fatalf("Failed to start gRPC server. Err: %s", err)
+		}
+	} else {
+		log.Println("gRPC server support disabled.")
+	}
+
 	go portfolio.StartPortfolioWatcher()
 
 	go TickerUpdaterRoutine()
 	go OrderbookUpdaterRoutine()
+	if bot.config.Webserver.Enabled {
+		go TradeUpdaterRoutine()
+	}
 	go WebsocketRoutine(*verbosity)
+	if *watchConfig {
+		go StartConfigWatcher()
+	}
+	botHealth.setStarted()
 
 	<-bot.shutdown
 	Shutdown()
@@ -205,14 +277,25 @@ func HandleInterrupt() {
 func Shutdown() {
 	log.Println("Bot shutting down..")
 
+	bot.configMtx.Lock()
+	defer bot.configMtx.Unlock()
+
 	if len(portfolio.Portfolio.Addresses) != 0 {
 		bot.config.Portfolio = portfolio.Portfolio
 	}
 
-	if !bot.dryRun {
+	if bot.comms != nil {
+		bot.comms.Shutdown()
+	}
+
+	if bot.dryRun {
+		log.Println("Dry run mode, config not saved.")
+	} else {
 		err := bot.config.SaveConfig(bot.configFile)
 
-		if err != nil {
+		if err == config.ErrComposedConfig {
+			log.Println("Config built from includes or a profile, not saved.")
+		} else if err != nil {
 			log.Println("Unable to save config.")
 		} else {
 			log.Println("Config file saved successfully.")
@@ -0,0 +1,297 @@
+package metrics
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "gct"
+
+	endpointParam      = ":param"
+	endpointUnknown    = "unknown"
+	maxEndpointSegment = 32
+	minCurrencyCode    = 3
+)
+
+// Order operations and results used to label order metrics
+const (
+	OrderSubmit    = "submit"
+	OrderModify    = "modify"
+	OrderCancel    = "cancel"
+	OrderCancelAll = "cancel_all"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+// Registry holds every GoCryptoTrader metric along with the Go runtime and
+// process collectors
+var Registry = prometheus.NewRegistry()
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "exchange_request_duration_seconds",
+		Help:      "Exchange HTTP request latency by exchange, HTTP method, endpoint and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"exchange", "method", "endpoint", "code"})
+
+	requestRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "exchange_request_retries_total",
+		Help:      "Exchange HTTP requests retried after a timeout.",
+	}, []string{"exchange", "method", "endpoint"})
+
+	rateLimitWaits = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "exchange_rate_limit_wait_seconds",
+		Help:      "Time exchange HTTP requests spent waiting on the rate limiter.",
+		Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"exchange"})
+
+	requestQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "exchange_request_queue_depth",
+		Help:      "Rate limited exchange HTTP requests waiting to be sent.",
+	}, []string{"exchange"})
+
+	websocketMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "exchange_websocket_messages_total",
+		Help:      "Messages received from exchange websocket feeds by type.",
+	}, []string{"exchange", "type"})
+
+	websocketReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "exchange_websocket_reconnects_total",
+		Help:      "Exchange websocket feed reconnection attempts.",
+	}, []string{"exchange"})
+
+	eventChecks = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "event_checks_total",
+		Help:      "Event trigger conditions checked.",
+	})
+
+	eventTriggers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "event_triggers_total",
+		Help:      "Events triggered by exchange.",
+	}, []string{"exchange"})
+
+	orders = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "orders_total",
+		Help:      "Order operations made through the bot by exchange, operation and result.",
+	}, []string{"exchange", "operation", "result"})
+
+	tickerStaleness    = newStalenessCollector("ticker")
+	orderbookStaleness = newStalenessCollector("orderbook")
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestDuration,
+		requestRetries,
+		rateLimitWaits,
+		requestQueueDepth,
+		websocketMessages,
+		websocketReconnects,
+		eventChecks,
+		eventTriggers,
+		orders,
+		tickerStaleness,
+		orderbookStaleness,
+	)
+}
+
+// Handler returns the HTTP handler which serves the metrics in the Prometheus
+// text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// RegisterGaugeFunc registers a gauge whose value is read from f when the
+// metrics are scraped
+func RegisterGaugeFunc(name, help string, f func() float64) error {
+	return Registry.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, f))
+}
+
+// Endpoint returns a bounded endpoint name for a request URL to label request
+// metrics with. The host and query are dropped and path segments which look
+// like order IDs or currency pairs are replaced so that each exchange API call
+// maps to a single series
+func Endpoint(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return endpointUnknown
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := range segments {
+		if isEndpointParam(segments[i]) {
+			segments[i] = endpointParam
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// isEndpointParam returns whether a path segment is a request parameter,
+// segments of two characters or fewer such as API versions are kept
+func isEndpointParam(segment string) bool {
+	if len(segment) <= 2 {
+		return false
+	}
+	if len(segment) > maxEndpointSegment {
+		return true
+	}
+
+	var upper int
+	for _, r := range segment {
+		if unicode.IsDigit(r) {
+			return true
+		}
+		if !unicode.IsUpper(r) {
+			upper = 0
+			continue
+		}
+		upper++
+		if upper == minCurrencyCode {
+			return true
+		}
+	}
+	return false
+}
+
+// ObserveRequest records the latency and status of an exchange HTTP request,
+// code is the HTTP status code or 0 if no response was received. The endpoint
+// should be bounded, see Endpoint
+func ObserveRequest(exchange, method, endpoint string, code int, duration time.Duration) {
+	status := "error"
+	if code != 0 {
+		status = strconv.Itoa(code)
+	}
+	requestDuration.WithLabelValues(exchange, method, endpoint, status).Observe(duration.Seconds())
+}
+
+// AddRequestRetry counts an exchange HTTP request retried after a timeout
+func AddRequestRetry(exchange, method, endpoint string) {
+	requestRetries.WithLabelValues(exchange, method, endpoint).Inc()
+}
+
+// ObserveRateLimitWait records the time a request waited on the exchange rate
+// limiter
+func ObserveRateLimitWait(exchange string, wait time.Duration) {
+	rateLimitWaits.WithLabelValues(exchange).Observe(wait.Seconds())
+}
+
+// SetRequestQueueDepth sets the number of queued exchange HTTP requests
+func SetRequestQueueDepth(exchange string, depth int) {
+	requestQueueDepth.WithLabelValues(exchange).Set(float64(depth))
+}
+
+// AddWebsocketMessage counts a message received from an exchange websocket
+// feed
+func AddWebsocketMessage(exchange, messageType string) {
+	websocketMessages.WithLabelValues(exchange, messageType).Inc()
+}
+
+// AddWebsocketReconnect counts an exchange websocket reconnection attempt
+func AddWebsocketReconnect(exchange string) {
+	websocketReconnects.WithLabelValues(exchange).Inc()
+}
+
+// AddEventCheck counts an event trigger condition check
+func AddEventCheck() {
+	eventChecks.Inc()
+}
+
+// AddEventTrigger counts a triggered event
+func AddEventTrigger(exchange string) {
+	eventTriggers.WithLabelValues(exchange).Inc()
+}
+
+// AddOrder counts an order operation and whether it failed
+func AddOrder(exchange, operation string, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultFailure
+	}
+	orders.WithLabelValues(exchange, operation, result).Inc()
+}
+
+// TickerUpdated records the time a ticker was last updated for staleness
+// reporting
+func TickerUpdated(exchange, currencyPair, assetType string) {
+	tickerStaleness.update(exchange, currencyPair, assetType, time.Now())
+}
+
+// OrderbookUpdated records the time an orderbook was last updated for
+// staleness reporting
+func OrderbookUpdated(exchange, currencyPair, assetType string) {
+	orderbookStaleness.update(exchange, currencyPair, assetType, time.Now())
+}
+
+type stalenessKey struct {
+	exchange     string
+	currencyPair string
+	assetType    string
+}
+
+// stalenessCollector reports the seconds since each pair was last updated at
+// scrape time
+type stalenessCollector struct {
+	desc    *prometheus.Desc
+	m       sync.Mutex
+	updated map[stalenessKey]time.Time
+	now     func() time.Time
+}
+
+func newStalenessCollector(name string) *stalenessCollector {
+	return &stalenessCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, name, "staleness_seconds"),
+			"Seconds since the "+name+" was last updated by exchange, pair and asset type.",
+			[]string{"exchange", "pair", "asset"}, nil),
+		updated: make(map[stalenessKey]time.Time),
+		now:     time.Now,
+	}
+}
+
+func (s *stalenessCollector) update(exchange, currencyPair, assetType string, t time.Time) {
+	s.m.Lock()
+	s.updated[stalenessKey{exchange, currencyPair, assetType}] = t
+	s.m.Unlock()
+}
+
+// Describe implements prometheus.Collector
+func (s *stalenessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- s.desc
+}
+
+// Collect implements prometheus.Collector
+func (s *stalenessCollector) Collect(ch chan<- prometheus.Metric) {
+	now := s.now()
+	s.m.Lock()
+	defer s.m.Unlock()
+	for k, t := range s.updated {
+		ch <- prometheus.MustNewConstMetric(s.desc, prometheus.GaugeValue,
+			now.Sub(t).Seconds(), k.exchange, k.currencyPair, k.assetType)
+	}
+}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestAddOrder(t *testing.T) {
+	AddOrder("Bitfinex", OrderSubmit, nil)
+	AddOrder("Bitfinex", OrderSubmit, errors.New("insufficient funds"))
+	AddOrder("Bitfinex", OrderSubmit, errors.New("insufficient funds"))
+
+	success := testutil.ToFloat64(orders.WithLabelValues("Bitfinex", OrderSubmit, resultSuccess))
+	failure := testutil.ToFloat64(orders.WithLabelValues("Bitfinex", OrderSubmit, resultFailure))
+	if success != 1 || failure != 2 {
+		t.Errorf("Test failed. AddOrder expected 1 success and 2 failures got %v and %v",
+			success, failure)
+	}
+}
+
+func TestObserveRequest(t *testing.T) {
+	ObserveRequest("Kraken", "GET", "/0/public/Ticker", 200, time.Millisecond)
+	ObserveRequest("Kraken", "GET", "/0/public/Ticker", 0, time.Second)
+
+	if testutil.CollectAndCount(requestDuration, "gct_exchange_request_duration_seconds") != 2 {
+		t.Error("Test failed. ObserveRequest expected a series per status code")
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"https://api.kraken.com/0/private/AddOrder?pair=XBTUSD":    "/0/private/AddOrder",
+		"https://api.bitfinex.com/v1/pubticker/tBTCUSD":            "/v1/pubticker/:param",
+		"https://api.example.com/v1/order/123456":                  "/v1/order/:param",
+		"https://api.gdax.com/orders/d0c5340b-6d6c-49d9-b567-48c4": "/orders/:param",
+		"https://api.gdax.com/products/BTC-USD/book?level=2":       "/products/:param/book",
+		"https://poloniex.com/public?command=returnTicker":         "/public",
+		"://bad": endpointUnknown,
+	}
+	for rawURL, expected := range tests {
+		if result := Endpoint(rawURL); result != expected {
+			t.Errorf("Test failed. Endpoint(%s) expected %s got %s", rawURL, expected, result)
+		}
+	}
+
+	if Endpoint("https://api.example.com/orders/"+strings.Repeat("a", 64)) != "/orders/:param" {
+		t.Error("Test failed. Endpoint expected long segments to be replaced")
+	}
+}
+
+func TestStalenessCollector(t *testing.T) {
+	s := newStalenessCollector("test")
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	s.update("Bitfinex", "BTCUSD", "SPOT", now.Add(-time.Second*30))
+
+	expected := `# HELP gct_test_staleness_seconds Seconds since the test was last updated by exchange, pair and asset type.
+# TYPE gct_test_staleness_seconds gauge
+gct_test_staleness_seconds{asset="SPOT",exchange="Bitfinex",pair="BTCUSD"} 30
+`
+	err := testutil.CollectAndCompare(s, strings.NewReader(expected))
+	if err != nil {
+		t.Error("Test failed. stalenessCollector unexpected output", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	AddEventCheck()
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body := w.Body.String()
+	for _, name := range []string{"gct_event_checks_total", "go_goroutines"} {
+		if !strings.Contains(body, name) {
+			t.Errorf("Test failed. Handler output missing %s", name)
+		}
+	}
+}
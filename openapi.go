@@ -0,0 +1,353 @@
+package main
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+// OpenAPIVersion is the version of the OpenAPI specification generated
+const OpenAPIVersion = "3.0.3"
+
+const (
+	openAPIBearerAuth = "bearerAuth"
+	openAPIBasicAuth  = "basicAuth"
+	openAPIJSON       = "application/json"
+)
+
+var openAPIPathParam = regexp.MustCompile(`{([^}:]+)(:[^}]+)?}`)
+
+// OpenAPIDocument is an OpenAPI 3 specification of the RESTful API
+type OpenAPIDocument struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Info       OpenAPIInfo                            `json:"info"`
+	Paths      map[string]map[string]OpenAPIOperation `json:"paths"`
+	Components OpenAPIComponents                      `json:"components"`
+}
+
+// OpenAPIInfo holds the API title and version
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// OpenAPIOperation describes a single route, Scope is the API scope required
+// to access it
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+	Security    []map[string][]string      `json:"security,omitempty"`
+	Scope       string                     `json:"x-gct-scope,omitempty"`
+}
+
+// OpenAPIParameter describes a path parameter
+type OpenAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIRequestBody describes a route request body
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIResponse describes a route response for a status code
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+// OpenAPIMediaType holds the schema of a request or response body
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema,omitempty"`
+}
+
+// OpenAPISchema is a JSON schema, an empty schema accepts any value
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// OpenAPIComponents holds the shared schemas and security schemes
+type OpenAPIComponents struct {
+	Schemas         map[string]*OpenAPISchema        `json:"schemas"`
+	SecuritySchemes map[string]OpenAPISecurityScheme `json:"securitySchemes"`
+}
+
+// OpenAPISecurityScheme describes an authentication method
+type OpenAPISecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme"`
+	Description string `json:"description,omitempty"`
+}
+
+// GenerateOpenAPI returns the OpenAPI specification of the routes, it errors if
+// a route is missing its summary or response metadata
+func GenerateOpenAPI(routes Routes) (OpenAPIDocument, error) {
+	doc := OpenAPIDocument{
+		OpenAPI: OpenAPIVersion,
+		Info: OpenAPIInfo{
+			Title:       "GoCryptoTrader RESTful API",
+			Description: "Routes with a x-gct-scope require an API token or the webserver admin credentials.",
+			Version:     MajorVersion + "." + MinorVersion,
+		},
+		Paths: make(map[string]map[string]OpenAPIOperation),
+		Components: OpenAPIComponents{
+			Schemas: make(map[string]*OpenAPISchema),
+			SecuritySchemes: map[string]OpenAPISecurityScheme{
+				openAPIBearerAuth: {
+					Type:        "http",
+					Scheme:      "bearer",
+					Description: "An API token from the webserver config",
+				},
+				openAPIBasicAuth: {
+					Type:        "http",
+					Scheme:      "basic",
+					Description: "The webserver admin username and password",
+				},
+			},
+		},
+	}
+
+	for _, route := range routes {
+		if route.Summary == "" || route.Response == nil {
+			return OpenAPIDocument{}, fmt.Errorf("route %s %s is missing its OpenAPI summary or response",
+				route.Method, route.Pattern)
+		}
+
+		op := OpenAPIOperation{
+			OperationID: route.Name,
+			Summary:     route.Summary,
+			Responses:   make(map[string]OpenAPIResponse),
+			Scope:       route.Scope,
+		}
+
+		for _, match := range openAPIPathParam.FindAllStringSubmatch(route.Pattern, -1) {
+			op.Parameters = append(op.Parameters, OpenAPIParameter{
+				Name:     match[1],
+				In:       "path",
+				Required: true,
+				Schema:   &OpenAPISchema{Type: "string"},
+			})
+		}
+
+		if route.Request != nil {
+			op.RequestBody = &OpenAPIRequestBody{
+				Required: true,
+				Content: map[string]OpenAPIMediaType{
+					openAPIJSON: {Schema: doc.schema(reflect.TypeOf(route.Request))},
+				},
+			}
+		}
+
+		responses, ok := route.Response.(RouteResponses)
+		if !ok {
+			responses = RouteResponses{http.StatusOK: route.Response}
+		}
+		for code, body := range responses {
+			op.Responses[strconv.Itoa(code)] = doc.response(code, body)
+		}
+
+		if route.Scope != "" {
+			op.Security = []map[string][]string{
+				{openAPIBearerAuth: {}},
+				{openAPIBasicAuth: {}},
+			}
+			doc.addResponse(&op, http.StatusUnauthorized)
+			if route.Scope == config.APIScopeAdmin {
+				doc.addResponse(&op, http.StatusForbidden)
+			}
+		}
+
+		pattern := openAPIPathParam.ReplaceAllString(route.Pattern, "{$1}")
+		if doc.Paths[pattern] == nil {
+			doc.Paths[pattern] = make(map[string]OpenAPIOperation)
+		}
+		doc.Paths[pattern][strings.ToLower(route.Method)] = op
+	}
+	return doc, nil
+}
+
+// addResponse adds a plain text authentication error response unless the
+// route already describes the status code
+func (d *OpenAPIDocument) addResponse(op *OpenAPIOperation, code int) {
+	status := strconv.Itoa(code)
+	if _, ok := op.Responses[status]; ok {
+		return
+	}
+	op.Responses[status] = d.response(code, RouteContent("text/plain"))
+}
+
+func (d *OpenAPIDocument) response(code int, body interface{}) OpenAPIResponse {
+	resp := OpenAPIResponse{Description: http.StatusText(code)}
+	switch b := body.(type) {
+	case nil:
+	case RouteContent:
+		resp.Content = map[string]OpenAPIMediaType{
+			string(b): {Schema: &OpenAPISchema{Type: "string"}},
+		}
+	default:
+		resp.Content = map[string]OpenAPIMediaType{
+			openAPIJSON: {Schema: d.schema(reflect.TypeOf(body))},
+		}
+	}
+	return resp
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	durationType      = reflect.TypeOf(time.Duration(0))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schema returns the JSON schema of a Go type matching its encoding/json
+// output, named struct types are added to the components and referenced
+func (d *OpenAPIDocument) schema(t reflect.Type) *OpenAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case t == durationType:
+		return &OpenAPISchema{Type: "integer", Format: "int64",
+			Description: "Duration in nanoseconds"}
+	case t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType):
+		return &OpenAPISchema{}
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return &OpenAPISchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: d.schema(t.Elem())}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: d.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return d.structSchema(t)
+		}
+		name := openAPISchemaName(t)
+		if _, ok := d.Components.Schemas[name]; !ok {
+			// reserve the name first so recursive types terminate
+			d.Components.Schemas[name] = &OpenAPISchema{}
+			d.Components.Schemas[name] = d.structSchema(t)
+		}
+		return &OpenAPISchema{Ref: "#/components/schemas/" + name}
+	}
+	return &OpenAPISchema{}
+}
+
+// structSchema returns the object schema of a struct, fields without omitempty
+// are required and embedded structs are flattened as encoding/json does
+func (d *OpenAPIDocument) structSchema(t reflect.Type) *OpenAPISchema {
+	s := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts := tag, ""
+		if idx := strings.Index(tag, ","); idx != -1 {
+			name, opts = tag[:idx], tag[idx+1:]
+		}
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded := d.structSchema(ft)
+				for k, v := range embedded.Properties {
+					s.Properties[k] = v
+				}
+				s.Required = append(s.Required, embedded.Required...)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldSchema := d.schema(field.Type)
+		if strings.Contains(opts, "string") {
+			fieldSchema = &OpenAPISchema{Type: "string"}
+		}
+		s.Properties[name] = fieldSchema
+		if !strings.Contains(opts, "omitempty") {
+			s.Required = append(s.Required, name)
+		}
+	}
+	sort.Strings(s.Required)
+	return s
+}
+
+// openAPISchemaName returns the component name of a named type, types from
+// other packages are prefixed with their package name
+func openAPISchemaName(t reflect.Type) string {
+	if t.PkgPath() == reflect.TypeOf(Route{}).PkgPath() {
+		return t.Name()
+	}
+	return t.String()
+}
+
+// RESTGetOpenAPI returns the OpenAPI specification of the registered routes
+func RESTGetOpenAPI(w http.ResponseWriter, r *http.Request) {
+	doc, err := GenerateOpenAPI(routes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, doc)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestOpenAPIRouteMetadata fails when a route is added without the metadata
+// needed to describe it in the OpenAPI specification
+func TestOpenAPIRouteMetadata(t *testing.T) {
+	NewRouter(nil)
+
+	doc, err := GenerateOpenAPI(routes)
+	if err != nil {
+		t.Fatal("Test failed. GenerateOpenAPI error", err)
+	}
+
+	for _, route := range routes {
+		if (route.Method == "POST" || route.Method == "PUT") && route.Request == nil {
+			t.Errorf("Test failed. Route %s has no request body schema", route.Name)
+		}
+
+		op, ok := doc.Paths[route.Pattern][strings.ToLower(route.Method)]
+		if !ok || op.OperationID != route.Name {
+			t.Errorf("Test failed. Route %s missing from the OpenAPI paths", route.Name)
+			continue
+		}
+
+		if route.Scope != "" && len(op.Security) == 0 {
+			t.Errorf("Test failed. Route %s has no security requirement", route.Name)
+		}
+
+		for status, resp := range op.Responses {
+			for mediaType, content := range resp.Content {
+				if mediaType == openAPIJSON && content.Schema.Ref == "" &&
+					content.Schema.Type == "" {
+					t.Errorf("Test failed. Route %s %s response has an empty schema",
+						route.Name, status)
+				}
+			}
+		}
+	}
+
+	for name, schema := range doc.Components.Schemas {
+		if schema.Type != "object" {
+			t.Errorf("Test failed. Schema %s is not an object", name)
+		}
+	}
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal("Test failed. OpenAPI document not encodable", err)
+	}
+	for _, ref := range openAPIRefs(string(encoded)) {
+		if _, ok := doc.Components.Schemas[ref]; !ok {
+			t.Errorf("Test failed. Schema reference %s has no component", ref)
+		}
+	}
+}
+
+func openAPIRefs(encoded string) []string {
+	const prefix = `"$ref":"#/components/schemas/`
+	var refs []string
+	for _, part := range strings.Split(encoded, prefix)[1:] {
+		refs = append(refs, part[:strings.Index(part, `"`)])
+	}
+	return refs
+}
+
+func TestGenerateOpenAPI(t *testing.T) {
+	_, err := GenerateOpenAPI(Routes{
+		Route{"Test", "GET", "/test", getIndex, "", "", nil, nil},
+	})
+	if err == nil {
+		t.Error("Test failed. GenerateOpenAPI accepted a route without metadata")
+	}
+
+	doc, err := GenerateOpenAPI(Routes{
+		Route{"Test", "POST", "/test/{exchangeName}", getIndex, "admin", "Test",
+			OrderSubmitRequest{}, restErrorResponses(OrderResponse{})},
+	})
+	if err != nil {
+		t.Fatal("Test failed. GenerateOpenAPI error", err)
+	}
+
+	op := doc.Paths["/test/{exchangeName}"]["post"]
+	if len(op.Parameters) != 1 || op.Parameters[0].Name != "exchangeName" {
+		t.Error("Test failed. GenerateOpenAPI unexpected path parameters", op.Parameters)
+	}
+	if op.Responses["401"].Content["text/plain"].Schema == nil ||
+		op.Responses["403"].Content[openAPIJSON].Schema == nil {
+		t.Error("Test failed. GenerateOpenAPI unexpected error responses", op.Responses)
+	}
+
+	submit := doc.Components.Schemas["OrderSubmitRequest"]
+	if submit == nil || submit.Properties["amount"].Type != "number" ||
+		len(submit.Required) != 6 {
+		t.Error("Test failed. GenerateOpenAPI unexpected request schema", submit)
+	}
+}
+
+func TestRESTGetOpenAPI(t *testing.T) {
+	router := NewRouter(nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatal("Test failed. /openapi.json unexpected status", w.Code)
+	}
+
+	var doc OpenAPIDocument
+	err := json.NewDecoder(w.Body).Decode(&doc)
+	if err != nil || doc.OpenAPI != OpenAPIVersion || len(doc.Paths) == 0 {
+		t.Error("Test failed. /openapi.json unexpected response", err)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency"
 )
 
 const (
@@ -24,6 +26,11 @@ const (
 // Portfolio is variable store holding an array of portfolioAddress
 var Portfolio Base
 
+var (
+	watcherLastRun time.Time
+	watcherMtx     sync.Mutex
+)
+
 // GetEthereumBalance single or multiple address information as
 // EtherchainBalanceResponse
 func GetEthereumBalance(address string) (EthplorerResponse, error) {
@@ -369,6 +376,32 @@ func (p *Base) GetPortfolioSummary() Summary {
 	return portfolioOutput
 }
 
+// SetValues values the coins of the summary in the display currency, coins are
+// converted through forex rates and exchange markets so altcoins and
+// stablecoins are valued too. Coins without a conversion path have no value
+func (s *Summary) SetValues(displayCurrency string) {
+	s.DisplayCurrency = displayCurrency
+	s.TotalValue = 0
+
+	setValues := func(coins []Coin) {
+		for x := range coins {
+			value, err := currency.ConvertCrossRate(coins[x].Balance, coins[x].Coin, displayCurrency)
+			if err != nil {
+				continue
+			}
+			coins[x].Value = value
+		}
+	}
+
+	setValues(s.Totals)
+	setValues(s.Offline)
+	setValues(s.Online)
+
+	for x := range s.Totals {
+		s.TotalValue += s.Totals[x].Value
+	}
+}
+
 // GetPortfolioGroupedCoin returns portfolio base information grouped by coin
 func (p *Base) GetPortfolioGroupedCoin() map[string][]string {
 	result := make(map[string][]string)
@@ -404,10 +437,21 @@ func StartPortfolioWatcher() {
 				)
 			}
 		}
+		watcherMtx.Lock()
+		watcherLastRun = time.Now()
+		watcherMtx.Unlock()
 		time.Sleep(time.Minute * 10)
 	}
 }
 
+// GetWatcherLastRun returns when the portfolio watcher last finished updating
+// address balances or the zero time if it has not run
+func GetWatcherLastRun() time.Time {
+	watcherMtx.Lock()
+	defer watcherMtx.Unlock()
+	return watcherLastRun
+}
+
 // GetPortfolio returns a pointer to the portfolio base
 func GetPortfolio() *Base {
 	return &Portfolio
@@ -1,9 +1,15 @@
 package portfolio
 
 import (
+	"math"
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency"
+	"github.com/thrasher-/gocryptotrader/currency/forexprovider"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/stats"
 )
 
 func TestGetEthereumBalance(t *testing.T) {
@@ -337,6 +343,49 @@ func TestGetPortfolioSummary(t *testing.T) {
 	}
 }
 
+func TestSummarySetValues(t *testing.T) {
+	backupRates, backupItems, backupProviders := currency.FXRates, stats.Items, currency.FXProviders
+	defer func() {
+		currency.FXRates, stats.Items, currency.FXProviders = backupRates, backupItems, backupProviders
+	}()
+
+	currency.FXProviders = forexprovider.NewDefaultFXProvider()
+	currency.FXRates = map[string]float64{"USDAUD": 1.5}
+	stats.Items = []stats.Item{
+		{Exchange: "Poloniex", Pair: pair.NewCurrencyPair("XMR", "BTC"), AssetType: "SPOT", Price: 0.02, Volume: 100},
+		{Exchange: "Bitstamp", Pair: pair.NewCurrencyPair("BTC", "USD"), AssetType: "SPOT", Price: 5000, Volume: 1000},
+	}
+
+	summary := Summary{
+		Totals: []Coin{
+			{Coin: "XMR", Balance: 10},
+			{Coin: "USDT", Balance: 100},
+			{Coin: "BIRDS", Balance: 1},
+		},
+	}
+	summary.SetValues("AUD")
+
+	if summary.DisplayCurrency != "AUD" {
+		t.Error("Test Failed - portfolio_test.go - TestSummarySetValues display currency not set")
+	}
+
+	if math.Abs(summary.Totals[0].Value-1500) > 1e-9 {
+		t.Errorf("Test Failed - portfolio_test.go - TestSummarySetValues unexpected XMR value %f", summary.Totals[0].Value)
+	}
+
+	if math.Abs(summary.Totals[1].Value-150) > 1e-9 {
+		t.Errorf("Test Failed - portfolio_test.go - TestSummarySetValues unexpected USDT value %f", summary.Totals[1].Value)
+	}
+
+	if summary.Totals[2].Value != 0 {
+		t.Error("Test Failed - portfolio_test.go - TestSummarySetValues valued an unknown coin")
+	}
+
+	if math.Abs(summary.TotalValue-1650) > 1e-9 {
+		t.Errorf("Test Failed - portfolio_test.go - TestSummarySetValues unexpected total %f", summary.TotalValue)
+	}
+}
+
 func TestGetPortfolioGroupedCoin(t *testing.T) {
 	newbase := Base{}
 	newbase.AddAddress("someaddress", "LTC", "LTCWALLETTEST", 0.02)
@@ -83,13 +83,14 @@ type ExchangeAccountCurrencyInfo struct {
 	Hold         float64
 }
 
-// Coin stores a coin type, balance, address and percentage relative to the total
-// amount.
+// Coin stores a coin type, balance, address, percentage relative to the total
+// amount and value in the display currency.
 type Coin struct {
 	Coin       string  `json:"coin"`
 	Balance    float64 `json:"balance"`
 	Address    string  `json:"address,omitempty"`
 	Percentage float64 `json:"percentage,omitempty"`
+	Value      float64 `json:"value,omitempty"`
 }
 
 // OfflineCoinSummary stores a coin types address, balance and percentage
@@ -114,4 +115,7 @@ type Summary struct {
 	OfflineSummary map[string][]OfflineCoinSummary         `json:"offline_summary"`
 	Online         []Coin                                  `json:"coins_online"`
 	OnlineSummary  map[string]map[string]OnlineCoinSummary `json:"online_summary"`
+
+	DisplayCurrency string  `json:"display_currency,omitempty"`
+	TotalValue      float64 `json:"total_value,omitempty"`
 }
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+)
+
+// vars related to the trading endpoints
+var (
+	ErrWithdrawalsDisabled = errors.New("withdrawals are disabled, set allowWithdrawals in the webserver config to enable them")
+	ErrInvalidRequestBody  = errors.New("invalid JSON request body")
+)
+
+// OrderSubmitRequest is the request body used to submit an order
+type OrderSubmitRequest struct {
+	Exchange  string  `json:"exchangeName"`
+	Currency  string  `json:"currency"`
+	Side      string  `json:"side"`
+	OrderType string  `json:"orderType"`
+	Amount    float64 `json:"amount"`
+	Price     float64 `json:"price"`
+	ClientID  string  `json:"clientID,omitempty"`
+}
+
+// OrderModifyRequest is the request body used to modify an order
+type OrderModifyRequest struct {
+	Exchange  string  `json:"exchangeName"`
+	OrderID   string  `json:"orderID"`
+	Side      string  `json:"side"`
+	OrderType string  `json:"orderType"`
+	Amount    float64 `json:"amount"`
+	Price     float64 `json:"price"`
+}
+
+// OrderRequest is the request body used to cancel or fetch a single order or
+// list or cancel all orders on an exchange
+type OrderRequest struct {
+	Exchange string `json:"exchangeName"`
+	OrderID  string `json:"orderID,omitempty"`
+}
+
+// DepositAddressRequest is the request body used to fetch a deposit address
+type DepositAddressRequest struct {
+	Exchange string `json:"exchangeName"`
+	Currency string `json:"currency"`
+}
+
+// WithdrawRequest is the request body used to withdraw funds, Address is only
+// used for cryptocurrency withdrawals
+type WithdrawRequest struct {
+	Exchange string  `json:"exchangeName"`
+	Currency string  `json:"currency"`
+	Address  string  `json:"address,omitempty"`
+	Amount   float64 `json:"amount"`
+}
+
+// OrderResponse holds the ID of a submitted or modified order
+type OrderResponse struct {
+	OrderID string `json:"orderID"`
+}
+
+// DepositAddressResponse holds an exchange deposit address
+type DepositAddressResponse struct {
+	Exchange string `json:"exchangeName"`
+	Currency string `json:"currency"`
+	Address  string `json:"address"`
+}
+
+// WithdrawResponse holds the exchange ID of a withdrawal request
+type WithdrawResponse struct {
+	ID string `json:"id"`
+}
+
+// RESTErrorResponse is the response body sent when a request fails
+type RESTErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// restErrorStatus returns the HTTP status code for a trading error
+func restErrorStatus(err error) int {
+	switch err {
+	case ErrExchangeNotFound:
+		return http.StatusNotFound
+	case ErrDryRunEnabled, ErrWithdrawalsDisabled:
+		return http.StatusForbidden
+	case ErrInvalidCurrencyPair, ErrInvalidOrderSide, ErrInvalidOrderType,
+		ErrInvalidOrderAmount, ErrInvalidOrderID, ErrInvalidWithdrawal,
+		ErrCurrencyNotSet, ErrInvalidRequestBody, ErrOrderTypeNotSupported,
+		exchange.ErrOrderAmountBelowMinimum, exchange.ErrOrderAmountAboveMaximum,
+		exchange.ErrOrderNotionalBelowMinimum:
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// RESTfulErrorResponse outputs a JSON error response with the status code
+// matching the error
+func RESTfulErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(restErrorStatus(err))
+	err = json.NewEncoder(w).Encode(RESTErrorResponse{Error: err.Error()})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// restDecodeBody decodes an optional JSON request body
+func restDecodeBody(r *http.Request, v interface{}) error {
+	if r.Body == nil || r.ContentLength == 0 {
+		return nil
+	}
+	if json.NewDecoder(r.Body).Decode(v) != nil {
+		return ErrInvalidRequestBody
+	}
+	return nil
+}
+
+// restRespond outputs the response or the error if one occurred
+func restRespond(w http.ResponseWriter, r *http.Request, response interface{}, err error) {
+	if err != nil {
+		RESTfulErrorResponse(w, r, err)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// SubmitOrder places an order requested through the RESTful or websocket API
+func SubmitOrder(req OrderSubmitRequest) (OrderResponse, error) {
+	orderID, err := bot.control.SubmitOrder(req.Exchange, req.Currency, req.Side,
+		req.OrderType, req.Amount, req.Price, req.ClientID)
+	return OrderResponse{OrderID: orderID}, err
+}
+
+// ModifyOrder amends an order requested through the RESTful or websocket API
+func ModifyOrder(req OrderModifyRequest) (OrderResponse, error) {
+	orderID, err := bot.control.ModifyOrder(req.Exchange, req.OrderID, req.Side,
+		req.OrderType, req.Amount, req.Price)
+	return OrderResponse{OrderID: orderID}, err
+}
+
+// GetDepositAddress returns a deposit address requested through the RESTful
+// or websocket API
+func GetDepositAddress(req DepositAddressRequest) (DepositAddressResponse, error) {
+	address, err := bot.control.GetDepositAddress(req.Exchange, req.Currency)
+	return DepositAddressResponse{
+		Exchange: req.Exchange,
+		Currency: common.StringToUpper(req.Currency),
+		Address:  address,
+	}, err
+}
+
+// Withdraw withdraws funds requested through the RESTful or websocket API if
+// withdrawals are enabled in the webserver config
+func Withdraw(req WithdrawRequest, fiat bool) (WithdrawResponse, error) {
+	if !getWebserverConfig().AllowWithdrawals {
+		return WithdrawResponse{}, ErrWithdrawalsDisabled
+	}
+
+	var id string
+	var err error
+	if fiat {
+		id, err = bot.control.WithdrawFiat(req.Exchange, req.Currency, req.Amount)
+	} else {
+		id, err = bot.control.WithdrawCryptocurrency(req.Exchange, req.Currency,
+			req.Address, req.Amount)
+	}
+	return WithdrawResponse{ID: id}, err
+}
+
+// RESTGetOrders returns the open orders placed through the bot on an exchange
+func RESTGetOrders(w http.ResponseWriter, r *http.Request) {
+	exchangeName := mux.Vars(r)["exchangeName"]
+	_, err := getLoadedExchange(exchangeName)
+	if err != nil {
+		RESTfulErrorResponse(w, r, err)
+		return
+	}
+
+	orders, err := bot.control.GetOpenOrders(exchangeName)
+	if orders == nil {
+		orders = []base.ControlOrder{}
+	}
+	restRespond(w, r, orders, err)
+}
+
+// RESTSubmitOrder places an order on an exchange
+func RESTSubmitOrder(w http.ResponseWriter, r *http.Request) {
+	var req OrderSubmitRequest
+	err := restDecodeBody(r, &req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, err)
+		return
+	}
+
+	req.Exchange = mux.Vars(r)["exchangeName"]
+	resp, err := SubmitOrder(req)
+	restRespond(w, r, resp, err)
+}
+
+// RESTCancelAllOrders cancels all orders on an exchange
+func RESTCancelAllOrders(w http.ResponseWriter, r *http.Request) {
+	err := bot.control.CancelAllOrders(mux.Vars(r)["exchangeName"])
+	restRespond(w, r, WebsocketResponseSuccess, err)
+}
+
+// RESTGetOrderInfo returns the exchange details of an order
+func RESTGetOrderInfo(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	resp, err := bot.control.GetOrderInfo(vars["exchangeName"], vars["orderID"])
+	restRespond(w, r, resp, err)
+}
+
+// RESTModifyOrder amends an order
+func RESTModifyOrder(w http.ResponseWriter, r *http.Request) {
+	var req OrderModifyRequest
+	err := restDecodeBody(r, &req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, err)
+		return
+	}
+
+	vars := mux.Vars(r)
+	req.Exchange = vars["exchangeName"]
+	req.OrderID = vars["orderID"]
+	resp, err := ModifyOrder(req)
+	restRespond(w, r, resp, err)
+}
+
+// RESTCancelOrder cancels an order
+func RESTCancelOrder(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	err := bot.control.CancelOrder(vars["exchangeName"], vars["orderID"])
+	restRespond(w, r, WebsocketResponseSuccess, err)
+}
+
+// RESTGetDepositAddress returns an exchange deposit address for a
+// cryptocurrency
+func RESTGetDepositAddress(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	resp, err := GetDepositAddress(DepositAddressRequest{
+		Exchange: vars["exchangeName"],
+		Currency: vars["currency"],
+	})
+	restRespond(w, r, resp, err)
+}
+
+// RESTWithdrawCryptocurrency withdraws a cryptocurrency to an address
+func RESTWithdrawCryptocurrency(w http.ResponseWriter, r *http.Request) {
+	restWithdraw(w, r, false)
+}
+
+// RESTWithdrawFiat withdraws a fiat currency to the registered bank account
+func RESTWithdrawFiat(w http.ResponseWriter, r *http.Request) {
+	restWithdraw(w, r, true)
+}
+
+func restWithdraw(w http.ResponseWriter, r *http.Request, fiat bool) {
+	var req WithdrawRequest
+	err := restDecodeBody(r, &req)
+	if err != nil {
+		RESTfulErrorResponse(w, r, err)
+		return
+	}
+
+	req.Exchange = mux.Vars(r)["exchangeName"]
+	resp, err := Withdraw(req, fiat)
+	restRespond(w, r, resp, err)
+}
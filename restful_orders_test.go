@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+func setupRESTOrdersTest(t *testing.T) http.Handler {
+	SetupTest(t)
+	bot.control = &BotControl{}
+	bot.config.Webserver.AllowWithdrawals = false
+	bot.config.Webserver.APITokens = []config.APIToken{
+		{Name: "reader", Token: "readtoken", Scope: config.APIScopeRead},
+		{Name: "admin", Token: "admintoken", Scope: config.APIScopeAdmin},
+	}
+	return NewRouter(nil)
+}
+
+func restOrdersRequest(router http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRESTOrderErrors(t *testing.T) {
+	router := setupRESTOrdersTest(t)
+	bot.dryRun = true
+	defer func() { bot.dryRun = false }()
+
+	tests := []struct {
+		method   string
+		path     string
+		token    string
+		body     string
+		expected int
+	}{
+		{"GET", "/exchanges/asdsad/orders", "readtoken", "", http.StatusNotFound},
+		{"POST", "/exchanges/Bitfinex/orders", "readtoken", "", http.StatusForbidden},
+		{"POST", "/exchanges/Bitfinex/orders", "admintoken", "{",
+			http.StatusBadRequest},
+		{"POST", "/exchanges/Bitfinex/orders", "admintoken",
+			`{"currency":"BTCUSD","side":"buy","orderType":"limit","amount":1,"price":1}`,
+			http.StatusForbidden},
+		{"GET", "/exchanges/Bitfinex/orders/abc", "readtoken", "",
+			http.StatusBadRequest},
+		{"DELETE", "/exchanges/Bitfinex/orders", "admintoken", "",
+			http.StatusForbidden},
+		{"GET", "/exchanges/asdsad/deposit/BTC", "admintoken", "",
+			http.StatusNotFound},
+		{"POST", "/exchanges/Bitfinex/withdraw/crypto", "admintoken",
+			`{"currency":"BTC","address":"1F5zVDgNjorJ51oGebSvNCrSAHpwGkUdDB","amount":1}`,
+			http.StatusForbidden},
+	}
+
+	for i, test := range tests {
+		w := restOrdersRequest(router, test.method, test.path, test.token,
+			test.body)
+		if w.Code != test.expected {
+			t.Errorf("Test failed. REST order test %d expected status %d got %d %s",
+				i, test.expected, w.Code, w.Body.String())
+		}
+	}
+
+	w := restOrdersRequest(router, "POST", "/exchanges/Bitfinex/withdraw/fiat",
+		"admintoken", `{"currency":"USD","amount":1}`)
+	var resp RESTErrorResponse
+	err := json.NewDecoder(w.Body).Decode(&resp)
+	if err != nil || resp.Error != ErrWithdrawalsDisabled.Error() {
+		t.Error("Test failed. REST withdraw fiat unexpected response", err, resp)
+	}
+}
+
+func TestRESTGetOrders(t *testing.T) {
+	router := setupRESTOrdersTest(t)
+	bot.control.orders = []base.ControlOrder{
+		{Exchange: "Bitfinex", OrderID: "1"},
+		{Exchange: "Kraken", OrderID: "2"},
+	}
+
+	w := restOrdersRequest(router, "GET", "/exchanges/Bitfinex/orders",
+		"readtoken", "")
+	if w.Code != http.StatusOK {
+		t.Fatal("Test failed. RESTGetOrders unexpected status", w.Code)
+	}
+
+	var orders []base.ControlOrder
+	err := json.NewDecoder(w.Body).Decode(&orders)
+	if err != nil || len(orders) != 1 || orders[0].OrderID != "1" {
+		t.Error("Test failed. RESTGetOrders unexpected response", err, orders)
+	}
+}
@@ -1,15 +1,28 @@
 package main
 
 import (
+	"context"
+	"crypto/subtle"
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/thrasher-/gocryptotrader/communications/base"
+	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/portfolio"
 )
 
+type restContextKey int
+
+// restScopeKey stores the scope granted to an authenticated request
+const restScopeKey restContextKey = iota
+
 // RESTLogger logs the requests internally
 func RESTLogger(inner http.Handler, name string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -27,14 +40,90 @@ func RESTLogger(inner http.Handler, name string) http.Handler {
 	})
 }
 
-// Route is a sub type that holds the request routes
+// RESTAuth authenticates requests using either an API token sent as a bearer
+// token or HTTP basic auth with the webserver admin credentials. Routes with an
+// empty scope are public, read routes accept any valid credentials and admin
+// routes require admin credentials
+func RESTAuth(inner http.Handler, scope string) http.Handler {
+	if scope == "" {
+		return inner
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		granted, ok := restAuthenticate(r)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Basic realm="GoCryptoTrader"`)
+			http.Error(w, "unauthorised", http.StatusUnauthorized)
+			return
+		}
+
+		if scope == config.APIScopeAdmin && granted != config.APIScopeAdmin {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		inner.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), restScopeKey, granted)))
+	})
+}
+
+// restAuthenticate returns the scope granted by the requests credentials
+func restAuthenticate(r *http.Request) (string, bool) {
+	webserver := getWebserverConfig()
+	auth := r.Header.Get("Authorization")
+	if strings.HasPrefix(auth, "Bearer ") {
+		token := []byte(strings.TrimPrefix(auth, "Bearer "))
+		for _, t := range webserver.APITokens {
+			if subtle.ConstantTimeCompare(token, []byte(t.Token)) == 1 {
+				return t.Scope, true
+			}
+		}
+		return "", false
+	}
+
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return "", false
+	}
+
+	userMatch := subtle.ConstantTimeCompare([]byte(username),
+		[]byte(webserver.AdminUsername))
+	passMatch := subtle.ConstantTimeCompare([]byte(password),
+		[]byte(webserver.AdminPassword))
+	if userMatch&passMatch != 1 {
+		return "", false
+	}
+	return config.APIScopeAdmin, true
+}
+
+// RESTScope returns the scope granted to an authenticated request
+func RESTScope(r *http.Request) string {
+	scope, _ := r.Context().Value(restScopeKey).(string)
+	return scope
+}
+
+// Route is a sub type that holds the request routes, Scope is the API scope
+// required to access the route or empty for public routes. Summary, Request
+// and Response describe the route in the OpenAPI specification, Request is the
+// JSON request body or nil if the route has none and Response is either the
+// JSON response body, a RouteContent or RouteResponses
 type Route struct {
 	Name        string
 	Method      string
 	Pattern     string
 	HandlerFunc http.HandlerFunc
+	Scope       string
+	Summary     string
+	Request     interface{}
+	Response    interface{}
 }
 
+// RouteContent is the media type of a route response which is not JSON
+type RouteContent string
+
+// RouteResponses holds the response body of a route by HTTP status code, a nil
+// body means the response has no content
+type RouteResponses map[int]interface{}
+
 // Routes is an array of all the registered routes
 type Routes []Route
 
@@ -47,70 +136,277 @@ func NewRouter(exchanges []exchange.IBotExchange) *mux.Router {
 
 	routes = Routes{
 		Route{
-			"",
+			"Index",
 			"GET",
 			"/",
 			getIndex,
+			"",
+			"Returns the RESTful interface index page",
+			nil,
+			RouteContent("text/html"),
 		},
 		Route{
 			"GetAllSettings",
 			"GET",
 			"/config/all",
 			RESTGetAllSettings,
+			config.APIScopeRead,
+			"Returns the bot config, secrets are redacted for non admin requests",
+			nil,
+			config.Config{},
+		},
+		Route{
+			"EffectiveConfig",
+			"GET",
+			"/config/effective",
+			RESTGetEffectiveConfig,
+			config.APIScopeRead,
+			"Returns the config after includes and the profile are applied, secrets are redacted for non admin requests",
+			nil,
+			EffectiveConfig{},
 		},
 		Route{
 			"SaveAllSettings",
 			"POST",
 			"/config/all/save",
 			RESTSaveAllSettings,
+			config.APIScopeAdmin,
+			"Saves and reloads the bot config",
+			config.Post{},
+			config.Config{},
 		},
 		Route{
 			"AllEnabledAccountInfo",
 			"GET",
 			"/exchanges/enabled/accounts/all",
 			RESTGetAllEnabledAccountInfo,
+			config.APIScopeRead,
+			"Returns the account info of every enabled exchange",
+			nil,
+			AllEnabledExchangeAccounts{},
 		},
 		Route{
 			"AllActiveExchangesAndCurrencies",
 			"GET",
 			"/exchanges/enabled/latest/all",
 			RESTGetAllActiveTickers,
+			config.APIScopeRead,
+			"Returns the latest tickers of every enabled exchange",
+			nil,
+			AllEnabledExchangeCurrencies{},
 		},
 		Route{
 			"IndividualExchangeAndCurrency",
 			"GET",
 			"/exchanges/{exchangeName}/latest/{currency}",
 			RESTGetTicker,
+			config.APIScopeRead,
+			"Returns the latest ticker for an exchange currency pair",
+			nil,
+			ticker.Price{},
 		},
 		Route{
 			"GetPortfolio",
 			"GET",
 			"/portfolio/all",
 			RESTGetPortfolio,
+			config.APIScopeRead,
+			"Returns the portfolio summary",
+			nil,
+			portfolio.Summary{},
 		},
 		Route{
 			"AllActiveExchangesAndOrderbooks",
 			"GET",
 			"/exchanges/orderbook/latest/all",
 			RESTGetAllActiveOrderbooks,
+			config.APIScopeRead,
+			"Returns the latest orderbooks of every enabled exchange",
+			nil,
+			AllEnabledExchangeOrderbooks{},
 		},
 		Route{
 			"IndividualExchangeOrderbook",
 			"GET",
 			"/exchanges/{exchangeName}/orderbook/latest/{currency}",
 			RESTGetOrderbook,
+			config.APIScopeRead,
+			"Returns the latest orderbook for an exchange currency pair",
+			nil,
+			orderbook.Base{},
+		},
+		Route{
+			"AllExchangeCapabilities",
+			"GET",
+			"/exchanges/capabilities/all",
+			RESTGetAllExchangeCapabilities,
+			config.APIScopeRead,
+			"Returns the capabilities of every loaded exchange",
+			nil,
+			AllExchangeCapabilities{},
+		},
+		Route{
+			"ExchangeCapabilities",
+			"GET",
+			"/exchanges/{exchangeName}/capabilities",
+			RESTGetExchangeCapabilities,
+			config.APIScopeRead,
+			"Returns the capabilities of an exchange",
+			nil,
+			restErrorResponses(exchange.Capabilities{}),
+		},
+		Route{
+			"GetOrders",
+			"GET",
+			"/exchanges/{exchangeName}/orders",
+			RESTGetOrders,
+			config.APIScopeRead,
+			"Returns the open orders placed through the bot on an exchange",
+			nil,
+			restErrorResponses([]base.ControlOrder{}),
+		},
+		Route{
+			"SubmitOrder",
+			"POST",
+			"/exchanges/{exchangeName}/orders",
+			RESTSubmitOrder,
+			config.APIScopeAdmin,
+			"Places an order on an exchange",
+			OrderSubmitRequest{},
+			restErrorResponses(OrderResponse{}),
+		},
+		Route{
+			"CancelAllOrders",
+			"DELETE",
+			"/exchanges/{exchangeName}/orders",
+			RESTCancelAllOrders,
+			config.APIScopeAdmin,
+			"Cancels all orders on an exchange",
+			nil,
+			restErrorResponses(WebsocketResponseSuccess),
+		},
+		Route{
+			"GetOrderInfo",
+			"GET",
+			"/exchanges/{exchangeName}/orders/{orderID}",
+			RESTGetOrderInfo,
+			config.APIScopeRead,
+			"Returns the exchange details of an order",
+			nil,
+			restErrorResponses(exchange.OrderDetail{}),
+		},
+		Route{
+			"ModifyOrder",
+			"PUT",
+			"/exchanges/{exchangeName}/orders/{orderID}",
+			RESTModifyOrder,
+			config.APIScopeAdmin,
+			"Amends an order",
+			OrderModifyRequest{},
+			restErrorResponses(OrderResponse{}),
+		},
+		Route{
+			"CancelOrder",
+			"DELETE",
+			"/exchanges/{exchangeName}/orders/{orderID}",
+			RESTCancelOrder,
+			config.APIScopeAdmin,
+			"Cancels an order",
+			nil,
+			restErrorResponses(WebsocketResponseSuccess),
+		},
+		Route{
+			"GetDepositAddress",
+			"GET",
+			"/exchanges/{exchangeName}/deposit/{currency}",
+			RESTGetDepositAddress,
+			config.APIScopeRead,
+			"Returns an exchange deposit address for a cryptocurrency",
+			nil,
+			restErrorResponses(DepositAddressResponse{}),
+		},
+		Route{
+			"WithdrawCryptocurrency",
+			"POST",
+			"/exchanges/{exchangeName}/withdraw/crypto",
+			RESTWithdrawCryptocurrency,
+			config.APIScopeAdmin,
+			"Withdraws a cryptocurrency to an address",
+			WithdrawRequest{},
+			restErrorResponses(WithdrawResponse{}),
+		},
+		Route{
+			"WithdrawFiat",
+			"POST",
+			"/exchanges/{exchangeName}/withdraw/fiat",
+			RESTWithdrawFiat,
+			config.APIScopeAdmin,
+			"Withdraws a fiat currency to the registered bank account",
+			WithdrawRequest{},
+			restErrorResponses(WithdrawResponse{}),
+		},
+		Route{
+			"Health",
+			"GET",
+			"/health",
+			RESTGetHealth,
+			"",
+			"Returns the health of each subsystem",
+			nil,
+			RouteResponses{
+				http.StatusOK:                 HealthReport{},
+				http.StatusServiceUnavailable: HealthReport{},
+			},
+		},
+		Route{
+			"Ready",
+			"GET",
+			"/ready",
+			RESTGetReady,
+			"",
+			"Returns whether the bot is ready to serve requests",
+			nil,
+			RouteResponses{
+				http.StatusOK:                 HealthReport{},
+				http.StatusServiceUnavailable: HealthReport{},
+			},
+		},
+		Route{
+			"Metrics",
+			"GET",
+			"/metrics",
+			RESTGetMetrics,
+			config.APIScopeRead,
+			"Returns the bot metrics in the Prometheus text format",
+			nil,
+			RouteContent("text/plain; version=0.0.4"),
+		},
+		Route{
+			"OpenAPI",
+			"GET",
+			"/openapi.json",
+			RESTGetOpenAPI,
+			"",
+			"Returns the OpenAPI specification of the RESTful API",
+			nil,
+			OpenAPIDocument{},
 		},
 		Route{
 			"ws",
 			"GET",
 			"/ws",
 			WebsocketClientHandler,
+			"",
+			"Upgrades the connection to the websocket API",
+			nil,
+			RouteResponses{http.StatusSwitchingProtocols: nil},
 		},
 	}
 
 	for _, route := range routes {
 		var handler http.Handler
 		handler = route.HandlerFunc
+		handler = RESTAuth(handler, route.Scope)
 		handler = RESTLogger(handler, route.Name)
 
 		router.
@@ -122,6 +418,18 @@ func NewRouter(exchanges []exchange.IBotExchange) *mux.Router {
 	return router
 }
 
+// restErrorResponses returns the responses of a route which replies with a
+// RESTErrorResponse when the request fails
+func restErrorResponses(response interface{}) RouteResponses {
+	return RouteResponses{
+		http.StatusOK:                  response,
+		http.StatusBadRequest:          RESTErrorResponse{},
+		http.StatusForbidden:           RESTErrorResponse{},
+		http.StatusNotFound:            RESTErrorResponse{},
+		http.StatusInternalServerError: RESTErrorResponse{},
+	}
+}
+
 func getIndex(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, "<html>GoCryptoTrader RESTful interface. For the web GUI, please visit the <a href=https://github.com/thrasher-/gocryptotrader/blob/master/web/README.md>web GUI readme.</a></html>")
 	w.WriteHeader(http.StatusOK)
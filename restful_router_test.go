@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+func TestRESTAuth(t *testing.T) {
+	bot.config = loadConfig(t)
+	bot.config.Webserver.AdminUsername = "admin"
+	bot.config.Webserver.AdminPassword = "password"
+	bot.config.Webserver.APITokens = []config.APIToken{
+		{Name: "reader", Token: "readtoken", Scope: config.APIScopeRead},
+		{Name: "admin", Token: "admintoken", Scope: config.APIScopeAdmin},
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(RESTScope(r)))
+	}
+
+	tests := []struct {
+		scope    string
+		token    string
+		user     string
+		password string
+		expected int
+	}{
+		{"", "", "", "", http.StatusOK},
+		{config.APIScopeRead, "", "", "", http.StatusUnauthorized},
+		{config.APIScopeRead, "wrongtoken", "", "", http.StatusUnauthorized},
+		{config.APIScopeRead, "readtoken", "", "", http.StatusOK},
+		{config.APIScopeAdmin, "readtoken", "", "", http.StatusForbidden},
+		{config.APIScopeAdmin, "admintoken", "", "", http.StatusOK},
+		{config.APIScopeAdmin, "", "admin", "wrong", http.StatusUnauthorized},
+		{config.APIScopeAdmin, "", "admin", "password", http.StatusOK},
+	}
+
+	for i, test := range tests {
+		req := httptest.NewRequest("GET", "/", nil)
+		if test.token != "" {
+			req.Header.Set("Authorization", "Bearer "+test.token)
+		}
+		if test.user != "" {
+			req.SetBasicAuth(test.user, test.password)
+		}
+
+		w := httptest.NewRecorder()
+		RESTAuth(http.HandlerFunc(handler), test.scope).ServeHTTP(w, req)
+		if w.Code != test.expected {
+			t.Errorf("Test failed. RESTAuth test %d expected status %d got %d",
+				i, test.expected, w.Code)
+		}
+	}
+}
+
+func TestRESTGetAllSettingsRedacted(t *testing.T) {
+	bot.config = loadConfig(t)
+	bot.config.Webserver.AdminUsername = "admin"
+	bot.config.Webserver.AdminPassword = "password"
+	bot.config.Webserver.APITokens = []config.APIToken{
+		{Name: "reader", Token: "readtoken", Scope: config.APIScopeRead},
+	}
+
+	router := NewRouter(nil)
+
+	req := httptest.NewRequest("GET", "/config/all", nil)
+	req.Header.Set("Authorization", "Bearer readtoken")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var cfg config.Config
+	err := json.NewDecoder(w.Body).Decode(&cfg)
+	if err != nil {
+		t.Fatal("Test failed. RESTGetAllSettings response not parseable", err)
+	}
+	if cfg.Webserver.AdminPassword != config.RedactedValue {
+		t.Error("Test failed. RESTGetAllSettings secrets not redacted for read token")
+	}
+
+	req = httptest.NewRequest("GET", "/config/all", nil)
+	req.SetBasicAuth("admin", "password")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	err = json.NewDecoder(w.Body).Decode(&cfg)
+	if err != nil {
+		t.Fatal("Test failed. RESTGetAllSettings response not parseable", err)
+	}
+	if cfg.Webserver.AdminPassword != "password" {
+		t.Error("Test failed. RESTGetAllSettings secrets redacted for admin")
+	}
+
+	req = httptest.NewRequest("POST", "/config/all/save", nil)
+	req.Header.Set("Authorization", "Bearer readtoken")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden {
+		t.Error("Test failed. SaveAllSettings allowed for read token", w.Code)
+	}
+}
@@ -10,8 +10,11 @@ import (
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/metrics"
 )
 
+var metricsHandler = metrics.Handler()
+
 // AllEnabledExchangeOrderbooks holds the enabled exchange orderbooks
 type AllEnabledExchangeOrderbooks struct {
 	Data []EnabledExchangeOrderbooks `json:"data"`
@@ -41,6 +44,21 @@ type AllEnabledExchangeAccounts struct {
 	Data []exchange.AccountInfo `json:"data"`
 }
 
+// AllExchangeCapabilities holds the capabilities of each loaded exchange
+type AllExchangeCapabilities struct {
+	Data []exchange.Capabilities `json:"data"`
+}
+
+// EffectiveConfig holds the config the bot is running with after the config
+// includes and profile are applied
+type EffectiveConfig struct {
+	ConfigFile    string        `json:"configFile"`
+	IncludedFiles []string      `json:"includedFiles"`
+	Profile       string        `json:"profile"`
+	DryRun        bool          `json:"dryRun"`
+	Config        config.Config `json:"config"`
+}
+
 // RESTfulJSONResponse outputs a JSON response of the response interface
 func RESTfulJSONResponse(w http.ResponseWriter, r *http.Request, response interface{}) error {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
@@ -55,16 +73,56 @@ func RESTfulError(method string, err error) {
 }
 
 // RESTGetAllSettings replies to a request with an encoded JSON response about the
-// trading bots configuration.
+// trading bots configuration. Secrets are redacted for non admin requests
 func RESTGetAllSettings(w http.ResponseWriter, r *http.Request) {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+
+	if RESTScope(r) != config.APIScopeAdmin {
+		err := RESTfulJSONResponse(w, r, bot.config.Redacted())
+		if err != nil {
+			RESTfulError(r.Method, err)
+		}
+		return
+	}
+
 	err := RESTfulJSONResponse(w, r, bot.config)
 	if err != nil {
 		RESTfulError(r.Method, err)
 	}
 }
 
+// GetEffectiveConfig returns the config the bot is running with, secrets are
+// redacted unless requested
+func GetEffectiveConfig(withSecrets bool) EffectiveConfig {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+
+	response := EffectiveConfig{
+		ConfigFile:    bot.configFile,
+		IncludedFiles: bot.config.IncludedFiles(),
+		Profile:       bot.config.Profile,
+		DryRun:        bot.dryRun,
+		Config:        bot.config.Redacted(),
+	}
+	if withSecrets {
+		response.Config = *bot.config
+	}
+	return response
+}
+
+// RESTGetEffectiveConfig replies with the config the bot is running with after
+// the config includes and profile are applied. Secrets are redacted for non
+// admin requests
+func RESTGetEffectiveConfig(w http.ResponseWriter, r *http.Request) {
+	err := RESTfulJSONResponse(w, r, GetEffectiveConfig(RESTScope(r) == config.APIScopeAdmin))
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
 // RESTSaveAllSettings saves all current settings from request body as a JSON
-// document then reloads state and returns the settings
+// document then applies the changes and returns the settings
 func RESTSaveAllSettings(w http.ResponseWriter, r *http.Request) {
 	//Get the data from the request
 	decoder := json.NewDecoder(r.Body)
@@ -73,18 +131,18 @@ func RESTSaveAllSettings(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		RESTfulError(r.Method, err)
 	}
-	//Save change the settings
-	err = bot.config.UpdateConfig(bot.configFile, responseData.Data)
+	//Save change the settings and apply them to the running bot
+	err = SaveAndApplyConfig(responseData.Data)
 	if err != nil {
 		RESTfulError(r.Method, err)
 	}
 
+	bot.configMtx.RLock()
 	err = RESTfulJSONResponse(w, r, bot.config)
+	bot.configMtx.RUnlock()
 	if err != nil {
 		RESTfulError(r.Method, err)
 	}
-
-	SetupExchanges()
 }
 
 // RESTGetOrderbook returns orderbook info for a given currency, exchange and
@@ -174,6 +232,7 @@ func RESTGetAllActiveOrderbooks(w http.ResponseWriter, r *http.Request) {
 // RESTGetPortfolio returns the bot portfolio
 func RESTGetPortfolio(w http.ResponseWriter, r *http.Request) {
 	result := bot.portfolio.GetPortfolioSummary()
+	result.SetValues(getFiatDisplayCurrency())
 	err := RESTfulJSONResponse(w, r, result)
 	if err != nil {
 		RESTfulError(r.Method, err)
@@ -291,3 +350,38 @@ func RESTGetAllEnabledAccountInfo(w http.ResponseWriter, r *http.Request) {
 		RESTfulError(r.Method, err)
 	}
 }
+
+// GetAllExchangeCapabilities returns the capabilities of each loaded exchange
+func GetAllExchangeCapabilities() AllExchangeCapabilities {
+	var response AllExchangeCapabilities
+	for _, exch := range bot.exchanges {
+		if exch != nil {
+			response.Data = append(response.Data, exch.GetCapabilities())
+		}
+	}
+	return response
+}
+
+// RESTGetAllExchangeCapabilities returns the capabilities of each loaded
+// exchange
+func RESTGetAllExchangeCapabilities(w http.ResponseWriter, r *http.Request) {
+	err := RESTfulJSONResponse(w, r, GetAllExchangeCapabilities())
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetExchangeCapabilities returns the capabilities of a loaded exchange
+func RESTGetExchangeCapabilities(w http.ResponseWriter, r *http.Request) {
+	exch, err := getLoadedExchange(mux.Vars(r)["exchangeName"])
+	if err != nil {
+		restRespond(w, r, nil, err)
+		return
+	}
+	restRespond(w, r, exch.GetCapabilities(), nil)
+}
+
+// RESTGetMetrics serves the bot metrics in the Prometheus text format
+func RESTGetMetrics(w http.ResponseWriter, r *http.Request) {
+	metricsHandler.ServeHTTP(w, r)
+}
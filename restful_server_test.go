@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 )
 
 func loadConfig(t *testing.T) *config.Config {
@@ -50,3 +51,77 @@ func TestConfigAllJsonResponse(t *testing.T) {
 		t.Error("Test failed. Json not equal to config")
 	}
 }
+
+func TestRESTGetMetrics(t *testing.T) {
+	bot.config = loadConfig(t)
+	bot.config.Webserver.APITokens = []config.APIToken{
+		{Name: "prometheus", Token: "readtoken", Scope: config.APIScopeRead},
+	}
+	router := NewRouter(nil)
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Error("Test failed. RESTGetMetrics allowed without credentials", w.Code)
+	}
+
+	req = httptest.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer readtoken")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "go_goroutines") {
+		t.Error("Test failed. RESTGetMetrics unexpected response", w.Code)
+	}
+}
+
+func TestRESTGetExchangeCapabilities(t *testing.T) {
+	router := setupRESTOrdersTest(t)
+
+	w := restOrdersRequest(router, "GET", "/exchanges/bitfinex/capabilities",
+		"readtoken", "")
+	var capabilities exchange.Capabilities
+	err := json.NewDecoder(w.Body).Decode(&capabilities)
+	if w.Code != http.StatusOK || err != nil || capabilities.Exchange != "Bitfinex" ||
+		!capabilities.SupportsOrderType(exchange.Market) {
+		t.Error("Test failed. RESTGetExchangeCapabilities unexpected response",
+			w.Code, err, capabilities)
+	}
+
+	w = restOrdersRequest(router, "GET", "/exchanges/asdsad/capabilities",
+		"readtoken", "")
+	if w.Code != http.StatusNotFound {
+		t.Error("Test failed. RESTGetExchangeCapabilities unexpected status", w.Code)
+	}
+
+	w = restOrdersRequest(router, "GET", "/exchanges/capabilities/all",
+		"readtoken", "")
+	var all AllExchangeCapabilities
+	err = json.NewDecoder(w.Body).Decode(&all)
+	if w.Code != http.StatusOK || err != nil || len(all.Data) != len(bot.exchanges) {
+		t.Error("Test failed. RESTGetAllExchangeCapabilities unexpected response",
+			w.Code, err)
+	}
+}
+
+func TestRESTGetEffectiveConfig(t *testing.T) {
+	router := setupRESTOrdersTest(t)
+	bot.config.Exchanges[0].APIKey = "effectivekey"
+
+	w := restOrdersRequest(router, "GET", "/config/effective", "readtoken", "")
+	var effective EffectiveConfig
+	err := json.NewDecoder(w.Body).Decode(&effective)
+	if w.Code != http.StatusOK || err != nil ||
+		len(effective.Config.Exchanges) != len(bot.config.Exchanges) {
+		t.Fatal("Test failed. RESTGetEffectiveConfig unexpected response", w.Code, err)
+	}
+	if effective.Config.Exchanges[0].APIKey == "effectivekey" {
+		t.Error("Test failed. RESTGetEffectiveConfig didn't redact secrets for a read token")
+	}
+
+	w = restOrdersRequest(router, "GET", "/config/effective", "admintoken", "")
+	err = json.NewDecoder(w.Body).Decode(&effective)
+	if err != nil || effective.Config.Exchanges[0].APIKey != "effectivekey" {
+		t.Error("Test failed. RESTGetEffectiveConfig redacted secrets for an admin token", err)
+	}
+}
@@ -4,21 +4,25 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/events"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/metrics"
 )
 
 func printCurrencyFormat(price float64) string {
-	displaySymbol, err := symbol.GetSymbolByCurrencyName(bot.config.Currency.FiatDisplayCurrency)
+	displaySymbol, err := symbol.GetSymbolByCurrencyName(getFiatDisplayCurrency())
 	if err != nil {
 		log.Printf("Failed to get display symbol: %s", err)
 	}
@@ -27,8 +31,8 @@ func printCurrencyFormat(price float64) string {
 }
 
 func printConvertCurrencyFormat(origCurrency string, origPrice float64) string {
-	displayCurrency := bot.config.Currency.FiatDisplayCurrency
-	conv, err := currency.ConvertCurrency(origPrice, origCurrency, displayCurrency)
+	displayCurrency := getFiatDisplayCurrency()
+	conv, err := currency.ConvertCrossRate(origPrice, origCurrency, displayCurrency)
 	if err != nil {
 		log.Printf("Failed to convert currency: %s", err)
 	}
@@ -63,7 +67,7 @@ func printTickerSummary(result ticker.Price, p pair.CurrencyPair, assetType, exc
 	}
 
 	stats.Add(exchangeName, p, assetType, result.Last, result.Volume)
-	if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.String() != bot.config.Currency.FiatDisplayCurrency {
+	if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.String() != getFiatDisplayCurrency() {
 		origCurrency := p.SecondCurrency.Upper().String()
 		log.Printf("%s %s %s: TICKER: Last %s Ask %s Bid %s High %s Low %s Volume %.8f",
 			exchangeName,
@@ -76,7 +80,7 @@ func printTickerSummary(result ticker.Price, p pair.CurrencyPair, assetType, exc
 			printConvertCurrencyFormat(origCurrency, result.Low),
 			result.Volume)
 	} else {
-		if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.Upper().String() == bot.config.Currency.FiatDisplayCurrency {
+		if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.Upper().String() == getFiatDisplayCurrency() {
 			log.Printf("%s %s %s: TICKER: Last %s Ask %s Bid %s High %s Low %s Volume %.8f",
 				exchangeName,
 				exchange.FormatCurrency(p).String(),
@@ -114,7 +118,7 @@ func printOrderbookSummary(result orderbook.Base, p pair.CurrencyPair, assetType
 	bidsAmount, bidsValue := result.CalculateTotalBids()
 	asksAmount, asksValue := result.CalculateTotalAsks()
 
-	if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.String() != bot.config.Currency.FiatDisplayCurrency {
+	if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.String() != getFiatDisplayCurrency() {
 		origCurrency := p.SecondCurrency.Upper().String()
 		log.Printf("%s %s %s: ORDERBOOK: Bids len: %d Amount: %f %s. Total value: %s Asks len: %d Amount: %f %s. Total value: %s",
 			exchangeName,
@@ -130,7 +134,7 @@ func printOrderbookSummary(result orderbook.Base, p pair.CurrencyPair, assetType
 			printConvertCurrencyFormat(origCurrency, asksValue),
 		)
 	} else {
-		if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.Upper().String() == bot.config.Currency.FiatDisplayCurrency {
+		if currency.IsFiatCurrency(p.SecondCurrency.String()) && p.SecondCurrency.Upper().String() == getFiatDisplayCurrency() {
 			log.Printf("%s %s %s: ORDERBOOK: Bids len: %d Amount: %f %s. Total value: %s Asks len: %d Amount: %f %s. Total value: %s",
 				exchangeName,
 				exchange.FormatCurrency(p).String(),
@@ -162,12 +166,13 @@ func printOrderbookSummary(result orderbook.Base, p pair.CurrencyPair, assetType
 	}
 }
 
-func relayWebsocketEvent(result interface{}, event, assetType, exchangeName string) {
+func relayWebsocketEvent(result interface{}, event, assetType, exchangeName, currency string) {
 	evt := WebsocketEvent{
 		Data:      result,
 		Event:     event,
 		AssetType: assetType,
 		Exchange:  exchangeName,
+		Currency:  currency,
 	}
 	err := BroadcastWebsocketMessage(evt)
 	if err != nil {
@@ -176,12 +181,51 @@ func relayWebsocketEvent(result interface{}, event, assetType, exchangeName stri
 	}
 }
 
+// Order statuses relayed in websocket order updates
+const (
+	OrderStatusPlaced    = "placed"
+	OrderStatusModified  = "modified"
+	OrderStatusCancelled = "cancelled"
+	OrderStatusFilled    = "filled"
+)
+
+// WebsocketOrderUpdate is relayed to websocket clients when an order is placed,
+// modified, cancelled or filled
+type WebsocketOrderUpdate struct {
+	Status string            `json:"status"`
+	Order  base.ControlOrder `json:"order"`
+}
+
+// relayOrderUpdate relays an order status change to websocket clients
+// subscribed to order updates
+func relayOrderUpdate(status string, order base.ControlOrder) {
+	if !getWebserverConfig().Enabled {
+		return
+	}
+	relayWebsocketEvent(WebsocketOrderUpdate{Status: status, Order: order},
+		WebsocketEventOrder, "", order.Exchange, order.CurrencyPair)
+}
+
+// relayEventTrigger relays a triggered event to websocket clients subscribed
+// to event triggers
+func relayEventTrigger(e *events.Event) {
+	if !getWebserverConfig().Enabled {
+		return
+	}
+	relayWebsocketEvent(e, WebsocketEventTrigger, e.Asset, e.Exchange,
+		e.Pair.Pair().String())
+}
+
 // TickerUpdaterRoutine fetches and updates the ticker for all enabled
 // currency pairs and exchanges
 func TickerUpdaterRoutine() {
 	log.Println("Starting ticker updater routine.")
 	var wg sync.WaitGroup
 	for {
+		if UpdatersPaused() {
+			time.Sleep(time.Second * 10)
+			continue
+		}
 		wg.Add(len(bot.exchanges))
 		for x := range bot.exchanges {
 			go func(x int, wg *sync.WaitGroup) {
@@ -209,9 +253,12 @@ func TickerUpdaterRoutine() {
 					}
 					printTickerSummary(result, c, assetType, exchangeName, err)
 					if err == nil {
+						metrics.TickerUpdated(exchangeName, c.Pair().String(), assetType)
+						botHealth.tickerUpdated(exchangeName)
 						bot.comms.StageTickerData(exchangeName, assetType, result)
-						if bot.config.Webserver.Enabled {
-							relayWebsocketEvent(result, "ticker_update", assetType, exchangeName)
+						if getWebserverConfig().Enabled {
+							relayWebsocketEvent(result, WebsocketEventTicker, assetType,
+								exchangeName, c.Pair().String())
 						}
 					}
 				}
@@ -239,6 +286,10 @@ func OrderbookUpdaterRoutine() {
 	log.Println("Starting orderbook updater routine.")
 	var wg sync.WaitGroup
 	for {
+		if UpdatersPaused() {
+			time.Sleep(time.Second * 10)
+			continue
+		}
 		wg.Add(len(bot.exchanges))
 		for x := range bot.exchanges {
 			go func(x int, wg *sync.WaitGroup) {
@@ -260,9 +311,11 @@ func OrderbookUpdaterRoutine() {
 					result, err := exch.UpdateOrderbook(c, assetType)
 					printOrderbookSummary(result, c, assetType, exchangeName, err)
 					if err == nil {
+						metrics.OrderbookUpdated(exchangeName, c.Pair().String(), assetType)
 						bot.comms.StageOrderbookData(exchangeName, assetType, result)
-						if bot.config.Webserver.Enabled {
-							relayWebsocketEvent(result, "orderbook_update", assetType, exchangeName)
+						if getWebserverConfig().Enabled {
+							relayWebsocketEvent(result, WebsocketEventOrderbook, assetType,
+								exchangeName, c.Pair().String())
 						}
 					}
 				}
@@ -362,6 +415,14 @@ func streamDiversion(ws *exchange.Websocket, verbose bool) {
 				log.Printf("exchange %s websocket feed disconnected, switching to REST functionality",
 					ws.GetName())
 			}
+			if bot.comms != nil {
+				bot.comms.PushEvent(base.Event{
+					Type:         "WEBSOCKET_DISCONNECT",
+					TradeDetails: fmt.Sprintf("%s websocket feed disconnected", ws.GetName()),
+					Severity:     base.SeverityWarning,
+					Category:     base.CategoryWebsocketDisconnect,
+				})
+			}
 		}
 	}
 }
@@ -380,6 +441,7 @@ func WebsocketDataHandler(ws *exchange.Websocket, verbose bool) {
 			return
 
 		case data := <-ws.DataHandler:
+			metrics.AddWebsocketMessage(ws.GetName(), websocketMessageType(data))
 			switch data.(type) {
 			case string:
 				switch data.(string) {
@@ -399,6 +461,14 @@ func WebsocketDataHandler(ws *exchange.Websocket, verbose bool) {
 					go WebsocketReconnect(ws, verbose)
 					continue
 				default:
+					if bot.comms != nil {
+						bot.comms.PushEvent(base.Event{
+							Type:         "WEBSOCKET_ERROR",
+							TradeDetails: fmt.Sprintf("%s websocket error - %s", ws.GetName(), data),
+							Severity:     base.SeverityCritical,
+							Category:     base.CategoryError,
+						})
+					}
 					log.Fatalf("routines.go exchange %s websocket error - %s", ws.GetName(), data)
 				}
 
@@ -432,8 +502,29 @@ func WebsocketDataHandler(ws *exchange.Websocket, verbose bool) {
 	}
 }
 
+// websocketMessageType returns the message type used to label exchange
+// websocket message metrics
+func websocketMessageType(data interface{}) string {
+	switch data.(type) {
+	case string:
+		return "status"
+	case error:
+		return "error"
+	case exchange.TradeData:
+		return "trade"
+	case exchange.TickerData:
+		return "ticker"
+	case exchange.KlineData:
+		return "kline"
+	case exchange.WebsocketOrderbookUpdate:
+		return "orderbook"
+	}
+	return "unknown"
+}
+
 // WebsocketReconnect tries to reconnect to a websocket stream
 func WebsocketReconnect(ws *exchange.Websocket, verbose bool) {
+	metrics.AddWebsocketReconnect(ws.GetName())
 	if verbose {
 		log.Printf("Websocket reconnection requested for %s", ws.GetName())
 	}
@@ -460,3 +551,75 @@ func WebsocketReconnect(ws *exchange.Websocket, verbose bool) {
 		}
 	}
 }
+
+// TradeUpdaterRoutine fetches the recent trades for all enabled currency pairs
+// and exchanges while a websocket client is subscribed to trade updates and
+// relays any new trades
+func TradeUpdaterRoutine() {
+	log.Println("Starting trade updater routine.")
+	lastTradeIDs := make(map[string]int64)
+	for {
+		time.Sleep(time.Second * 10)
+		if UpdatersPaused() || !IsWebsocketEventSubscribed(WebsocketEventTrade) {
+			continue
+		}
+
+		for x := range bot.exchanges {
+			if bot.exchanges[x] == nil {
+				continue
+			}
+
+			exchangeName := bot.exchanges[x].GetName()
+			assetTypes, err := exchange.GetExchangeAssetTypes(exchangeName)
+			if err != nil {
+				log.Printf("failed to get %s exchange asset types. Error: %s",
+					exchangeName, err)
+				continue
+			}
+
+			enabledCurrencies := bot.exchanges[x].GetEnabledCurrencies()
+			for y := range assetTypes {
+				for z := range enabledCurrencies {
+					c := enabledCurrencies[z]
+					trades, err := bot.exchanges[x].GetExchangeHistory(c, assetTypes[y])
+					if err == common.ErrNotYetImplemented {
+						break
+					}
+					if err != nil {
+						log.Printf("failed to get %s %s %s trades. Error: %s",
+							exchangeName, c.Pair().String(), assetTypes[y], err)
+						continue
+					}
+
+					key := exchangeName + assetTypes[y] + c.Pair().String()
+					lastID, seen := lastTradeIDs[key]
+					newTrades := newTradesSince(trades, lastID)
+					if len(newTrades) == 0 {
+						continue
+					}
+
+					lastTradeIDs[key] = newTrades[len(newTrades)-1].TID
+					if seen {
+						relayWebsocketEvent(newTrades, WebsocketEventTrade,
+							assetTypes[y], exchangeName, c.Pair().String())
+					}
+				}
+			}
+		}
+	}
+}
+
+// newTradesSince returns the trades with an ID greater than lastID ordered
+// by ID
+func newTradesSince(trades []exchange.TradeHistory, lastID int64) []exchange.TradeHistory {
+	var newTrades []exchange.TradeHistory
+	for i := range trades {
+		if trades[i].TID > lastID {
+			newTrades = append(newTrades, trades[i])
+		}
+	}
+	sort.Slice(newTrades, func(i, j int) bool {
+		return newTrades[i].TID < newTrades[j].TID
+	})
+	return newTrades
+}
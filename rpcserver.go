@@ -0,0 +1,551 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/events"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// rpcStreamInterval is how often the ticker and orderbook subscriptions check
+// for updated data
+var rpcStreamInterval = time.Second
+
+// vars related to the gRPC server
+var (
+	errRPCUnauthenticated = status.Error(codes.Unauthenticated, "invalid or missing credentials")
+	errRPCPortfolioEmpty  = status.Error(codes.InvalidArgument, "address and coin type must be set")
+)
+
+// RPCServer implements the GoCryptoTrader gRPC service
+type RPCServer struct {
+	gctrpc.UnimplementedGoCryptoTraderServer
+}
+
+// NewRPCServer returns a gRPC server with the GoCryptoTrader service and
+// admin credential authentication registered
+func NewRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.UnaryInterceptor(rpcUnaryAuth),
+		grpc.StreamInterceptor(rpcStreamAuth),
+	)
+	server := grpc.NewServer(opts...)
+	gctrpc.RegisterGoCryptoTraderServer(server, &RPCServer{})
+	return server
+}
+
+// StartRPCServer starts the gRPC server on the configured listen address,
+// using the webserver TLS settings if TLS is enabled
+func StartRPCServer() error {
+	listenAddr := bot.config.Webserver.GRPC.ListenAddress
+	var opts []grpc.ServerOption
+	if bot.config.Webserver.TLS.Enabled {
+		tlsConfig, err := GetWebserverTLSConfig(bot.config.Webserver.TLS,
+			bot.dataDir, listenAddr)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("gRPC server support enabled. Listen address: %s. TLS: %s.\n",
+		listenAddr, common.IsEnabled(bot.config.Webserver.TLS.Enabled))
+	go func() {
+		err := NewRPCServer(opts...).Serve(lis)
+		if err != nil {
+			log.Printf("gRPC server stopped. Err: %s", err)
+		}
+	}()
+	return nil
+}
+
+// rpcAuthenticate checks the HTTP basic auth admin credentials sent in the
+// authorization metadata
+func rpcAuthenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return errRPCUnauthenticated
+	}
+
+	auth := md.Get("authorization")
+	if len(auth) != 1 || !strings.HasPrefix(auth[0], "Basic ") {
+		return errRPCUnauthenticated
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(auth[0], "Basic "))
+	if err != nil {
+		return errRPCUnauthenticated
+	}
+
+	creds := strings.SplitN(string(decoded), ":", 2)
+	if len(creds) != 2 {
+		return errRPCUnauthenticated
+	}
+
+	webserver := getWebserverConfig()
+	userMatch := subtle.ConstantTimeCompare([]byte(creds[0]),
+		[]byte(webserver.AdminUsername))
+	passMatch := subtle.ConstantTimeCompare([]byte(creds[1]),
+		[]byte(webserver.AdminPassword))
+	if userMatch&passMatch != 1 {
+		return errRPCUnauthenticated
+	}
+	return nil
+}
+
+func rpcUnaryAuth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	err := rpcAuthenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func rpcStreamAuth(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := rpcAuthenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// rpcError converts known bot errors to their gRPC status equivalents
+func rpcError(err error) error {
+	switch err {
+	case ErrExchangeNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrInvalidCurrencyPair, ErrInvalidOrderSide, ErrInvalidOrderType,
+		ErrInvalidOrderAmount, ErrInvalidOrderID, ErrInvalidWithdrawal,
+		ErrCurrencyNotSet, ErrOrderTypeNotSupported,
+		exchange.ErrOrderAmountBelowMinimum, exchange.ErrOrderAmountAboveMaximum,
+		exchange.ErrOrderNotionalBelowMinimum:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ErrDryRunEnabled:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrExchangeAlreadyActive, ErrExchangeAlreadyLoaded:
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// rpcAssetType returns the asset type or spot if it is empty
+func rpcAssetType(assetType string) string {
+	if assetType == "" {
+		return ticker.Spot
+	}
+	return common.StringToUpper(assetType)
+}
+
+func tickerToRPC(exchangeName, assetType string, t ticker.Price) *gctrpc.TickerResponse {
+	return &gctrpc.TickerResponse{
+		Exchange:    exchangeName,
+		Pair:        t.Pair.Pair().String(),
+		AssetType:   assetType,
+		LastUpdated: t.LastUpdated.Unix(),
+		Last:        t.Last,
+		High:        t.High,
+		Low:         t.Low,
+		Bid:         t.Bid,
+		Ask:         t.Ask,
+		Volume:      t.Volume,
+		PriceAth:    t.PriceATH,
+	}
+}
+
+func orderbookToRPC(exchangeName string, ob orderbook.Base) *gctrpc.OrderbookResponse {
+	resp := &gctrpc.OrderbookResponse{
+		Exchange:    exchangeName,
+		Pair:        ob.Pair.Pair().String(),
+		AssetType:   ob.AssetType,
+		LastUpdated: ob.LastUpdated.Unix(),
+	}
+	for i := range ob.Bids {
+		resp.Bids = append(resp.Bids, &gctrpc.OrderbookItem{
+			Amount: ob.Bids[i].Amount,
+			Price:  ob.Bids[i].Price,
+			Id:     ob.Bids[i].ID,
+		})
+	}
+	for i := range ob.Asks {
+		resp.Asks = append(resp.Asks, &gctrpc.OrderbookItem{
+			Amount: ob.Asks[i].Amount,
+			Price:  ob.Asks[i].Price,
+			Id:     ob.Asks[i].ID,
+		})
+	}
+	return resp
+}
+
+// GetInfo returns the bot version, uptime and status
+func (s *RPCServer) GetInfo(ctx context.Context, r *gctrpc.GetInfoRequest) (*gctrpc.GetInfoResponse, error) {
+	bot.configMtx.RLock()
+	resp := &gctrpc.GetInfoResponse{
+		Version:            strings.TrimSpace(BuildVersion(true)),
+		Uptime:             time.Since(bot.startTime).Round(time.Second).String(),
+		AvailableExchanges: int64(len(bot.config.Exchanges)),
+		EnabledExchanges:   int64(bot.config.CountEnabledExchanges()),
+		DryRun:             bot.dryRun,
+		UpdatersPaused:     UpdatersPaused(),
+	}
+	bot.configMtx.RUnlock()
+
+	if bot.comms != nil {
+		for i := range bot.comms.IComm {
+			if bot.comms.IComm[i].IsEnabled() {
+				resp.CommunicationMediums = append(resp.CommunicationMediums,
+					bot.comms.IComm[i].GetName())
+			}
+		}
+	}
+	return resp, nil
+}
+
+// GetExchanges returns the loaded exchanges or every exchange in the config
+func (s *RPCServer) GetExchanges(ctx context.Context, r *gctrpc.GetExchangesRequest) (*gctrpc.GetExchangesResponse, error) {
+	var resp gctrpc.GetExchangesResponse
+	if r.Enabled {
+		for i := range bot.exchanges {
+			if bot.exchanges[i] != nil {
+				resp.Exchanges = append(resp.Exchanges, bot.exchanges[i].GetName())
+			}
+		}
+		return &resp, nil
+	}
+
+	bot.configMtx.RLock()
+	for i := range bot.config.Exchanges {
+		resp.Exchanges = append(resp.Exchanges, bot.config.Exchanges[i].Name)
+	}
+	bot.configMtx.RUnlock()
+	return &resp, nil
+}
+
+// EnableExchange loads an exchange and enables it in the config
+func (s *RPCServer) EnableExchange(ctx context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GenericResponse, error) {
+	err := bot.control.SetExchangeEnabled(r.Exchange, true)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &gctrpc.GenericResponse{Status: "enabled"}, nil
+}
+
+// DisableExchange unloads an exchange and disables it in the config
+func (s *RPCServer) DisableExchange(ctx context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GenericResponse, error) {
+	err := bot.control.SetExchangeEnabled(r.Exchange, false)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &gctrpc.GenericResponse{Status: "disabled"}, nil
+}
+
+// GetTicker returns the ticker for an exchange currency pair
+func (s *RPCServer) GetTicker(ctx context.Context, r *gctrpc.GetTickerRequest) (*gctrpc.TickerResponse, error) {
+	exch, err := getLoadedExchange(r.Exchange)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	p, err := parseCurrencyPair(r.Pair)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	assetType := rpcAssetType(r.AssetType)
+	t, err := exch.GetTickerPrice(p, assetType)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return tickerToRPC(exch.GetName(), assetType, t), nil
+}
+
+// GetTickers returns the tickers for all enabled exchanges
+func (s *RPCServer) GetTickers(ctx context.Context, r *gctrpc.GetTickersRequest) (*gctrpc.GetTickersResponse, error) {
+	var resp gctrpc.GetTickersResponse
+	for _, exch := range GetAllActiveTickers() {
+		for i := range exch.ExchangeValues {
+			resp.Tickers = append(resp.Tickers,
+				tickerToRPC(exch.ExchangeName, "", exch.ExchangeValues[i]))
+		}
+	}
+	return &resp, nil
+}
+
+// GetOrderbook returns the orderbook for an exchange currency pair
+func (s *RPCServer) GetOrderbook(ctx context.Context, r *gctrpc.GetOrderbookRequest) (*gctrpc.OrderbookResponse, error) {
+	exch, err := getLoadedExchange(r.Exchange)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	p, err := parseCurrencyPair(r.Pair)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	ob, err := exch.GetOrderbookEx(p, rpcAssetType(r.AssetType))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return orderbookToRPC(exch.GetName(), ob), nil
+}
+
+// GetOrderbooks returns the orderbooks for all enabled exchanges
+func (s *RPCServer) GetOrderbooks(ctx context.Context, r *gctrpc.GetOrderbooksRequest) (*gctrpc.GetOrderbooksResponse, error) {
+	var resp gctrpc.GetOrderbooksResponse
+	for _, exch := range GetAllActiveOrderbooks() {
+		for i := range exch.ExchangeValues {
+			resp.Orderbooks = append(resp.Orderbooks,
+				orderbookToRPC(exch.ExchangeName, exch.ExchangeValues[i]))
+		}
+	}
+	return &resp, nil
+}
+
+// GetAccountInfo returns the account balances for an exchange
+func (s *RPCServer) GetAccountInfo(ctx context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GetAccountInfoResponse, error) {
+	exch, err := getLoadedExchange(r.Exchange)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	info, err := exch.GetAccountInfo()
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	resp := &gctrpc.GetAccountInfoResponse{Exchange: exch.GetName()}
+	for i := range info.Currencies {
+		resp.Currencies = append(resp.Currencies, &gctrpc.AccountCurrencyInfo{
+			Currency:   info.Currencies[i].CurrencyName,
+			TotalValue: info.Currencies[i].TotalValue.Float64(),
+			Hold:       info.Currencies[i].Hold.Float64(),
+		})
+	}
+	return resp, nil
+}
+
+// GetOrders returns the open orders placed through the bot
+func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
+	orders, err := bot.control.GetOpenOrders(r.Exchange)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	var resp gctrpc.GetOrdersResponse
+	for i := range orders {
+		resp.Orders = append(resp.Orders, &gctrpc.OrderDetails{
+			Exchange:  orders[i].Exchange,
+			Id:        orders[i].OrderID,
+			Pair:      orders[i].CurrencyPair,
+			Side:      orders[i].Side,
+			OrderType: orders[i].OrderType,
+			Amount:    orders[i].Amount,
+			Price:     orders[i].Price,
+		})
+	}
+	return &resp, nil
+}
+
+// SubmitOrder places an order on an exchange
+func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderRequest) (*gctrpc.SubmitOrderResponse, error) {
+	orderID, err := bot.control.SubmitOrder(r.Exchange, r.Pair, r.Side,
+		r.OrderType, r.Amount, r.Price, r.ClientId)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &gctrpc.SubmitOrderResponse{OrderPlaced: true, OrderId: orderID}, nil
+}
+
+// CancelOrder cancels an order by ID
+func (s *RPCServer) CancelOrder(ctx context.Context, r *gctrpc.CancelOrderRequest) (*gctrpc.GenericResponse, error) {
+	err := bot.control.CancelOrder(r.Exchange, r.OrderId)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &gctrpc.GenericResponse{Status: "cancelled"}, nil
+}
+
+// CancelAllOrders cancels all orders on an exchange
+func (s *RPCServer) CancelAllOrders(ctx context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GenericResponse, error) {
+	err := bot.control.CancelAllOrders(r.Exchange)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &gctrpc.GenericResponse{Status: "cancelled"}, nil
+}
+
+// GetEvents returns the events on the event chain
+func (s *RPCServer) GetEvents(ctx context.Context, r *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
+	var resp gctrpc.GetEventsResponse
+	for _, e := range events.Events {
+		resp.Events = append(resp.Events, &gctrpc.Event{
+			Id:        int64(e.ID),
+			Exchange:  e.Exchange,
+			Item:      e.Item,
+			Condition: e.Condition,
+			Pair:      e.Pair.Pair().String(),
+			AssetType: e.Asset,
+			Action:    e.Action,
+			Executed:  e.Executed,
+		})
+	}
+	return &resp, nil
+}
+
+// AddEvent adds an event to the event chain
+func (s *RPCServer) AddEvent(ctx context.Context, r *gctrpc.AddEventRequest) (*gctrpc.AddEventResponse, error) {
+	p, err := parseCurrencyPair(r.Pair)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	id, err := events.AddEvent(r.Exchange, r.Item, r.Condition, p,
+		rpcAssetType(r.AssetType), r.Action)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &gctrpc.AddEventResponse{Id: int64(id)}, nil
+}
+
+// RemoveEvent removes an event from the event chain
+func (s *RPCServer) RemoveEvent(ctx context.Context, r *gctrpc.RemoveEventRequest) (*gctrpc.GenericResponse, error) {
+	if !events.RemoveEvent(int(r.Id)) {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	return &gctrpc.GenericResponse{Status: "removed"}, nil
+}
+
+// GetPortfolio returns the portfolio addresses
+func (s *RPCServer) GetPortfolio(ctx context.Context, r *gctrpc.GetPortfolioRequest) (*gctrpc.GetPortfolioResponse, error) {
+	var resp gctrpc.GetPortfolioResponse
+	for _, a := range bot.portfolio.Addresses {
+		resp.Addresses = append(resp.Addresses, &gctrpc.PortfolioAddress{
+			Address:     a.Address,
+			CoinType:    a.CoinType,
+			Description: a.Description,
+			Balance:     a.Balance,
+		})
+	}
+	return &resp, nil
+}
+
+// AddPortfolioAddress adds an address to the portfolio
+func (s *RPCServer) AddPortfolioAddress(ctx context.Context, r *gctrpc.AddPortfolioAddressRequest) (*gctrpc.GenericResponse, error) {
+	if r.Address == "" || r.CoinType == "" {
+		return nil, errRPCPortfolioEmpty
+	}
+	bot.portfolio.AddAddress(r.Address, common.StringToUpper(r.CoinType),
+		r.Description, r.Balance)
+	return &gctrpc.GenericResponse{Status: "added"}, nil
+}
+
+// RemovePortfolioAddress removes an address from the portfolio
+func (s *RPCServer) RemovePortfolioAddress(ctx context.Context, r *gctrpc.RemovePortfolioAddressRequest) (*gctrpc.GenericResponse, error) {
+	if r.Address == "" || r.CoinType == "" {
+		return nil, errRPCPortfolioEmpty
+	}
+
+	coinType := common.StringToUpper(r.CoinType)
+	for _, a := range bot.portfolio.Addresses {
+		if a.Address == r.Address && a.CoinType == coinType &&
+			a.Description == r.Description {
+			bot.portfolio.RemoveAddress(r.Address, coinType, r.Description)
+			return &gctrpc.GenericResponse{Status: "removed"}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "portfolio address not found")
+}
+
+// SubscribeTicker streams ticker updates for an exchange currency pair as they
+// are stored by the ticker updater routines
+func (s *RPCServer) SubscribeTicker(r *gctrpc.SubscribeTickerRequest, stream gctrpc.GoCryptoTrader_SubscribeTickerServer) error {
+	exch, err := getLoadedExchange(r.Exchange)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	p, err := parseCurrencyPair(r.Pair)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	exchangeName := exch.GetName()
+	assetType := rpcAssetType(r.AssetType)
+	return rpcPoll(stream.Context(), func(last time.Time) (time.Time, error) {
+		t, err := ticker.GetTicker(exchangeName, p, assetType)
+		if err != nil || !t.LastUpdated.After(last) {
+			return last, nil
+		}
+		return t.LastUpdated, stream.Send(tickerToRPC(exchangeName, assetType, t))
+	})
+}
+
+// SubscribeOrderbook streams orderbook updates for an exchange currency pair
+// as they are stored by the orderbook updater routines
+func (s *RPCServer) SubscribeOrderbook(r *gctrpc.SubscribeOrderbookRequest, stream gctrpc.GoCryptoTrader_SubscribeOrderbookServer) error {
+	exch, err := getLoadedExchange(r.Exchange)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	p, err := parseCurrencyPair(r.Pair)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	exchangeName := exch.GetName()
+	assetType := rpcAssetType(r.AssetType)
+	return rpcPoll(stream.Context(), func(last time.Time) (time.Time, error) {
+		ob, err := orderbook.GetOrderbook(exchangeName, p, assetType)
+		if err != nil || !ob.LastUpdated.After(last) {
+			return last, nil
+		}
+		return ob.LastUpdated, stream.Send(orderbookToRPC(exchangeName, ob))
+	})
+}
+
+// rpcPoll calls send every stream interval with the last update time until
+// the stream is closed or send returns an error
+func rpcPoll(ctx context.Context, send func(last time.Time) (time.Time, error)) error {
+	tick := time.NewTicker(rpcStreamInterval)
+	defer tick.Stop()
+
+	var last time.Time
+	var err error
+	for {
+		last, err = send(last)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+			return ctx.Err()
+		case <-tick.C:
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/events"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/gctrpc"
+	"github.com/thrasher-/gocryptotrader/portfolio"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func setupRPCTest(t *testing.T) gctrpc.GoCryptoTraderClient {
+	SetupTest(t)
+	bot.config.Webserver.AdminUsername = "admin"
+	bot.config.Webserver.AdminPassword = "password"
+	bot.portfolio = &portfolio.Portfolio
+	bot.control = &BotControl{}
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := NewRPCServer()
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal("Test failed. gRPC client error", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return gctrpc.NewGoCryptoTraderClient(conn)
+}
+
+func rpcTestContext(username, password string) context.Context {
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return metadata.AppendToOutgoingContext(context.Background(),
+		"authorization", "Basic "+auth)
+}
+
+func TestRPCAuthentication(t *testing.T) {
+	client := setupRPCTest(t)
+
+	_, err := client.GetInfo(context.Background(), &gctrpc.GetInfoRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Error("Test failed. GetInfo allowed without credentials", err)
+	}
+
+	_, err = client.GetInfo(rpcTestContext("admin", "wrong"), &gctrpc.GetInfoRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Error("Test failed. GetInfo allowed with invalid credentials", err)
+	}
+
+	info, err := client.GetInfo(rpcTestContext("admin", "password"), &gctrpc.GetInfoRequest{})
+	if err != nil || info.AvailableExchanges == 0 {
+		t.Error("Test failed. GetInfo error", err)
+	}
+
+	stream, err := client.SubscribeTicker(context.Background(),
+		&gctrpc.SubscribeTickerRequest{Exchange: "Bitfinex", Pair: "BTCUSD"})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unauthenticated {
+		t.Error("Test failed. SubscribeTicker allowed without credentials", err)
+	}
+}
+
+func TestRPCExchanges(t *testing.T) {
+	client := setupRPCTest(t)
+	ctx := rpcTestContext("admin", "password")
+
+	resp, err := client.GetExchanges(ctx, &gctrpc.GetExchangesRequest{Enabled: true})
+	if err != nil || len(resp.Exchanges) == 0 {
+		t.Error("Test failed. GetExchanges error", err)
+	}
+
+	_, err = client.GetTicker(ctx, &gctrpc.GetTickerRequest{Exchange: "Asdsad", Pair: "BTCUSD"})
+	if status.Code(err) != codes.NotFound {
+		t.Error("Test failed. GetTicker unknown exchange error", err)
+	}
+
+	_, err = client.EnableExchange(ctx, &gctrpc.GenericExchangeNameRequest{Exchange: "Bitfinex"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Error("Test failed. EnableExchange error", err)
+	}
+}
+
+func TestRPCEventsAndPortfolio(t *testing.T) {
+	client := setupRPCTest(t)
+	ctx := rpcTestContext("admin", "password")
+
+	event, err := client.AddEvent(ctx, &gctrpc.AddEventRequest{
+		Exchange:  "ANX",
+		Item:      "PRICE",
+		Condition: ">,10000",
+		Pair:      "BTCUSD",
+		Action:    "CONSOLE_PRINT",
+	})
+	if err != nil {
+		t.Fatal("Test failed. AddEvent error", err)
+	}
+	defer events.RemoveEvent(int(event.Id))
+
+	_, err = client.AddEvent(ctx, &gctrpc.AddEventRequest{Exchange: "ANX", Item: "LOL", Pair: "BTCUSD"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Error("Test failed. AddEvent invalid event accepted", err)
+	}
+
+	eventList, err := client.GetEvents(ctx, &gctrpc.GetEventsRequest{})
+	if err != nil || len(eventList.Events) == 0 {
+		t.Error("Test failed. GetEvents error", err)
+	}
+
+	_, err = client.RemoveEvent(ctx, &gctrpc.RemoveEventRequest{Id: 1337})
+	if status.Code(err) != codes.NotFound {
+		t.Error("Test failed. RemoveEvent unknown event error", err)
+	}
+
+	_, err = client.AddPortfolioAddress(ctx, &gctrpc.AddPortfolioAddressRequest{
+		Address:  "rpctestaddress",
+		CoinType: "btc",
+		Balance:  1,
+	})
+	if err != nil {
+		t.Fatal("Test failed. AddPortfolioAddress error", err)
+	}
+
+	_, err = client.RemovePortfolioAddress(ctx, &gctrpc.RemovePortfolioAddressRequest{
+		Address:  "rpctestaddress",
+		CoinType: "BTC",
+	})
+	if err != nil {
+		t.Error("Test failed. RemovePortfolioAddress error", err)
+	}
+
+	_, err = client.RemovePortfolioAddress(ctx, &gctrpc.RemovePortfolioAddressRequest{
+		Address:  "rpctestaddress",
+		CoinType: "BTC",
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Error("Test failed. RemovePortfolioAddress unknown address error", err)
+	}
+}
+
+func TestRPCSubscribeTicker(t *testing.T) {
+	client := setupRPCTest(t)
+	rpcStreamInterval = time.Millisecond * 10
+	defer func() { rpcStreamInterval = time.Second }()
+
+	p := pair.NewCurrencyPair("BTC", "USD")
+	ticker.ProcessTicker("Bitfinex", p, ticker.Price{Pair: p, Last: 1337}, ticker.Spot)
+
+	ctx, cancel := context.WithTimeout(rpcTestContext("admin", "password"), time.Second*5)
+	defer cancel()
+
+	stream, err := client.SubscribeTicker(ctx, &gctrpc.SubscribeTickerRequest{
+		Exchange: "bitfinex",
+		Pair:     "btcusd",
+	})
+	if err != nil {
+		t.Fatal("Test failed. SubscribeTicker error", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil || resp.Last != 1337 || resp.Exchange != "Bitfinex" {
+		t.Fatal("Test failed. SubscribeTicker first update error", err, resp)
+	}
+
+	time.Sleep(time.Millisecond * 5)
+	ticker.ProcessTicker("Bitfinex", p, ticker.Price{Pair: p, Last: 1338}, ticker.Spot)
+	resp, err = stream.Recv()
+	if err != nil || resp.Last != 1338 {
+		t.Error("Test failed. SubscribeTicker second update error", err, resp)
+	}
+}
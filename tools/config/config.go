@@ -2,10 +2,14 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	// registers the credentials each exchange requires for config validation
+	_ "github.com/thrasher-/gocryptotrader/exchanges/supported"
 )
 
 // EncryptOrDecrypt returns a string from a boolean
@@ -16,9 +20,182 @@ func EncryptOrDecrypt(encrypt bool) string {
 	return "decrypted"
 }
 
+// getKey resolves a key given as an env: or file: secret reference, when no
+// key is given it is read from the GCT_CONFIG_KEY environment variable or
+// prompted for
+func getKey(key string) (string, error) {
+	if key != "" {
+		return config.ResolveSecret(key)
+	}
+
+	result, err := config.EncryptionKey()
+	if err != nil {
+		return "", err
+	}
+
+	if result == nil {
+		result, err = config.PromptForConfigKey(false)
+		if err != nil {
+			return "", err
+		}
+	}
+	return string(result), nil
+}
+
+// readConfig reads the input config, decrypting it when needed, and returns
+// the key used so the config can be encrypted again along with the format the
+// config was in
+func readConfig(inFile, key string) (cfg config.Config, encrypted bool, usedKey, format string, err error) {
+	file, err := common.ReadFile(inFile)
+	if err != nil {
+		return cfg, false, "", "", fmt.Errorf("unable to read input file %s. Error: %s", inFile, err)
+	}
+
+	encrypted = config.ConfirmECS(file)
+	if encrypted {
+		key, err = getKey(key)
+		if err != nil {
+			return cfg, true, "", "", err
+		}
+
+		file, err = config.DecryptConfigFile(file, []byte(key))
+		if err != nil {
+			return cfg, true, "", "", fmt.Errorf("unable to decrypt config data. Error: %s", err)
+		}
+	}
+
+	format = config.DetectConfigFormat(inFile, file)
+	err = config.UnmarshalConfig(file, format, &cfg)
+	if err != nil {
+		return cfg, encrypted, "", format, fmt.Errorf("file isn't in %s format. Error: %s",
+			common.StringToUpper(format), err)
+	}
+	return cfg, encrypted, key, format, nil
+}
+
+// writeConfig writes the config to the output file in the format, encrypting
+// it with the key when needed
+func writeConfig(outFile string, cfg *config.Config, format string, encrypted bool, key string) error {
+	data, err := config.MarshalConfig(cfg, format)
+	if err != nil {
+		return err
+	}
+
+	if encrypted {
+		data, err = config.EncryptConfigFile(data, []byte(key))
+		if err != nil {
+			return fmt.Errorf("unable to encrypt config data. Error: %s", err)
+		}
+	}
+
+	err = common.WriteFile(outFile, data)
+	if err != nil {
+		return fmt.Errorf("unable to write output file %s. Error: %s", outFile, err)
+	}
+	return nil
+}
+
+// validateConfig validates the input config and prints the report, returning
+// whether the config is valid
+func validateConfig(inFile, key, format string) (bool, error) {
+	cfg, _, _, _, err := readConfig(inFile, key)
+	if err != nil {
+		return false, err
+	}
+
+	report := cfg.Validate()
+	output, err := report.Format(format)
+	if err != nil {
+		return false, err
+	}
+	fmt.Println(output)
+	return report.Valid, nil
+}
+
+// migrateConfig upgrades the input config to the current config version and
+// writes it to the output file, a dry run prints the changes instead
+func migrateConfig(inFile, outFile, key string, dryRun bool) error {
+	cfg, encrypted, key, format, err := readConfig(inFile, key)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		pending, diff, errf := cfg.DiffMigration()
+		if errf != nil {
+			return errf
+		}
+		if len(pending) == 0 {
+			log.Printf("Config %s is already at version %d.", inFile, cfg.Version)
+			return nil
+		}
+		for i := range pending {
+			fmt.Printf("Migration %d -> %d: %s\n", pending[i].Version,
+				pending[i].Version+1, pending[i].Description)
+		}
+		for i := range diff {
+			fmt.Println(diff[i])
+		}
+		return nil
+	}
+
+	backup, err := config.BackupConfigFile(inFile, cfg.Version)
+	if err != nil {
+		return fmt.Errorf("unable to back up input file %s. Error: %s", inFile, err)
+	}
+	log.Printf("Backed up input file %s to %s.", inFile, backup)
+
+	applied, err := cfg.Migrate()
+	if err != nil {
+		return err
+	}
+
+	if outFormat := config.FileFormat(outFile); outFormat != "" {
+		format = outFormat
+	}
+
+	err = writeConfig(outFile, &cfg, format, encrypted, key)
+	if err != nil {
+		return err
+	}
+	log.Printf("Successfully applied %d migrations to input file %s and wrote version %d to %s.",
+		len(applied), inFile, cfg.Version, outFile)
+	return nil
+}
+
+// convertConfig converts the input config to the format and writes it to the
+// output file, an encrypted config stays encrypted with the same key. When no
+// format is given it is chosen by the output file extension
+func convertConfig(inFile, outFile, key, format string) error {
+	if format == "" {
+		format = config.FileFormat(outFile)
+		if format == "" {
+			return fmt.Errorf("unable to choose a format for output file %s, use -format %s or -format %s",
+				outFile, config.ConfigFormatJSON, config.ConfigFormatYAML)
+		}
+	}
+
+	if format != config.ConfigFormatJSON && format != config.ConfigFormatYAML {
+		return fmt.Errorf("unsupported config format %s", format)
+	}
+
+	cfg, encrypted, key, inFormat, err := readConfig(inFile, key)
+	if err != nil {
+		return err
+	}
+
+	err = writeConfig(outFile, &cfg, format, encrypted, key)
+	if err != nil {
+		return err
+	}
+	log.Printf("Successfully converted %s input file %s and wrote %s output to %s.",
+		common.StringToUpper(inFormat), inFile, common.StringToUpper(format), outFile)
+	return nil
+}
+
 func main() {
-	var inFile, outFile, key string
-	var encrypt bool
+	var inFile, outFile, key, validateFormat, format string
+	var encrypt, migrate, dryRun, validate, convert bool
 	var err error
 
 	configFile, err := config.GetFilePath("")
@@ -29,17 +206,47 @@ func main() {
 	flag.StringVar(&inFile, "infile", configFile, "The config input file to process.")
 	flag.StringVar(&outFile, "outfile", configFile+".out", "The config output file.")
 	flag.BoolVar(&encrypt, "encrypt", true, "Whether to encrypt or decrypt.")
-	flag.StringVar(&key, "key", "", "The key to use for AES encryption.")
+	flag.StringVar(&key, "key", "", "The key to use for AES encryption, or an env:NAME or file:/path reference to read it from.")
+	flag.BoolVar(&migrate, "migrate", false, "Migrate the config to the current config version.")
+	flag.BoolVar(&dryRun, "dryrun", false, "Print the changes a migration would make without writing them.")
+	flag.BoolVar(&validate, "validate", false, "Validate the config and report every problem found.")
+	flag.StringVar(&validateFormat, "validateformat", config.ValidationFormatText, "The validation report format, text or json.")
+	flag.BoolVar(&convert, "convert", false, "Convert the config between the JSON and YAML formats.")
+	flag.StringVar(&format, "format", "", "The format to convert the config to, json or yaml. Defaults to the output file extension.")
 	flag.Parse()
 
 	log.Println("GoCryptoTrader: config-helper tool.")
 
-	if key == "" {
-		result, errf := config.PromptForConfigKey(false)
-		if errf != nil {
-			log.Fatal("Unable to obtain encryption/decryption key.")
+	if validate {
+		valid, err := validateConfig(inFile, key, validateFormat)
+		if err != nil {
+			log.Fatalf("Unable to validate config. Error: %s.", err)
+		}
+		if !valid {
+			os.Exit(1)
 		}
-		key = string(result)
+		return
+	}
+
+	if convert {
+		err = convertConfig(inFile, outFile, key, format)
+		if err != nil {
+			log.Fatalf("Unable to convert config. Error: %s.", err)
+		}
+		return
+	}
+
+	if migrate || dryRun {
+		err = migrateConfig(inFile, outFile, key, dryRun)
+		if err != nil {
+			log.Fatalf("Unable to migrate config. Error: %s.", err)
+		}
+		return
+	}
+
+	key, err = getKey(key)
+	if err != nil {
+		log.Fatal("Unable to obtain encryption/decryption key.")
 	}
 
 	file, err := common.ReadFile(inFile)
@@ -54,9 +261,9 @@ func main() {
 
 	if !config.ConfirmECS(file) && !encrypt {
 		var result interface{}
-		errf := config.ConfirmConfigJSON(file, result)
+		errf := config.UnmarshalConfig(file, config.DetectConfigFormat(inFile, file), &result)
 		if errf != nil {
-			log.Fatal("File isn't in JSON format")
+			log.Fatal("File isn't in JSON or YAML format")
 		}
 		log.Println("File is already decrypted. Encrypting..")
 		encrypt = true
@@ -1,6 +1,13 @@
 package main
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/config"
+)
 
 func TestEncryptOrDecrypt(t *testing.T) {
 	reValue := EncryptOrDecrypt(true)
@@ -16,3 +23,33 @@ func TestEncryptOrDecrypt(t *testing.T) {
 		)
 	}
 }
+
+func TestConvertConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-config-convert")
+	if err != nil {
+		t.Fatal("Test failed - Tools/Config/Config_test.go - TempDir Error", err)
+	}
+	defer os.RemoveAll(dir)
+
+	yamlFile := filepath.Join(dir, "config.yaml")
+	err = convertConfig("../../testdata/configtest.json", yamlFile, "", "")
+	if err != nil {
+		t.Fatal("Test failed - Tools/Config/Config_test.go - convertConfig Error", err)
+	}
+
+	jsonFile := filepath.Join(dir, "config.out")
+	err = convertConfig(yamlFile, jsonFile, "", config.ConfigFormatJSON)
+	if err != nil {
+		t.Fatal("Test failed - Tools/Config/Config_test.go - convertConfig Error", err)
+	}
+
+	cfg, _, _, format, err := readConfig(jsonFile, "")
+	if err != nil || format != config.ConfigFormatJSON || len(cfg.Exchanges) == 0 {
+		t.Error("Test failed - Tools/Config/Config_test.go - readConfig Error", err)
+	}
+
+	err = convertConfig(yamlFile, jsonFile, "", "")
+	if err == nil {
+		t.Error("Test failed - Tools/Config/Config_test.go - convertConfig chose a format for an unknown extension")
+	}
+}
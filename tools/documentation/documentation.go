@@ -20,6 +20,9 @@ const (
 	communicationsSmsglobalPath     = "..%s..%scommunications%ssmsglobal%s"
 	communicationsSMTPPath          = "..%s..%scommunications%ssmtpservice%s"
 	communicationsTelegramPath      = "..%s..%scommunications%stelegram%s"
+	communicationsWebhookPath       = "..%s..%scommunications%swebhook%s"
+	communicationsDiscordPath       = "..%s..%scommunications%sdiscord%s"
+	communicationsMatrixPath        = "..%s..%scommunications%smatrix%s"
 	configPath                      = "..%s..%sconfig%s"
 	currencyPath                    = "..%s..%scurrency%s"
 	currencyFXPath                  = "..%s..%scurrency%sforexprovider%s"
@@ -29,8 +32,9 @@ const (
 	currencyFXFixerPath             = "..%s..%scurrency%sforexprovider%sfixer.io%s"
 	currencyFXOpenExchangeRatesPath = "..%s..%scurrency%sforexprovider%sopenexchangerates%s"
 	currencyPairPath                = "..%s..%scurrency%spair%s"
+	currencyRegistryPath            = "..%s..%scurrency%sregistry%s"
 	currencySymbolPath              = "..%s..%scurrency%ssymbol%s"
-	currencyTranslationPath         = "..%s..%scurrency%stranslation%s"
+	decimalPath                     = "..%s..%sdecimal%s"
 	eventsPath                      = "..%s..%sevents%s"
 	exchangesPath                   = "..%s..%sexchanges%s"
 	exchangesNoncePath              = "..%s..%sexchanges%snonce%s"
@@ -39,6 +43,9 @@ const (
 	exchangesTickerPath             = "..%s..%sexchanges%sticker%s"
 	exchangesOrdersPath             = "..%s..%sexchanges%sorders%s"
 	exchangesRequestPath            = "..%s..%sexchanges%srequest%s"
+	exchangesSupportedPath          = "..%s..%sexchanges%ssupported%s"
+	gctrpcPath                      = "..%s..%sgctrpc%s"
+	metricsPath                     = "..%s..%smetrics%s"
 	portfolioPath                   = "..%s..%sportfolio%s"
 	testdataPath                    = "..%s..%stestdata%s"
 	toolsPath                       = "..%s..%stools%s"
@@ -191,6 +198,9 @@ func addPaths() {
 	codebasePaths["communications smsglobal"] = fmt.Sprintf(communicationsSmsglobalPath, path, path, path, path)
 	codebasePaths["communications smtp"] = fmt.Sprintf(communicationsSMTPPath, path, path, path, path)
 	codebasePaths["communications telegram"] = fmt.Sprintf(communicationsTelegramPath, path, path, path, path)
+	codebasePaths["communications webhook"] = fmt.Sprintf(communicationsWebhookPath, path, path, path, path)
+	codebasePaths["communications discord"] = fmt.Sprintf(communicationsDiscordPath, path, path, path, path)
+	codebasePaths["communications matrix"] = fmt.Sprintf(communicationsMatrixPath, path, path, path, path)
 
 	codebasePaths["config"] = fmt.Sprintf(configPath, path, path, path)
 
@@ -202,11 +212,14 @@ func addPaths() {
 	codebasePaths["currency forexprovider fixer"] = fmt.Sprintf(currencyFXFixerPath, path, path, path, path, path)
 	codebasePaths["currency forexprovider openexchangerates"] = fmt.Sprintf(currencyFXOpenExchangeRatesPath, path, path, path, path, path)
 	codebasePaths["currency pair"] = fmt.Sprintf(currencyPairPath, path, path, path, path)
+	codebasePaths["currency registry"] = fmt.Sprintf(currencyRegistryPath, path, path, path, path)
 	codebasePaths["currency symbol"] = fmt.Sprintf(currencySymbolPath, path, path, path, path)
-	codebasePaths["currency translation"] = fmt.Sprintf(currencyTranslationPath, path, path, path, path)
 
+	codebasePaths["decimal"] = fmt.Sprintf(decimalPath, path, path, path)
 	codebasePaths["events"] = fmt.Sprintf(eventsPath, path, path, path)
 
+	codebasePaths["gctrpc"] = fmt.Sprintf(gctrpcPath, path, path, path)
+	codebasePaths["metrics"] = fmt.Sprintf(metricsPath, path, path, path)
 	codebasePaths["portfolio"] = fmt.Sprintf(portfolioPath, path, path, path)
 	codebasePaths["testdata"] = fmt.Sprintf(testdataPath, path, path, path)
 	codebasePaths["tools"] = fmt.Sprintf(toolsPath, path, path, path)
@@ -220,6 +233,7 @@ func addPaths() {
 	codebasePaths["exchanges ticker"] = fmt.Sprintf(exchangesTickerPath, path, path, path, path)
 	codebasePaths["exchanges orders"] = fmt.Sprintf(exchangesOrdersPath, path, path, path, path)
 	codebasePaths["exchanges request"] = fmt.Sprintf(exchangesRequestPath, path, path, path, path)
+	codebasePaths["exchanges supported"] = fmt.Sprintf(exchangesSupportedPath, path, path, path, path)
 
 	codebasePaths["exchanges alphapoint"] = fmt.Sprintf(alphapoint, path, path, path, path)
 	codebasePaths["exchanges anx"] = fmt.Sprintf(anx, path, path, path, path)
@@ -305,8 +319,11 @@ var globS = []string{
 	fmt.Sprintf("communications_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("config_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("currency_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("decimal_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("events_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("exchanges_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("gctrpc_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("metrics_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("portfolio_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("root_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("sub_templates%s*", common.GetOSPathSlash()),
@@ -0,0 +1,466 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const requestTimeout = time.Second * 30
+
+var errMissingArgs = errors.New("missing required arguments, see -h for usage")
+
+// command is a gctcli subcommand
+type command struct {
+	usage string
+	run   func(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error
+}
+
+var commands = map[string]command{
+	"getinfo":                {"returns the bot version, uptime and status", getInfo},
+	"listexchanges":          {"lists the exchanges in the config, -enabled lists loaded exchanges only", listExchanges},
+	"enableexchange":         {"loads an exchange and enables it in the config", enableExchange},
+	"disableexchange":        {"unloads an exchange and disables it in the config", disableExchange},
+	"getticker":              {"returns the ticker for an exchange currency pair", getTicker},
+	"getorderbook":           {"returns the orderbook for an exchange currency pair", getOrderbook},
+	"getaccountinfo":         {"returns the account balances for an exchange", getAccountInfo},
+	"submitorder":            {"places a limit or market order", submitOrder},
+	"cancelorder":            {"cancels an order by ID", cancelOrder},
+	"cancelallorders":        {"cancels all orders on an exchange", cancelAllOrders},
+	"getorders":              {"lists the open orders placed through the bot", getOrders},
+	"getevents":              {"lists the events on the event chain", getEvents},
+	"addevent":               {"adds an event to the event chain", addEvent},
+	"removeevent":            {"removes an event from the event chain", removeEvent},
+	"getportfolio":           {"lists the portfolio addresses", getPortfolio},
+	"addportfolioaddress":    {"adds an address to the portfolio", addPortfolioAddress},
+	"removeportfolioaddress": {"removes an address from the portfolio", removePortfolioAddress},
+	"subscribeticker":        {"streams ticker updates until interrupted", subscribeTicker},
+	"subscribeorderbook":     {"streams orderbook updates until interrupted", subscribeOrderbook},
+}
+
+// basicAuth sends the bot admin credentials with every request
+type basicAuth struct {
+	username, password string
+}
+
+func (b basicAuth) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(b.username + ":" + b.password))
+	return map[string]string{"authorization": "Basic " + auth}, nil
+}
+
+func (b basicAuth) RequireTransportSecurity() bool {
+	return false
+}
+
+func main() {
+	host := flag.String("host", "localhost:9052", "the gRPC address of the bot")
+	username := flag.String("username", "admin", "the webserver admin username")
+	password := flag.String("password", os.Getenv("GCTCLI_PASSWORD"), "the webserver admin password, defaults to the GCTCLI_PASSWORD environment variable")
+	useTLS := flag.Bool("tls", false, "connect using TLS")
+	certFile := flag.String("cert", common.GetDefaultDataDir(runtime.GOOS)+common.GetOSPathSlash()+"tls"+common.GetOSPathSlash()+"cert.pem", "the certificate used to verify the bot when TLS is enabled")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		log.Fatalf("Unknown command %s, see -h for usage", flag.Arg(0))
+	}
+
+	creds := insecure.NewCredentials()
+	if *useTLS {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(*certFile, "")
+		if err != nil {
+			log.Fatalf("Failed to load certificate %s. Err: %s", *certFile, err)
+		}
+	}
+
+	conn, err := grpc.NewClient(*host,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(basicAuth{*username, *password}),
+	)
+	if err != nil {
+		log.Fatalf("Failed to connect to %s. Err: %s", *host, err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	err = cmd.run(ctx, gctrpc.NewGoCryptoTraderClient(conn), flag.Args()[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: gctcli [global flags] <command> [command flags]\n\nGlobal flags:\n")
+	flag.PrintDefaults()
+	fmt.Fprintf(os.Stderr, "\nCommands:\n")
+
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-24s %s\n", name, commands[name].usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun gctcli <command> -h for command flags\n")
+}
+
+// jsonOutput prints a response as indented JSON
+func jsonOutput(m proto.Message) {
+	out, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(out))
+}
+
+// unary applies the request timeout to a unary call and prints its response
+func unary(ctx context.Context, call func(ctx context.Context) (proto.Message, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	resp, err := call(ctx)
+	if err != nil {
+		return err
+	}
+	jsonOutput(resp)
+	return nil
+}
+
+// exchangePairFlags holds the flags shared by the market data commands
+type exchangePairFlags struct {
+	exchange, pair, assetType *string
+}
+
+func newExchangePairFlags(fs *flag.FlagSet) exchangePairFlags {
+	return exchangePairFlags{
+		exchange:  fs.String("exchange", "", "the exchange name"),
+		pair:      fs.String("pair", "", "the currency pair, for example BTCUSD"),
+		assetType: fs.String("asset", "SPOT", "the asset type"),
+	}
+}
+
+func (e exchangePairFlags) valid() bool {
+	return *e.exchange != "" && *e.pair != ""
+}
+
+func getInfo(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("getinfo", flag.ExitOnError)
+	fs.Parse(args)
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetInfo(ctx, &gctrpc.GetInfoRequest{})
+	})
+}
+
+func listExchanges(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("listexchanges", flag.ExitOnError)
+	enabled := fs.Bool("enabled", false, "only list loaded exchanges")
+	fs.Parse(args)
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetExchanges(ctx, &gctrpc.GetExchangesRequest{Enabled: *enabled})
+	})
+}
+
+func setExchangeEnabled(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string, enable bool) error {
+	name := "disableexchange"
+	if enable {
+		name = "enableexchange"
+	}
+
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	exchange := fs.String("exchange", "", "the exchange name")
+	fs.Parse(args)
+	if *exchange == "" {
+		return errMissingArgs
+	}
+
+	req := &gctrpc.GenericExchangeNameRequest{Exchange: *exchange}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		if enable {
+			return c.EnableExchange(ctx, req)
+		}
+		return c.DisableExchange(ctx, req)
+	})
+}
+
+func enableExchange(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	return setExchangeEnabled(ctx, c, args, true)
+}
+
+func disableExchange(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	return setExchangeEnabled(ctx, c, args, false)
+}
+
+func getTicker(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("getticker", flag.ExitOnError)
+	f := newExchangePairFlags(fs)
+	fs.Parse(args)
+	if !f.valid() {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetTicker(ctx, &gctrpc.GetTickerRequest{
+			Exchange:  *f.exchange,
+			Pair:      *f.pair,
+			AssetType: *f.assetType,
+		})
+	})
+}
+
+func getOrderbook(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("getorderbook", flag.ExitOnError)
+	f := newExchangePairFlags(fs)
+	fs.Parse(args)
+	if !f.valid() {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetOrderbook(ctx, &gctrpc.GetOrderbookRequest{
+			Exchange:  *f.exchange,
+			Pair:      *f.pair,
+			AssetType: *f.assetType,
+		})
+	})
+}
+
+func getAccountInfo(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("getaccountinfo", flag.ExitOnError)
+	exchange := fs.String("exchange", "", "the exchange name")
+	fs.Parse(args)
+	if *exchange == "" {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetAccountInfo(ctx, &gctrpc.GenericExchangeNameRequest{Exchange: *exchange})
+	})
+}
+
+func submitOrder(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("submitorder", flag.ExitOnError)
+	exchange := fs.String("exchange", "", "the exchange name")
+	pair := fs.String("pair", "", "the currency pair, for example BTCUSD")
+	side := fs.String("side", "", "the order side, buy or sell")
+	orderType := fs.String("type", "limit", "the order type, limit or market")
+	amount := fs.Float64("amount", 0, "the order amount")
+	price := fs.Float64("price", 0, "the limit price")
+	clientID := fs.String("clientid", "", "an optional client ID")
+	fs.Parse(args)
+	if *exchange == "" || *pair == "" || *side == "" || *amount <= 0 {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.SubmitOrder(ctx, &gctrpc.SubmitOrderRequest{
+			Exchange:  *exchange,
+			Pair:      *pair,
+			Side:      *side,
+			OrderType: *orderType,
+			Amount:    *amount,
+			Price:     *price,
+			ClientId:  *clientID,
+		})
+	})
+}
+
+func cancelOrder(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("cancelorder", flag.ExitOnError)
+	exchange := fs.String("exchange", "", "the exchange name")
+	orderID := fs.String("orderid", "", "the order ID")
+	fs.Parse(args)
+	if *exchange == "" || *orderID == "" {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.CancelOrder(ctx, &gctrpc.CancelOrderRequest{
+			Exchange: *exchange,
+			OrderId:  *orderID,
+		})
+	})
+}
+
+func cancelAllOrders(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("cancelallorders", flag.ExitOnError)
+	exchange := fs.String("exchange", "", "the exchange name")
+	fs.Parse(args)
+	if *exchange == "" {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.CancelAllOrders(ctx, &gctrpc.GenericExchangeNameRequest{Exchange: *exchange})
+	})
+}
+
+func getOrders(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("getorders", flag.ExitOnError)
+	exchange := fs.String("exchange", "", "an optional exchange name to filter by")
+	fs.Parse(args)
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetOrders(ctx, &gctrpc.GetOrdersRequest{Exchange: *exchange})
+	})
+}
+
+func getEvents(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("getevents", flag.ExitOnError)
+	fs.Parse(args)
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetEvents(ctx, &gctrpc.GetEventsRequest{})
+	})
+}
+
+func addEvent(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("addevent", flag.ExitOnError)
+	f := newExchangePairFlags(fs)
+	item := fs.String("item", "PRICE", "the item to check")
+	condition := fs.String("condition", "", "the condition operator and value, for example \">,10000\"")
+	action := fs.String("action", "CONSOLE_PRINT", "the action to perform, CONSOLE_PRINT or SMS,<contact name|ALL>")
+	fs.Parse(args)
+	if !f.valid() || *condition == "" {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.AddEvent(ctx, &gctrpc.AddEventRequest{
+			Exchange:  *f.exchange,
+			Item:      *item,
+			Condition: *condition,
+			Pair:      *f.pair,
+			AssetType: *f.assetType,
+			Action:    *action,
+		})
+	})
+}
+
+func removeEvent(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("removeevent", flag.ExitOnError)
+	id := fs.Int64("id", -1, "the event ID")
+	fs.Parse(args)
+	if *id < 0 {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.RemoveEvent(ctx, &gctrpc.RemoveEventRequest{Id: *id})
+	})
+}
+
+func getPortfolio(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("getportfolio", flag.ExitOnError)
+	fs.Parse(args)
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.GetPortfolio(ctx, &gctrpc.GetPortfolioRequest{})
+	})
+}
+
+func addPortfolioAddress(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("addportfolioaddress", flag.ExitOnError)
+	address := fs.String("address", "", "the address")
+	coinType := fs.String("coin", "", "the coin type, for example BTC")
+	description := fs.String("description", "", "an optional description")
+	balance := fs.Float64("balance", 0, "the address balance")
+	fs.Parse(args)
+	if *address == "" || *coinType == "" {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.AddPortfolioAddress(ctx, &gctrpc.AddPortfolioAddressRequest{
+			Address:     *address,
+			CoinType:    *coinType,
+			Description: *description,
+			Balance:     *balance,
+		})
+	})
+}
+
+func removePortfolioAddress(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("removeportfolioaddress", flag.ExitOnError)
+	address := fs.String("address", "", "the address")
+	coinType := fs.String("coin", "", "the coin type, for example BTC")
+	description := fs.String("description", "", "the address description")
+	fs.Parse(args)
+	if *address == "" || *coinType == "" {
+		return errMissingArgs
+	}
+	return unary(ctx, func(ctx context.Context) (proto.Message, error) {
+		return c.RemovePortfolioAddress(ctx, &gctrpc.RemovePortfolioAddressRequest{
+			Address:     *address,
+			CoinType:    *coinType,
+			Description: *description,
+		})
+	})
+}
+
+func subscribeTicker(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("subscribeticker", flag.ExitOnError)
+	f := newExchangePairFlags(fs)
+	fs.Parse(args)
+	if !f.valid() {
+		return errMissingArgs
+	}
+
+	stream, err := c.SubscribeTicker(ctx, &gctrpc.SubscribeTickerRequest{
+		Exchange:  *f.exchange,
+		Pair:      *f.pair,
+		AssetType: *f.assetType,
+	})
+	if err != nil {
+		return err
+	}
+	return printStream(ctx, func() (proto.Message, error) { return stream.Recv() })
+}
+
+func subscribeOrderbook(ctx context.Context, c gctrpc.GoCryptoTraderClient, args []string) error {
+	fs := flag.NewFlagSet("subscribeorderbook", flag.ExitOnError)
+	f := newExchangePairFlags(fs)
+	fs.Parse(args)
+	if !f.valid() {
+		return errMissingArgs
+	}
+
+	stream, err := c.SubscribeOrderbook(ctx, &gctrpc.SubscribeOrderbookRequest{
+		Exchange:  *f.exchange,
+		Pair:      *f.pair,
+		AssetType: *f.assetType,
+	})
+	if err != nil {
+		return err
+	}
+	return printStream(ctx, func() (proto.Message, error) { return stream.Recv() })
+}
+
+// printStream prints streamed responses until the stream ends or the command
+// is interrupted
+func printStream(ctx context.Context, recv func() (proto.Message, error)) error {
+	for {
+		resp, err := recv()
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
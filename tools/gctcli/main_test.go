@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMissingArgs(t *testing.T) {
+	tests := map[string][]string{
+		"enableexchange":         {},
+		"getticker":              {"-exchange", "Bitfinex"},
+		"submitorder":            {"-exchange", "Bitfinex", "-pair", "BTCUSD", "-side", "buy"},
+		"cancelorder":            {"-exchange", "Bitfinex"},
+		"addevent":               {"-exchange", "Bitfinex", "-pair", "BTCUSD"},
+		"removeevent":            {},
+		"addportfolioaddress":    {"-address", "1F5zVDgNjorJ51oGebSvNCrSAHpwGkUdDB"},
+		"removeportfolioaddress": {"-coin", "BTC"},
+	}
+
+	for name, args := range tests {
+		err := commands[name].run(context.Background(), nil, args)
+		if err != errMissingArgs {
+			t.Errorf("Test failed. %s expected missing args error, got %v", name, err)
+		}
+	}
+}
+
+func TestBasicAuth(t *testing.T) {
+	md, err := basicAuth{"admin", "password"}.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if md["authorization"] != "Basic YWRtaW46cGFzc3dvcmQ=" {
+		t.Error("Test failed. basicAuth unexpected authorization", md["authorization"])
+	}
+}
@@ -45,6 +45,16 @@ type WebsocketOrderbookTickerRequest struct {
 	AssetType string `json:"assetType"`
 }
 
+// WebsocketSubscribeRequest is the struct used to subscribe to ticker,
+// orderbook, trade, order update and event trigger events
+type WebsocketSubscribeRequest struct {
+	Exchange  string `json:"exchangeName"`
+	Currency  string `json:"currency"`
+	AssetType string `json:"assetType"`
+	Event     string `json:"event"`
+	Throttle  int64  `json:"throttle,omitempty"`
+}
+
 // SendWebsocketEvent sends a websocket event message
 func SendWebsocketEvent(event string, reqData interface{}, result *WebsocketEventResponse) error {
 	req := WebsocketEvent{
@@ -178,6 +188,25 @@ func main() {
 	}
 	log.Println("Got orderbook!")
 
+	log.Println("Subscribing to ticker and orderbook updates..")
+	err = SendWebsocketEvent("Subscribe", WebsocketSubscribeRequest{
+		Event:    "ticker",
+		Throttle: 1000,
+	}, &wsResp)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = SendWebsocketEvent("Subscribe", WebsocketSubscribeRequest{
+		Exchange: "Bitfinex",
+		Currency: "BTCUSD",
+		Event:    "orderbook",
+	}, &wsResp)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("Subscribed!")
+
 	for {
 		var wsEvent WebsocketEvent
 		err = WSConn.ReadJSON(&wsEvent)
@@ -33,6 +33,7 @@ export class WebsocketService {
         ws.onopen = () => {
           this.isConnected = true;
           ws.send(JSON.stringify(WebSocketMessage.CreateAuthenticationMessage()));
+          ws.send(JSON.stringify(WebSocketMessage.CreateTickerSubscriptionMessage()));
         };
         return ws.close.bind(ws);
       });
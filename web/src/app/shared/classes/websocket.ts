@@ -3,6 +3,7 @@ export class WebSocketMessageType {
     public static GetConfig = 'GetConfig';
     public static SaveConfig = 'SaveConfig';
     public static GetPortfolio = 'GetPortfolio';
+    public static Subscribe = 'subscribe';
     public static TickerUpdate = 'ticker_update';
 }
 
@@ -22,6 +23,15 @@ export class WebSocketMessage {
         return response;
     }
 
+    public static CreateTickerSubscriptionMessage(): WebSocketMessage {
+        const response = new WebSocketMessage();
+
+        response.event = WebSocketMessageType.Subscribe;
+        response.data = { 'event': 'ticker' };
+
+        return response;
+    }
+
     public static GetSettingsMessage(): WebSocketMessage {
         const response = new WebSocketMessage();
 
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+const (
+	tlsDir      = "tls"
+	tlsCertFile = "cert.pem"
+	tlsKeyFile  = "key.pem"
+
+	selfSignedValidity = time.Hour * 24 * 365
+)
+
+// GetTLSCertPaths returns the certificate and key paths for the webserver,
+// defaulting to the data directory if they are not set in the config
+func GetTLSCertPaths(cfg config.WebserverTLSConfig, dataDir string) (certPath, keyPath string, generated bool) {
+	if cfg.CertFile != "" && cfg.KeyFile != "" {
+		return cfg.CertFile, cfg.KeyFile, false
+	}
+	dir := dataDir + common.GetOSPathSlash() + tlsDir + common.GetOSPathSlash()
+	return dir + tlsCertFile, dir + tlsKeyFile, true
+}
+
+// GetWebserverTLSConfig returns the TLS config for the webserver, generating a
+// self-signed certificate in the data directory if one does not exist and no
+// certificate has been configured
+func GetWebserverTLSConfig(cfg config.WebserverTLSConfig, dataDir, listenAddr string) (*tls.Config, error) {
+	certPath, keyPath, generated := GetTLSCertPaths(cfg, dataDir)
+	_, statErr := os.Stat(certPath)
+	if generated && os.IsNotExist(statErr) {
+		err := common.CheckDir(dataDir+common.GetOSPathSlash()+tlsDir, true)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("Generating self-signed TLS certificate %s.\n", certPath)
+		err = GenerateSelfSignedCert(certPath, keyPath,
+			[]string{"localhost", "127.0.0.1", "::1", common.ExtractHost(listenAddr)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate self-signed certificate. Err: %s", err)
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		caPEM, err := common.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no certificates found in client CA file")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// GenerateSelfSignedCert writes a self-signed ECDSA certificate and private key
+// valid for the supplied hosts
+func GenerateSelfSignedCert(certPath, keyPath string, hosts []string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"GoCryptoTrader"},
+			CommonName:   "GoCryptoTrader self-signed",
+		},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+
+	for _, h := range hosts {
+		if h == "" {
+			continue
+		}
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+			continue
+		}
+		template.DNSNames = append(template.DNSNames, h)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = writePEM(keyPath, "EC PRIVATE KEY", keyDER, 0600)
+	if err != nil {
+		return err
+	}
+	return writePEM(certPath, "CERTIFICATE", der, 0644)
+}
+
+func writePEM(path, blockType string, data []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	err = pem.Encode(f, &pem.Block{Type: blockType, Bytes: data})
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
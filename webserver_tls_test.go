@@ -0,0 +1,67 @@
+package main
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/config"
+)
+
+func TestGetWebserverTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gcttls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.WebserverTLSConfig{Enabled: true}
+	tlsConfig, err := GetWebserverTLSConfig(cfg, dir, "localhost:9050")
+	if err != nil {
+		t.Fatal("Test failed. GetWebserverTLSConfig error", err)
+	}
+
+	certPath, keyPath, _ := GetTLSCertPaths(cfg, dir)
+	info, err := os.Stat(keyPath)
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Error("Test failed. GetWebserverTLSConfig private key not written securely", err)
+	}
+
+	certPEM, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A second start must reuse the generated certificate
+	_, err = GetWebserverTLSConfig(cfg, dir, "localhost:9050")
+	if err != nil {
+		t.Fatal("Test failed. GetWebserverTLSConfig error", err)
+	}
+	reloaded, _ := ioutil.ReadFile(certPath)
+	if string(reloaded) != string(certPEM) {
+		t.Error("Test failed. GetWebserverTLSConfig regenerated existing certificate")
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(getIndex))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	defer server.Close()
+
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal("Test failed. TLS request error", err)
+	}
+	resp.Body.Close()
+
+	cfg.ClientCAFile = certPath
+	tlsConfig, err = GetWebserverTLSConfig(cfg, dir, "localhost:9050")
+	if err != nil || tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+		t.Error("Test failed. GetWebserverTLSConfig client certificate auth not enabled", err)
+	}
+}
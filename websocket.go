@@ -2,18 +2,61 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency"
+	"github.com/thrasher-/gocryptotrader/metrics"
 )
 
 // Const vars for websocket
 const (
 	WebsocketResponseSuccess = "OK"
+
+	WebsocketEventTicker    = "ticker_update"
+	WebsocketEventOrderbook = "orderbook_update"
+	WebsocketEventTrade     = "trade_update"
+	WebsocketEventOrder     = "order_update"
+	WebsocketEventTrigger   = "event_trigger"
+
+	// DefaultWebsocketThrottle is the minimum interval between ticker or
+	// orderbook updates for the same exchange, pair and asset type sent to a
+	// client unless it requests a different one
+	DefaultWebsocketThrottle = time.Millisecond * 500
+
+	wsMaxSubscriptions = 100
+	wsFlushInterval    = time.Millisecond * 100
+)
+
+// wsSubscriptionKinds maps the kinds a client can subscribe to onto the
+// relayed websocket event names
+var wsSubscriptionKinds = map[string]string{
+	"ticker":    WebsocketEventTicker,
+	"orderbook": WebsocketEventOrderbook,
+	"trades":    WebsocketEventTrade,
+	"orders":    WebsocketEventOrder,
+	"events":    WebsocketEventTrigger,
+}
+
+// wsPrivateEvents are only relayed to authenticated clients
+var wsPrivateEvents = map[string]bool{
+	WebsocketEventOrder:   true,
+	WebsocketEventTrigger: true,
+}
+
+// vars related to websocket subscriptions
+var (
+	ErrWebsocketInvalidSubscription = errors.New("invalid subscription event kind, must be one of ticker, orderbook, trades, orders or events")
+	ErrWebsocketSubscriptionAuth    = errors.New("authentication required for order and event trigger subscriptions")
+	ErrWebsocketSubscriptionLimit   = fmt.Errorf("subscription limit of %d reached", wsMaxSubscriptions)
+	ErrWebsocketInvalidThrottle     = errors.New("throttle must not be negative")
+	ErrWebsocketClientDisconnected  = errors.New("websocket client disconnected")
 )
 
 var (
@@ -27,16 +70,27 @@ type wsCommandHandler struct {
 }
 
 var wsHandlers = map[string]wsCommandHandler{
-	"auth":             {authRequired: false, handler: wsAuth},
-	"getconfig":        {authRequired: true, handler: wsGetConfig},
-	"saveconfig":       {authRequired: true, handler: wsSaveConfig},
-	"getaccountinfo":   {authRequired: true, handler: wsGetAccountInfo},
-	"gettickers":       {authRequired: false, handler: wsGetTickers},
-	"getticker":        {authRequired: false, handler: wsGetTicker},
-	"getorderbooks":    {authRequired: false, handler: wsGetOrderbooks},
-	"getorderbook":     {authRequired: false, handler: wsGetOrderbook},
-	"getexchangerates": {authRequired: false, handler: wsGetExchangeRates},
-	"getportfolio":     {authRequired: true, handler: wsGetPortfolio},
+	"auth":              {authRequired: false, handler: wsAuth},
+	"getconfig":         {authRequired: true, handler: wsGetConfig},
+	"saveconfig":        {authRequired: true, handler: wsSaveConfig},
+	"getaccountinfo":    {authRequired: true, handler: wsGetAccountInfo},
+	"gettickers":        {authRequired: false, handler: wsGetTickers},
+	"getticker":         {authRequired: false, handler: wsGetTicker},
+	"getorderbooks":     {authRequired: false, handler: wsGetOrderbooks},
+	"getorderbook":      {authRequired: false, handler: wsGetOrderbook},
+	"getexchangerates":  {authRequired: false, handler: wsGetExchangeRates},
+	"getportfolio":      {authRequired: true, handler: wsGetPortfolio},
+	"getorders":         {authRequired: true, handler: wsGetOrders},
+	"getorderinfo":      {authRequired: true, handler: wsGetOrderInfo},
+	"submitorder":       {authRequired: true, handler: wsSubmitOrder},
+	"modifyorder":       {authRequired: true, handler: wsModifyOrder},
+	"cancelorder":       {authRequired: true, handler: wsCancelOrder},
+	"cancelallorders":   {authRequired: true, handler: wsCancelAllOrders},
+	"getdepositaddress": {authRequired: true, handler: wsGetDepositAddress},
+	"withdrawcrypto":    {authRequired: true, handler: wsWithdrawCryptocurrency},
+	"withdrawfiat":      {authRequired: true, handler: wsWithdrawFiat},
+	"subscribe":         {authRequired: false, handler: wsSubscribe},
+	"unsubscribe":       {authRequired: false, handler: wsUnsubscribe},
 }
 
 // WebsocketClient stores information related to the websocket client
@@ -46,24 +100,65 @@ type WebsocketClient struct {
 	Authenticated bool
 	authFailures  int
 	Send          chan []byte
+
+	// done is closed by the hub when the client is disconnected. Send is
+	// never closed as read() may still be sending responses to it
+	done chan struct{}
+
+	subscriptionsMtx sync.Mutex
+	subscriptions    []WebsocketSubscription
+	throttle         time.Duration
+
+	// lastSent and pending are only accessed by the hub routine
+	lastSent map[string]time.Time
+	pending  map[string]wsPendingEvent
+}
+
+// wsPendingEvent is a throttled ticker or orderbook update waiting to be sent
+type wsPendingEvent struct {
+	evt  WebsocketEvent
+	data []byte
 }
 
 // WebsocketHub stores the data for managing websocket clients
 type WebsocketHub struct {
 	Clients    map[*WebsocketClient]bool
-	Broadcast  chan []byte
+	Broadcast  chan WebsocketEvent
 	Register   chan *WebsocketClient
 	Unregister chan *WebsocketClient
+	clientsMtx sync.RWMutex
 }
 
 // WebsocketEvent is the struct used for websocket events
 type WebsocketEvent struct {
 	Exchange  string `json:"exchange,omitempty"`
 	AssetType string `json:"assetType,omitempty"`
+	Currency  string `json:"currency,omitempty"`
 	Event     string
 	Data      interface{}
 }
 
+// WebsocketSubscription filters the events relayed to a client, empty fields
+// match everything
+type WebsocketSubscription struct {
+	Exchange  string `json:"exchangeName"`
+	Currency  string `json:"currency"`
+	AssetType string `json:"assetType"`
+	Event     string `json:"event"`
+
+	// publicOnly is set when a subscription to all event kinds is made by an
+	// unauthenticated client
+	publicOnly bool
+}
+
+// WebsocketSubscribeRequest is the request used to subscribe to or unsubscribe
+// from events. Throttle sets the minimum interval in milliseconds between
+// ticker or orderbook updates for the same exchange, pair and asset type
+type WebsocketSubscribeRequest struct {
+	WebsocketSubscription
+	Throttle int64 `json:"throttle,omitempty"`
+}
+
 // WebsocketEventResponse is the struct used for websocket event responses
 type WebsocketEventResponse struct {
 	Event string      `json:"event"`
@@ -88,7 +183,7 @@ type WebsocketAuth struct {
 // NewWebsocketHub Creates a new websocket hub
 func NewWebsocketHub() *WebsocketHub {
 	return &WebsocketHub{
-		Broadcast:  make(chan []byte),
+		Broadcast:  make(chan WebsocketEvent),
 		Register:   make(chan *WebsocketClient),
 		Unregister: make(chan *WebsocketClient),
 		Clients:    make(map[*WebsocketClient]bool),
@@ -96,31 +191,308 @@ func NewWebsocketHub() *WebsocketHub {
 }
 
 func (h *WebsocketHub) run() {
+	flush := time.NewTicker(wsFlushInterval)
+	defer flush.Stop()
+
 	for {
 		select {
 		case client := <-h.Register:
+			h.clientsMtx.Lock()
 			h.Clients[client] = true
+			h.clientsMtx.Unlock()
 		case client := <-h.Unregister:
 			if _, ok := h.Clients[client]; ok {
-				log.Printf("websocket: disconnected client")
-				delete(h.Clients, client)
-				close(client.Send)
+				h.disconnect(client)
+			}
+		case evt := <-h.Broadcast:
+			var data []byte
+			for client := range h.Clients {
+				if !client.IsSubscribed(evt) {
+					continue
+				}
+
+				if data == nil {
+					var err error
+					data, err = common.JSONEncode(evt)
+					if err != nil {
+						log.Printf("websocket: failed to encode %s event: %s",
+							evt.Event, err)
+						break
+					}
+				}
+
+				if !client.queue(evt, data, time.Now()) {
+					h.disconnect(client)
+				}
 			}
-		case message := <-h.Broadcast:
+		case now := <-flush.C:
 			for client := range h.Clients {
-				select {
-				case client.Send <- message:
-				default:
-					log.Printf("websocket: disconnected client")
-					close(client.Send)
-					delete(h.Clients, client)
+				if !client.flush(now) {
+					h.disconnect(client)
 				}
 			}
 		}
 	}
 }
 
-// SendWebsocketMessage sends a websocket event to the client
+// disconnect removes a client from the hub and signals its routines to stop
+func (h *WebsocketHub) disconnect(client *WebsocketClient) {
+	log.Printf("websocket: disconnected client")
+	h.clientsMtx.Lock()
+	delete(h.Clients, client)
+	h.clientsMtx.Unlock()
+	close(client.done)
+}
+
+// MaxSendQueue returns the largest number of messages waiting to be sent to a
+// single client
+func (h *WebsocketHub) MaxSendQueue() int {
+	h.clientsMtx.RLock()
+	defer h.clientsMtx.RUnlock()
+	var max int
+	for client := range h.Clients {
+		if n := len(client.Send); n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// registerWebsocketMetrics registers the websocket hub client and queue gauges
+func registerWebsocketMetrics(h *WebsocketHub) {
+	err := metrics.RegisterGaugeFunc("websocket_clients",
+		"Connected websocket API clients.",
+		func() float64 { return float64(h.ClientCount()) })
+	if err != nil {
+		log.Printf("websocket: failed to register metrics: %s", err)
+		return
+	}
+
+	err = metrics.RegisterGaugeFunc("websocket_client_send_queue_max",
+		"Largest number of messages queued for a websocket API client.",
+		func() float64 { return float64(h.MaxSendQueue()) })
+	if err != nil {
+		log.Printf("websocket: failed to register metrics: %s", err)
+	}
+}
+
+// ClientCount returns the number of connected clients
+func (h *WebsocketHub) ClientCount() int {
+	h.clientsMtx.RLock()
+	defer h.clientsMtx.RUnlock()
+	return len(h.Clients)
+}
+
+// IsEventSubscribed returns whether any connected client is subscribed to a
+// websocket event
+func (h *WebsocketHub) IsEventSubscribed(event string) bool {
+	h.clientsMtx.RLock()
+	defer h.clientsMtx.RUnlock()
+	for client := range h.Clients {
+		client.subscriptionsMtx.Lock()
+		for i := range client.subscriptions {
+			s := client.subscriptions[i]
+			if s.Event == "" || wsSubscriptionKinds[s.Event] == event {
+				client.subscriptionsMtx.Unlock()
+				return true
+			}
+		}
+		client.subscriptionsMtx.Unlock()
+	}
+	return false
+}
+
+// matches returns whether an event passes the subscription filters
+func (s *WebsocketSubscription) matches(evt WebsocketEvent) bool {
+	if s.Event == "" {
+		if s.publicOnly && wsPrivateEvents[evt.Event] {
+			return false
+		}
+	} else if wsSubscriptionKinds[s.Event] != evt.Event {
+		return false
+	}
+
+	if s.Exchange != "" &&
+		common.StringToLower(s.Exchange) != common.StringToLower(evt.Exchange) {
+		return false
+	}
+
+	if s.AssetType != "" &&
+		common.StringToUpper(s.AssetType) != common.StringToUpper(evt.AssetType) {
+		return false
+	}
+
+	if s.Currency != "" &&
+		wsFormatCurrency(s.Currency) != wsFormatCurrency(evt.Currency) {
+		return false
+	}
+	return true
+}
+
+// wsFormatCurrency normalises a currency pair so pairs with different
+// delimiters or casing can be compared
+func wsFormatCurrency(currency string) string {
+	currency = common.StringToUpper(currency)
+	for _, delimiter := range []string{"-", "_", "/", ":"} {
+		currency = common.ReplaceString(currency, delimiter, "", -1)
+	}
+	return currency
+}
+
+// IsSubscribed returns whether the client is subscribed to an event
+func (c *WebsocketClient) IsSubscribed(evt WebsocketEvent) bool {
+	c.subscriptionsMtx.Lock()
+	defer c.subscriptionsMtx.Unlock()
+	for i := range c.subscriptions {
+		if c.subscriptions[i].matches(evt) {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribe adds a subscription for the client and returns the client
+// subscriptions
+func (c *WebsocketClient) Subscribe(sub WebsocketSubscription) ([]WebsocketSubscription, error) {
+	sub.Event = common.StringToLower(sub.Event)
+	if sub.Event != "" {
+		event, ok := wsSubscriptionKinds[sub.Event]
+		if !ok {
+			return nil, ErrWebsocketInvalidSubscription
+		}
+		if wsPrivateEvents[event] && !c.Authenticated {
+			return nil, ErrWebsocketSubscriptionAuth
+		}
+	} else {
+		sub.publicOnly = !c.Authenticated
+	}
+
+	c.subscriptionsMtx.Lock()
+	defer c.subscriptionsMtx.Unlock()
+	for i := range c.subscriptions {
+		if c.subscriptions[i] == sub {
+			return c.copySubscriptions(), nil
+		}
+	}
+
+	if len(c.subscriptions) >= wsMaxSubscriptions {
+		return nil, ErrWebsocketSubscriptionLimit
+	}
+
+	c.subscriptions = append(c.subscriptions, sub)
+	return c.copySubscriptions(), nil
+}
+
+// Unsubscribe removes a subscription matching the exchange, currency, asset
+// type and event kind, or every subscription if they are all empty, and returns
+// the remaining client subscriptions
+func (c *WebsocketClient) Unsubscribe(sub WebsocketSubscription) []WebsocketSubscription {
+	sub.Event = common.StringToLower(sub.Event)
+	removeAll := sub == WebsocketSubscription{}
+
+	c.subscriptionsMtx.Lock()
+	defer c.subscriptionsMtx.Unlock()
+	var subscriptions []WebsocketSubscription
+	for _, s := range c.subscriptions {
+		if removeAll || (s.Exchange == sub.Exchange &&
+			s.Currency == sub.Currency &&
+			s.AssetType == sub.AssetType &&
+			s.Event == sub.Event) {
+			continue
+		}
+		subscriptions = append(subscriptions, s)
+	}
+	c.subscriptions = subscriptions
+	return c.copySubscriptions()
+}
+
+// copySubscriptions returns a copy of the client subscriptions, the caller
+// must hold subscriptionsMtx
+func (c *WebsocketClient) copySubscriptions() []WebsocketSubscription {
+	subscriptions := make([]WebsocketSubscription, len(c.subscriptions))
+	copy(subscriptions, c.subscriptions)
+	return subscriptions
+}
+
+// SetThrottle sets the minimum interval between ticker or orderbook updates
+// for the same exchange, pair and asset type
+func (c *WebsocketClient) SetThrottle(throttle time.Duration) {
+	c.subscriptionsMtx.Lock()
+	c.throttle = throttle
+	c.subscriptionsMtx.Unlock()
+}
+
+func (c *WebsocketClient) getThrottle() time.Duration {
+	c.subscriptionsMtx.Lock()
+	defer c.subscriptionsMtx.Unlock()
+	return c.throttle
+}
+
+// queue sends an event to the client. Ticker and orderbook updates arriving
+// within the throttle interval are coalesced so only the latest update for
+// each exchange, pair and asset type is sent once the interval has passed.
+// It returns false if the client send buffer is full
+func (c *WebsocketClient) queue(evt WebsocketEvent, data []byte, now time.Time) bool {
+	if evt.Event != WebsocketEventTicker && evt.Event != WebsocketEventOrderbook {
+		return c.send(data)
+	}
+
+	if c.lastSent == nil {
+		c.lastSent = make(map[string]time.Time)
+		c.pending = make(map[string]wsPendingEvent)
+	}
+
+	key := evt.Event + "|" + common.StringToLower(evt.Exchange) + "|" +
+		common.StringToUpper(evt.AssetType) + "|" + wsFormatCurrency(evt.Currency)
+	if now.Sub(c.lastSent[key]) < c.getThrottle() {
+		c.pending[key] = wsPendingEvent{evt: evt, data: data}
+		return true
+	}
+
+	delete(c.pending, key)
+	c.lastSent[key] = now
+	return c.send(data)
+}
+
+// flush sends the coalesced updates whose throttle interval has passed and
+// drops any the client is no longer subscribed to. It returns false if the
+// client send buffer is full
+func (c *WebsocketClient) flush(now time.Time) bool {
+	if len(c.pending) == 0 {
+		return true
+	}
+
+	throttle := c.getThrottle()
+	for key, p := range c.pending {
+		if now.Sub(c.lastSent[key]) < throttle {
+			continue
+		}
+
+		delete(c.pending, key)
+		if !c.IsSubscribed(p.evt) {
+			continue
+		}
+
+		c.lastSent[key] = now
+		if !c.send(p.data) {
+			return false
+		}
+	}
+	return true
+}
+
+// send adds a message to the client send buffer without blocking
+func (c *WebsocketClient) send(data []byte) bool {
+	select {
+	case c.Send <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+// SendWebsocketMessage sends a websocket event to the client, it returns
+// ErrWebsocketClientDisconnected once the hub has disconnected the client
 func (c *WebsocketClient) SendWebsocketMessage(evt interface{}) error {
 	data, err := common.JSONEncode(evt)
 	if err != nil {
@@ -128,8 +500,12 @@ func (c *WebsocketClient) SendWebsocketMessage(evt interface{}) error {
 		return err
 	}
 
-	c.Send <- data
-	return nil
+	select {
+	case c.Send <- data:
+		return nil
+	case <-c.done:
+		return ErrWebsocketClientDisconnected
+	}
 }
 
 func (c *WebsocketClient) read() {
@@ -196,13 +572,11 @@ func (c *WebsocketClient) write() {
 	}()
 	for {
 		select {
-		case message, ok := <-c.Send:
-			if !ok {
-				c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
-				log.Printf("websocket: hub closed the channel")
-				return
-			}
-
+		case <-c.done:
+			c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+			log.Printf("websocket: hub disconnected the client")
+			return
+		case message := <-c.Send:
 			w, err := c.Conn.NextWriter(websocket.TextMessage)
 			if err != nil {
 				log.Printf("websocket: failed to create new io.writeCloser: %s", err)
@@ -224,6 +598,17 @@ func (c *WebsocketClient) write() {
 	}
 }
 
+// newWebsocketClient returns a websocket client for a connection
+func newWebsocketClient(hub *WebsocketHub, conn *websocket.Conn) *WebsocketClient {
+	return &WebsocketClient{
+		Hub:      hub,
+		Conn:     conn,
+		Send:     make(chan []byte, 1024),
+		done:     make(chan struct{}),
+		throttle: DefaultWebsocketThrottle,
+	}
+}
+
 // StartWebsocketHandler starts the websocket hub and routine which
 // handles clients
 func StartWebsocketHandler() {
@@ -231,24 +616,29 @@ func StartWebsocketHandler() {
 		wsHubStarted = true
 		wsHub = NewWebsocketHub()
 		go wsHub.run()
+		registerWebsocketMetrics(wsHub)
 	}
 }
 
-// BroadcastWebsocketMessage meow
+// BroadcastWebsocketMessage relays an event to the clients subscribed to it
 func BroadcastWebsocketMessage(evt WebsocketEvent) error {
 	if !wsHubStarted {
 		return errors.New("websocket service not started")
 	}
 
-	data, err := common.JSONEncode(evt)
-	if err != nil {
-		return err
-	}
-
-	wsHub.Broadcast <- data
+	wsHub.Broadcast <- evt
 	return nil
 }
 
+// IsWebsocketEventSubscribed returns whether any websocket client is
+// subscribed to an event
+func IsWebsocketEventSubscribed(event string) bool {
+	if !wsHubStarted {
+		return false
+	}
+	return wsHub.IsEventSubscribed(event)
+}
+
 // WebsocketClientHandler upgrades the HTTP connection to a websocket
 // compatible one
 func WebsocketClientHandler(w http.ResponseWriter, r *http.Request) {
@@ -256,8 +646,9 @@ func WebsocketClientHandler(w http.ResponseWriter, r *http.Request) {
 		StartWebsocketHandler()
 	}
 
-	connectionLimit := bot.config.Webserver.WebsocketConnectionLimit
-	numClients := len(wsHub.Clients)
+	webserver := getWebserverConfig()
+	connectionLimit := webserver.WebsocketConnectionLimit
+	numClients := wsHub.ClientCount()
 
 	if numClients >= connectionLimit {
 		log.Printf("websocket: client rejected due to websocket client limit reached. Number of clients %d. Limit %d.",
@@ -273,7 +664,7 @@ func WebsocketClientHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Allow insecure origin if the Origin request header is present and not
 	// equal to the Host request header. Default to false
-	if bot.config.Webserver.WebsocketAllowInsecureOrigin {
+	if webserver.WebsocketAllowInsecureOrigin {
 		upgrader.CheckOrigin = func(r *http.Request) bool { return true }
 	}
 
@@ -283,7 +674,7 @@ func WebsocketClientHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	client := &WebsocketClient{Hub: wsHub, Conn: conn, Send: make(chan []byte, 1024)}
+	client := newWebsocketClient(wsHub, conn)
 	client.Hub.Register <- client
 	log.Printf("websocket: client connected. Connected clients: %d. Limit %d.",
 		numClients+1, connectionLimit)
@@ -305,8 +696,9 @@ func wsAuth(client *WebsocketClient, data interface{}) error {
 		return err
 	}
 
-	hashPW := common.HexEncodeToString(common.GetSHA256([]byte(bot.config.Webserver.AdminPassword)))
-	if auth.Username == bot.config.Webserver.AdminUsername && auth.Password == hashPW {
+	webserver := getWebserverConfig()
+	hashPW := common.HexEncodeToString(common.GetSHA256([]byte(webserver.AdminPassword)))
+	if auth.Username == webserver.AdminUsername && auth.Password == hashPW {
 		client.Authenticated = true
 		wsResp.Data = WebsocketResponseSuccess
 		log.Println("websocket: client authenticated successfully")
@@ -316,19 +708,22 @@ func wsAuth(client *WebsocketClient, data interface{}) error {
 	wsResp.Error = "invalid username/password"
 	client.authFailures++
 	client.SendWebsocketMessage(wsResp)
-	if client.authFailures >= bot.config.Webserver.WebsocketMaxAuthFailures {
+	if client.authFailures >= webserver.WebsocketMaxAuthFailures {
 		log.Printf("websocket: disconnecting client, maximum auth failures threshold reached (failures: %d limit: %d)",
-			client.authFailures, bot.config.Webserver.WebsocketMaxAuthFailures)
+			client.authFailures, webserver.WebsocketMaxAuthFailures)
 		wsHub.Unregister <- client
 		return nil
 	}
 
 	log.Printf("websocket: client sent wrong username/password (failures: %d limit: %d)",
-		client.authFailures, bot.config.Webserver.WebsocketMaxAuthFailures)
+		client.authFailures, webserver.WebsocketMaxAuthFailures)
 	return nil
 }
 
 func wsGetConfig(client *WebsocketClient, data interface{}) error {
+	bot.configMtx.RLock()
+	defer bot.configMtx.RUnlock()
+
 	wsResp := WebsocketEventResponse{
 		Event: "GetConfig",
 		Data:  bot.config,
@@ -348,14 +743,13 @@ func wsSaveConfig(client *WebsocketClient, data interface{}) error {
 		return err
 	}
 
-	err = bot.config.UpdateConfig(bot.configFile, cfg)
+	err = SaveAndApplyConfig(cfg)
 	if err != nil {
 		wsResp.Error = err.Error()
 		client.SendWebsocketMessage(wsResp)
 		return err
 	}
 
-	SetupExchanges()
 	wsResp.Data = WebsocketResponseSuccess
 	return client.SendWebsocketMessage(wsResp)
 }
@@ -445,6 +839,157 @@ func wsGetPortfolio(client *WebsocketClient, data interface{}) error {
 	wsResp := WebsocketEventResponse{
 		Event: "GetPortfolio",
 	}
-	wsResp.Data = bot.portfolio.GetPortfolioSummary()
+	result := bot.portfolio.GetPortfolioSummary()
+	result.SetValues(getFiatDisplayCurrency())
+	wsResp.Data = result
 	return client.SendWebsocketMessage(wsResp)
 }
+
+// wsRespond sends the response for a websocket event or the error if one
+// occurred
+func wsRespond(client *WebsocketClient, event string, response interface{}, err error) error {
+	wsResp := WebsocketEventResponse{
+		Event: event,
+	}
+
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	wsResp.Data = response
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetOrders(client *WebsocketClient, data interface{}) error {
+	var req OrderRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "GetOrders", nil, err)
+	}
+
+	orders, err := bot.control.GetOpenOrders(req.Exchange)
+	return wsRespond(client, "GetOrders", orders, err)
+}
+
+func wsGetOrderInfo(client *WebsocketClient, data interface{}) error {
+	var req OrderRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "GetOrderInfo", nil, err)
+	}
+
+	resp, err := bot.control.GetOrderInfo(req.Exchange, req.OrderID)
+	return wsRespond(client, "GetOrderInfo", resp, err)
+}
+
+func wsSubmitOrder(client *WebsocketClient, data interface{}) error {
+	var req OrderSubmitRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "SubmitOrder", nil, err)
+	}
+
+	resp, err := SubmitOrder(req)
+	return wsRespond(client, "SubmitOrder", resp, err)
+}
+
+func wsModifyOrder(client *WebsocketClient, data interface{}) error {
+	var req OrderModifyRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "ModifyOrder", nil, err)
+	}
+
+	resp, err := ModifyOrder(req)
+	return wsRespond(client, "ModifyOrder", resp, err)
+}
+
+func wsCancelOrder(client *WebsocketClient, data interface{}) error {
+	var req OrderRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "CancelOrder", nil, err)
+	}
+
+	err = bot.control.CancelOrder(req.Exchange, req.OrderID)
+	return wsRespond(client, "CancelOrder", WebsocketResponseSuccess, err)
+}
+
+func wsCancelAllOrders(client *WebsocketClient, data interface{}) error {
+	var req OrderRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "CancelAllOrders", nil, err)
+	}
+
+	err = bot.control.CancelAllOrders(req.Exchange)
+	return wsRespond(client, "CancelAllOrders", WebsocketResponseSuccess, err)
+}
+
+func wsGetDepositAddress(client *WebsocketClient, data interface{}) error {
+	var req DepositAddressRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "GetDepositAddress", nil, err)
+	}
+
+	resp, err := GetDepositAddress(req)
+	return wsRespond(client, "GetDepositAddress", resp, err)
+}
+
+func wsWithdrawCryptocurrency(client *WebsocketClient, data interface{}) error {
+	var req WithdrawRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "WithdrawCrypto", nil, err)
+	}
+
+	resp, err := Withdraw(req, false)
+	return wsRespond(client, "WithdrawCrypto", resp, err)
+}
+
+func wsWithdrawFiat(client *WebsocketClient, data interface{}) error {
+	var req WithdrawRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "WithdrawFiat", nil, err)
+	}
+
+	resp, err := Withdraw(req, true)
+	return wsRespond(client, "WithdrawFiat", resp, err)
+}
+
+func wsSubscribe(client *WebsocketClient, data interface{}) error {
+	var req WebsocketSubscribeRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "Subscribe", nil, err)
+	}
+
+	if req.Throttle < 0 {
+		return wsRespond(client, "Subscribe", nil, ErrWebsocketInvalidThrottle)
+	}
+
+	subscriptions, err := client.Subscribe(req.WebsocketSubscription)
+	if err != nil {
+		return wsRespond(client, "Subscribe", nil, err)
+	}
+
+	if req.Throttle > 0 {
+		client.SetThrottle(time.Duration(req.Throttle) * time.Millisecond)
+	}
+	return wsRespond(client, "Subscribe", subscriptions, nil)
+}
+
+func wsUnsubscribe(client *WebsocketClient, data interface{}) error {
+	var req WebsocketSubscribeRequest
+	err := common.JSONDecode(data.([]byte), &req)
+	if err != nil {
+		return wsRespond(client, "Unsubscribe", nil, err)
+	}
+
+	return wsRespond(client, "Unsubscribe",
+		client.Unsubscribe(req.WebsocketSubscription), nil)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebsocketSubscriptionMatches(t *testing.T) {
+	evt := WebsocketEvent{
+		Exchange:  "Bitfinex",
+		AssetType: "SPOT",
+		Currency:  "BTC-USD",
+		Event:     WebsocketEventTicker,
+	}
+
+	tests := []struct {
+		sub      WebsocketSubscription
+		expected bool
+	}{
+		{WebsocketSubscription{}, true},
+		{WebsocketSubscription{Event: "ticker"}, true},
+		{WebsocketSubscription{Event: "orderbook"}, false},
+		{WebsocketSubscription{Exchange: "bitfinex", Currency: "btcusd"}, true},
+		{WebsocketSubscription{Exchange: "Kraken"}, false},
+		{WebsocketSubscription{Currency: "BTC_USD", AssetType: "spot"}, true},
+		{WebsocketSubscription{Currency: "LTCUSD"}, false},
+		{WebsocketSubscription{AssetType: "futures"}, false},
+	}
+
+	for i, test := range tests {
+		if test.sub.matches(evt) != test.expected {
+			t.Errorf("Test failed. WebsocketSubscription matches test %d expected %v",
+				i, test.expected)
+		}
+	}
+
+	sub := WebsocketSubscription{publicOnly: true}
+	if sub.matches(WebsocketEvent{Event: WebsocketEventOrder}) {
+		t.Error("Test failed. Public subscription matched an order update")
+	}
+}
+
+func TestWebsocketClientSubscribe(t *testing.T) {
+	var c WebsocketClient
+
+	_, err := c.Subscribe(WebsocketSubscription{Event: "asdsad"})
+	if err != ErrWebsocketInvalidSubscription {
+		t.Error("Test failed. Subscribe() error", err)
+	}
+
+	_, err = c.Subscribe(WebsocketSubscription{Event: "orders"})
+	if err != ErrWebsocketSubscriptionAuth {
+		t.Error("Test failed. Subscribe() error", err)
+	}
+
+	subs, err := c.Subscribe(WebsocketSubscription{Exchange: "Bitfinex", Event: "ticker"})
+	if err != nil || len(subs) != 1 {
+		t.Error("Test failed. Subscribe() error", err, subs)
+	}
+
+	subs, err = c.Subscribe(WebsocketSubscription{Exchange: "Bitfinex", Event: "TICKER"})
+	if err != nil || len(subs) != 1 {
+		t.Error("Test failed. Subscribe() duplicate subscription added", err, subs)
+	}
+
+	c.Authenticated = true
+	subs, err = c.Subscribe(WebsocketSubscription{Event: "orders"})
+	if err != nil || len(subs) != 2 {
+		t.Error("Test failed. Subscribe() error", err, subs)
+	}
+
+	if !c.IsSubscribed(WebsocketEvent{Exchange: "Bitfinex", Event: WebsocketEventTicker}) ||
+		c.IsSubscribed(WebsocketEvent{Exchange: "Kraken", Event: WebsocketEventTicker}) {
+		t.Error("Test failed. IsSubscribed() unexpected result")
+	}
+
+	subs = c.Unsubscribe(WebsocketSubscription{Event: "orders"})
+	if len(subs) != 1 || subs[0].Event != "ticker" {
+		t.Error("Test failed. Unsubscribe() unexpected subscriptions", subs)
+	}
+
+	subs = c.Unsubscribe(WebsocketSubscription{})
+	if len(subs) != 0 {
+		t.Error("Test failed. Unsubscribe() unexpected subscriptions", subs)
+	}
+}
+
+func TestWebsocketClientCoalescing(t *testing.T) {
+	c := WebsocketClient{
+		Send:     make(chan []byte, 10),
+		throttle: time.Second,
+	}
+	c.Subscribe(WebsocketSubscription{})
+
+	orderbook := WebsocketEvent{Exchange: "Bitfinex", Currency: "BTCUSD",
+		Event: WebsocketEventOrderbook}
+	trade := WebsocketEvent{Exchange: "Bitfinex", Currency: "BTCUSD",
+		Event: WebsocketEventTrade}
+
+	now := time.Now()
+	c.queue(orderbook, []byte("1"), now)
+	c.queue(orderbook, []byte("2"), now.Add(time.Millisecond))
+	c.queue(orderbook, []byte("3"), now.Add(time.Millisecond*2))
+	c.queue(trade, []byte("trade"), now.Add(time.Millisecond*3))
+	if len(c.Send) != 2 || string(<-c.Send) != "1" || string(<-c.Send) != "trade" {
+		t.Fatal("Test failed. queue() did not throttle orderbook updates")
+	}
+
+	c.flush(now.Add(time.Millisecond * 500))
+	if len(c.Send) != 0 {
+		t.Fatal("Test failed. flush() sent an update within the throttle interval")
+	}
+
+	c.flush(now.Add(time.Second))
+	if len(c.Send) != 1 || string(<-c.Send) != "3" {
+		t.Error("Test failed. flush() did not send the latest coalesced update")
+	}
+
+	c.queue(orderbook, []byte("4"), now.Add(time.Second+time.Millisecond))
+	c.Unsubscribe(WebsocketSubscription{})
+	c.flush(now.Add(time.Second * 2))
+	if len(c.Send) != 0 {
+		t.Error("Test failed. flush() sent an update after unsubscribing")
+	}
+
+	full := WebsocketClient{Send: make(chan []byte)}
+	if full.queue(trade, []byte("trade"), now) {
+		t.Error("Test failed. queue() succeeded with a full send buffer")
+	}
+}
+
+func TestWebsocketClientDisconnect(t *testing.T) {
+	hub := NewWebsocketHub()
+	c := newWebsocketClient(hub, nil)
+	hub.Clients[c] = true
+	for i := 0; i < cap(c.Send); i++ {
+		c.Send <- []byte("queued")
+	}
+
+	sent := make(chan error)
+	go func() {
+		sent <- c.SendWebsocketMessage(WebsocketEventResponse{Event: "auth"})
+	}()
+
+	hub.disconnect(c)
+	select {
+	case err := <-sent:
+		if err != ErrWebsocketClientDisconnected {
+			t.Error("Test failed. SendWebsocketMessage() unexpected error", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Test failed. SendWebsocketMessage() blocked after disconnect")
+	}
+
+	if hub.ClientCount() != 0 {
+		t.Error("Test failed. disconnect() did not remove the client")
+	}
+
+	if c.SendWebsocketMessage(WebsocketEventResponse{}) != ErrWebsocketClientDisconnected {
+		t.Error("Test failed. SendWebsocketMessage() succeeded after disconnect")
+	}
+}